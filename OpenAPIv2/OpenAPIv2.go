@@ -18,6 +18,7 @@ package openapi_v2
 
 import (
 	"fmt"
+	"github.com/golang/protobuf/proto"
 	"github.com/googleapis/gnostic/compiler"
 	"gopkg.in/yaml.v2"
 	"regexp"
@@ -8840,6 +8841,6725 @@ func (m *Xml) ToRawInfo() interface{} {
 	return info
 }
 
+// Visitor is implemented by types that inspect the nodes of a Document.
+// Each method is called with a node and the path of keys that leads to it
+// from the root of the document. Map keys and property names are used as keys,
+// and array elements are identified by their indices. Returning SkipChildren skips
+// the nodes below a node, and returning any other error stops the walk.
+// Implementations can embed BaseVisitor and override only the methods they need.
+type Visitor interface {
+	VisitAdditionalPropertiesItem(node *AdditionalPropertiesItem, path []string) error
+	VisitAny(node *Any, path []string) error
+	VisitApiKeySecurity(node *ApiKeySecurity, path []string) error
+	VisitBasicAuthenticationSecurity(node *BasicAuthenticationSecurity, path []string) error
+	VisitBodyParameter(node *BodyParameter, path []string) error
+	VisitContact(node *Contact, path []string) error
+	VisitDefault(node *Default, path []string) error
+	VisitDefinitions(node *Definitions, path []string) error
+	VisitDocument(node *Document, path []string) error
+	VisitExamples(node *Examples, path []string) error
+	VisitExternalDocs(node *ExternalDocs, path []string) error
+	VisitFileSchema(node *FileSchema, path []string) error
+	VisitFormDataParameterSubSchema(node *FormDataParameterSubSchema, path []string) error
+	VisitHeader(node *Header, path []string) error
+	VisitHeaderParameterSubSchema(node *HeaderParameterSubSchema, path []string) error
+	VisitHeaders(node *Headers, path []string) error
+	VisitInfo(node *Info, path []string) error
+	VisitItemsItem(node *ItemsItem, path []string) error
+	VisitJsonReference(node *JsonReference, path []string) error
+	VisitLicense(node *License, path []string) error
+	VisitNonBodyParameter(node *NonBodyParameter, path []string) error
+	VisitOauth2AccessCodeSecurity(node *Oauth2AccessCodeSecurity, path []string) error
+	VisitOauth2ApplicationSecurity(node *Oauth2ApplicationSecurity, path []string) error
+	VisitOauth2ImplicitSecurity(node *Oauth2ImplicitSecurity, path []string) error
+	VisitOauth2PasswordSecurity(node *Oauth2PasswordSecurity, path []string) error
+	VisitOauth2Scopes(node *Oauth2Scopes, path []string) error
+	VisitOperation(node *Operation, path []string) error
+	VisitParameter(node *Parameter, path []string) error
+	VisitParameterDefinitions(node *ParameterDefinitions, path []string) error
+	VisitParametersItem(node *ParametersItem, path []string) error
+	VisitPathItem(node *PathItem, path []string) error
+	VisitPathParameterSubSchema(node *PathParameterSubSchema, path []string) error
+	VisitPaths(node *Paths, path []string) error
+	VisitPrimitivesItems(node *PrimitivesItems, path []string) error
+	VisitProperties(node *Properties, path []string) error
+	VisitQueryParameterSubSchema(node *QueryParameterSubSchema, path []string) error
+	VisitResponse(node *Response, path []string) error
+	VisitResponseDefinitions(node *ResponseDefinitions, path []string) error
+	VisitResponseValue(node *ResponseValue, path []string) error
+	VisitResponses(node *Responses, path []string) error
+	VisitSchema(node *Schema, path []string) error
+	VisitSchemaItem(node *SchemaItem, path []string) error
+	VisitSecurityDefinitions(node *SecurityDefinitions, path []string) error
+	VisitSecurityDefinitionsItem(node *SecurityDefinitionsItem, path []string) error
+	VisitSecurityRequirement(node *SecurityRequirement, path []string) error
+	VisitStringArray(node *StringArray, path []string) error
+	VisitTag(node *Tag, path []string) error
+	VisitTypeItem(node *TypeItem, path []string) error
+	VisitVendorExtension(node *VendorExtension, path []string) error
+	VisitXml(node *Xml, path []string) error
+}
+
+// SkipChildren is returned by Visitor methods to skip the nodes below the node that they were called with.
+var SkipChildren error = skipChildren{}
+
+type skipChildren struct{}
+
+func (skipChildren) Error() string { return "skip children" }
+
+// BaseVisitor is a Visitor whose methods do nothing.
+type BaseVisitor struct{}
+
+// VisitAdditionalPropertiesItem does nothing.
+func (BaseVisitor) VisitAdditionalPropertiesItem(node *AdditionalPropertiesItem, path []string) error {
+	return nil
+}
+
+// VisitAny does nothing.
+func (BaseVisitor) VisitAny(node *Any, path []string) error { return nil }
+
+// VisitApiKeySecurity does nothing.
+func (BaseVisitor) VisitApiKeySecurity(node *ApiKeySecurity, path []string) error { return nil }
+
+// VisitBasicAuthenticationSecurity does nothing.
+func (BaseVisitor) VisitBasicAuthenticationSecurity(node *BasicAuthenticationSecurity, path []string) error {
+	return nil
+}
+
+// VisitBodyParameter does nothing.
+func (BaseVisitor) VisitBodyParameter(node *BodyParameter, path []string) error { return nil }
+
+// VisitContact does nothing.
+func (BaseVisitor) VisitContact(node *Contact, path []string) error { return nil }
+
+// VisitDefault does nothing.
+func (BaseVisitor) VisitDefault(node *Default, path []string) error { return nil }
+
+// VisitDefinitions does nothing.
+func (BaseVisitor) VisitDefinitions(node *Definitions, path []string) error { return nil }
+
+// VisitDocument does nothing.
+func (BaseVisitor) VisitDocument(node *Document, path []string) error { return nil }
+
+// VisitExamples does nothing.
+func (BaseVisitor) VisitExamples(node *Examples, path []string) error { return nil }
+
+// VisitExternalDocs does nothing.
+func (BaseVisitor) VisitExternalDocs(node *ExternalDocs, path []string) error { return nil }
+
+// VisitFileSchema does nothing.
+func (BaseVisitor) VisitFileSchema(node *FileSchema, path []string) error { return nil }
+
+// VisitFormDataParameterSubSchema does nothing.
+func (BaseVisitor) VisitFormDataParameterSubSchema(node *FormDataParameterSubSchema, path []string) error {
+	return nil
+}
+
+// VisitHeader does nothing.
+func (BaseVisitor) VisitHeader(node *Header, path []string) error { return nil }
+
+// VisitHeaderParameterSubSchema does nothing.
+func (BaseVisitor) VisitHeaderParameterSubSchema(node *HeaderParameterSubSchema, path []string) error {
+	return nil
+}
+
+// VisitHeaders does nothing.
+func (BaseVisitor) VisitHeaders(node *Headers, path []string) error { return nil }
+
+// VisitInfo does nothing.
+func (BaseVisitor) VisitInfo(node *Info, path []string) error { return nil }
+
+// VisitItemsItem does nothing.
+func (BaseVisitor) VisitItemsItem(node *ItemsItem, path []string) error { return nil }
+
+// VisitJsonReference does nothing.
+func (BaseVisitor) VisitJsonReference(node *JsonReference, path []string) error { return nil }
+
+// VisitLicense does nothing.
+func (BaseVisitor) VisitLicense(node *License, path []string) error { return nil }
+
+// VisitNonBodyParameter does nothing.
+func (BaseVisitor) VisitNonBodyParameter(node *NonBodyParameter, path []string) error { return nil }
+
+// VisitOauth2AccessCodeSecurity does nothing.
+func (BaseVisitor) VisitOauth2AccessCodeSecurity(node *Oauth2AccessCodeSecurity, path []string) error {
+	return nil
+}
+
+// VisitOauth2ApplicationSecurity does nothing.
+func (BaseVisitor) VisitOauth2ApplicationSecurity(node *Oauth2ApplicationSecurity, path []string) error {
+	return nil
+}
+
+// VisitOauth2ImplicitSecurity does nothing.
+func (BaseVisitor) VisitOauth2ImplicitSecurity(node *Oauth2ImplicitSecurity, path []string) error {
+	return nil
+}
+
+// VisitOauth2PasswordSecurity does nothing.
+func (BaseVisitor) VisitOauth2PasswordSecurity(node *Oauth2PasswordSecurity, path []string) error {
+	return nil
+}
+
+// VisitOauth2Scopes does nothing.
+func (BaseVisitor) VisitOauth2Scopes(node *Oauth2Scopes, path []string) error { return nil }
+
+// VisitOperation does nothing.
+func (BaseVisitor) VisitOperation(node *Operation, path []string) error { return nil }
+
+// VisitParameter does nothing.
+func (BaseVisitor) VisitParameter(node *Parameter, path []string) error { return nil }
+
+// VisitParameterDefinitions does nothing.
+func (BaseVisitor) VisitParameterDefinitions(node *ParameterDefinitions, path []string) error {
+	return nil
+}
+
+// VisitParametersItem does nothing.
+func (BaseVisitor) VisitParametersItem(node *ParametersItem, path []string) error { return nil }
+
+// VisitPathItem does nothing.
+func (BaseVisitor) VisitPathItem(node *PathItem, path []string) error { return nil }
+
+// VisitPathParameterSubSchema does nothing.
+func (BaseVisitor) VisitPathParameterSubSchema(node *PathParameterSubSchema, path []string) error {
+	return nil
+}
+
+// VisitPaths does nothing.
+func (BaseVisitor) VisitPaths(node *Paths, path []string) error { return nil }
+
+// VisitPrimitivesItems does nothing.
+func (BaseVisitor) VisitPrimitivesItems(node *PrimitivesItems, path []string) error { return nil }
+
+// VisitProperties does nothing.
+func (BaseVisitor) VisitProperties(node *Properties, path []string) error { return nil }
+
+// VisitQueryParameterSubSchema does nothing.
+func (BaseVisitor) VisitQueryParameterSubSchema(node *QueryParameterSubSchema, path []string) error {
+	return nil
+}
+
+// VisitResponse does nothing.
+func (BaseVisitor) VisitResponse(node *Response, path []string) error { return nil }
+
+// VisitResponseDefinitions does nothing.
+func (BaseVisitor) VisitResponseDefinitions(node *ResponseDefinitions, path []string) error {
+	return nil
+}
+
+// VisitResponseValue does nothing.
+func (BaseVisitor) VisitResponseValue(node *ResponseValue, path []string) error { return nil }
+
+// VisitResponses does nothing.
+func (BaseVisitor) VisitResponses(node *Responses, path []string) error { return nil }
+
+// VisitSchema does nothing.
+func (BaseVisitor) VisitSchema(node *Schema, path []string) error { return nil }
+
+// VisitSchemaItem does nothing.
+func (BaseVisitor) VisitSchemaItem(node *SchemaItem, path []string) error { return nil }
+
+// VisitSecurityDefinitions does nothing.
+func (BaseVisitor) VisitSecurityDefinitions(node *SecurityDefinitions, path []string) error {
+	return nil
+}
+
+// VisitSecurityDefinitionsItem does nothing.
+func (BaseVisitor) VisitSecurityDefinitionsItem(node *SecurityDefinitionsItem, path []string) error {
+	return nil
+}
+
+// VisitSecurityRequirement does nothing.
+func (BaseVisitor) VisitSecurityRequirement(node *SecurityRequirement, path []string) error {
+	return nil
+}
+
+// VisitStringArray does nothing.
+func (BaseVisitor) VisitStringArray(node *StringArray, path []string) error { return nil }
+
+// VisitTag does nothing.
+func (BaseVisitor) VisitTag(node *Tag, path []string) error { return nil }
+
+// VisitTypeItem does nothing.
+func (BaseVisitor) VisitTypeItem(node *TypeItem, path []string) error { return nil }
+
+// VisitVendorExtension does nothing.
+func (BaseVisitor) VisitVendorExtension(node *VendorExtension, path []string) error { return nil }
+
+// VisitXml does nothing.
+func (BaseVisitor) VisitXml(node *Xml, path []string) error { return nil }
+
+// Walk calls the methods of a visitor for a Document and for each node below it, in document order.
+// Nodes that wrap alternative types are visited before the node that they contain, at the same path.
+func Walk(document *Document, visitor Visitor) error {
+	return document.walk(visitor, []string{})
+}
+
+// keyPath returns a copy of path with keys appended.
+func keyPath(path []string, keys ...string) []string {
+	result := make([]string, 0, len(path)+len(keys))
+	result = append(result, path...)
+	return append(result, keys...)
+}
+
+// walk visits a AdditionalPropertiesItem and the nodes below it.
+func (m *AdditionalPropertiesItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAdditionalPropertiesItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Any and the nodes below it.
+func (m *Any) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAny(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a ApiKeySecurity and the nodes below it.
+func (m *ApiKeySecurity) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitApiKeySecurity(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a BasicAuthenticationSecurity and the nodes below it.
+func (m *BasicAuthenticationSecurity) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitBasicAuthenticationSecurity(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a BodyParameter and the nodes below it.
+func (m *BodyParameter) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitBodyParameter(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Schema.walk(visitor, keyPath(path, "schema")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Contact and the nodes below it.
+func (m *Contact) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitContact(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Default and the nodes below it.
+func (m *Default) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDefault(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Definitions and the nodes below it.
+func (m *Definitions) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDefinitions(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Document and the nodes below it.
+func (m *Document) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDocument(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Info.walk(visitor, keyPath(path, "info")); err != nil {
+		return err
+	}
+	if err := m.Paths.walk(visitor, keyPath(path, "paths")); err != nil {
+		return err
+	}
+	if err := m.Definitions.walk(visitor, keyPath(path, "definitions")); err != nil {
+		return err
+	}
+	if err := m.Parameters.walk(visitor, keyPath(path, "parameters")); err != nil {
+		return err
+	}
+	if err := m.Responses.walk(visitor, keyPath(path, "responses")); err != nil {
+		return err
+	}
+	for i, item := range m.Security {
+		if err := item.walk(visitor, keyPath(path, "security", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.SecurityDefinitions.walk(visitor, keyPath(path, "securityDefinitions")); err != nil {
+		return err
+	}
+	for i, item := range m.Tags {
+		if err := item.walk(visitor, keyPath(path, "tags", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Examples and the nodes below it.
+func (m *Examples) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExamples(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ExternalDocs and the nodes below it.
+func (m *ExternalDocs) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExternalDocs(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a FileSchema and the nodes below it.
+func (m *FileSchema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitFileSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	if err := m.Example.walk(visitor, keyPath(path, "example")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a FormDataParameterSubSchema and the nodes below it.
+func (m *FormDataParameterSubSchema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitFormDataParameterSubSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Header and the nodes below it.
+func (m *Header) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitHeader(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a HeaderParameterSubSchema and the nodes below it.
+func (m *HeaderParameterSubSchema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitHeaderParameterSubSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Headers and the nodes below it.
+func (m *Headers) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitHeaders(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Info and the nodes below it.
+func (m *Info) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitInfo(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Contact.walk(visitor, keyPath(path, "contact")); err != nil {
+		return err
+	}
+	if err := m.License.walk(visitor, keyPath(path, "license")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ItemsItem and the nodes below it.
+func (m *ItemsItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitItemsItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.Schema {
+		if err := item.walk(visitor, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a JsonReference and the nodes below it.
+func (m *JsonReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitJsonReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a License and the nodes below it.
+func (m *License) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitLicense(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a NonBodyParameter and the nodes below it.
+func (m *NonBodyParameter) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitNonBodyParameter(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetHeaderParameterSubSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetFormDataParameterSubSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetQueryParameterSubSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetPathParameterSubSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Oauth2AccessCodeSecurity and the nodes below it.
+func (m *Oauth2AccessCodeSecurity) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauth2AccessCodeSecurity(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Scopes.walk(visitor, keyPath(path, "scopes")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Oauth2ApplicationSecurity and the nodes below it.
+func (m *Oauth2ApplicationSecurity) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauth2ApplicationSecurity(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Scopes.walk(visitor, keyPath(path, "scopes")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Oauth2ImplicitSecurity and the nodes below it.
+func (m *Oauth2ImplicitSecurity) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauth2ImplicitSecurity(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Scopes.walk(visitor, keyPath(path, "scopes")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Oauth2PasswordSecurity and the nodes below it.
+func (m *Oauth2PasswordSecurity) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauth2PasswordSecurity(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Scopes.walk(visitor, keyPath(path, "scopes")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Oauth2Scopes and the nodes below it.
+func (m *Oauth2Scopes) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauth2Scopes(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Operation and the nodes below it.
+func (m *Operation) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOperation(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	for i, item := range m.Parameters {
+		if err := item.walk(visitor, keyPath(path, "parameters", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.Responses.walk(visitor, keyPath(path, "responses")); err != nil {
+		return err
+	}
+	for i, item := range m.Security {
+		if err := item.walk(visitor, keyPath(path, "security", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Parameter and the nodes below it.
+func (m *Parameter) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParameter(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetBodyParameter().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetNonBodyParameter().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a ParameterDefinitions and the nodes below it.
+func (m *ParameterDefinitions) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParameterDefinitions(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ParametersItem and the nodes below it.
+func (m *ParametersItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParametersItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetParameter().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetJsonReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a PathItem and the nodes below it.
+func (m *PathItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitPathItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Get.walk(visitor, keyPath(path, "get")); err != nil {
+		return err
+	}
+	if err := m.Put.walk(visitor, keyPath(path, "put")); err != nil {
+		return err
+	}
+	if err := m.Post.walk(visitor, keyPath(path, "post")); err != nil {
+		return err
+	}
+	if err := m.Delete.walk(visitor, keyPath(path, "delete")); err != nil {
+		return err
+	}
+	if err := m.Options.walk(visitor, keyPath(path, "options")); err != nil {
+		return err
+	}
+	if err := m.Head.walk(visitor, keyPath(path, "head")); err != nil {
+		return err
+	}
+	if err := m.Patch.walk(visitor, keyPath(path, "patch")); err != nil {
+		return err
+	}
+	for i, item := range m.Parameters {
+		if err := item.walk(visitor, keyPath(path, "parameters", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a PathParameterSubSchema and the nodes below it.
+func (m *PathParameterSubSchema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitPathParameterSubSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Paths and the nodes below it.
+func (m *Paths) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitPaths(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.Path {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a PrimitivesItems and the nodes below it.
+func (m *PrimitivesItems) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitPrimitivesItems(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Properties and the nodes below it.
+func (m *Properties) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitProperties(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a QueryParameterSubSchema and the nodes below it.
+func (m *QueryParameterSubSchema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitQueryParameterSubSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Response and the nodes below it.
+func (m *Response) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponse(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Schema.walk(visitor, keyPath(path, "schema")); err != nil {
+		return err
+	}
+	if err := m.Headers.walk(visitor, keyPath(path, "headers")); err != nil {
+		return err
+	}
+	if err := m.Examples.walk(visitor, keyPath(path, "examples")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ResponseDefinitions and the nodes below it.
+func (m *ResponseDefinitions) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponseDefinitions(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ResponseValue and the nodes below it.
+func (m *ResponseValue) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponseValue(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetResponse().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetJsonReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Responses and the nodes below it.
+func (m *Responses) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponses(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.ResponseCode {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Schema and the nodes below it.
+func (m *Schema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.AdditionalProperties.walk(visitor, keyPath(path, "additionalProperties")); err != nil {
+		return err
+	}
+	if err := m.Type.walk(visitor, keyPath(path, "type")); err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	for i, item := range m.AllOf {
+		if err := item.walk(visitor, keyPath(path, "allOf", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.Properties.walk(visitor, keyPath(path, "properties")); err != nil {
+		return err
+	}
+	if err := m.Xml.walk(visitor, keyPath(path, "xml")); err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	if err := m.Example.walk(visitor, keyPath(path, "example")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SchemaItem and the nodes below it.
+func (m *SchemaItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchemaItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetFileSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a SecurityDefinitions and the nodes below it.
+func (m *SecurityDefinitions) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecurityDefinitions(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SecurityDefinitionsItem and the nodes below it.
+func (m *SecurityDefinitionsItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecurityDefinitionsItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetBasicAuthenticationSecurity().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetApiKeySecurity().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetOauth2ImplicitSecurity().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetOauth2PasswordSecurity().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetOauth2ApplicationSecurity().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetOauth2AccessCodeSecurity().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a SecurityRequirement and the nodes below it.
+func (m *SecurityRequirement) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecurityRequirement(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a StringArray and the nodes below it.
+func (m *StringArray) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitStringArray(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Tag and the nodes below it.
+func (m *Tag) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitTag(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a TypeItem and the nodes below it.
+func (m *TypeItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitTypeItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a VendorExtension and the nodes below it.
+func (m *VendorExtension) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitVendorExtension(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Xml and the nodes below it.
+func (m *Xml) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitXml(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.VendorExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Equal returns true if two AdditionalPropertiesItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *AdditionalPropertiesItem) Equal(other *AdditionalPropertiesItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *AdditionalPropertiesItem_Schema:
+		o, ok := other.Oneof.(*AdditionalPropertiesItem_Schema)
+		return ok && v.Schema.Equal(o.Schema)
+	case *AdditionalPropertiesItem_Boolean:
+		o, ok := other.Oneof.(*AdditionalPropertiesItem_Boolean)
+		return ok && v.Boolean == o.Boolean
+	}
+	return false
+}
+
+// Clone returns a deep copy of a AdditionalPropertiesItem.
+func (m *AdditionalPropertiesItem) Clone() *AdditionalPropertiesItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*AdditionalPropertiesItem)
+}
+
+// Merge overlays the contents of another AdditionalPropertiesItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *AdditionalPropertiesItem) Merge(other *AdditionalPropertiesItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*AdditionalPropertiesItem_Schema); ok && v.Schema != nil {
+		if o, ok := other.Oneof.(*AdditionalPropertiesItem_Schema); ok {
+			v.Schema.Merge(o.Schema)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Any objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Any) Equal(other *Any) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return proto.Equal(m, other)
+}
+
+// Clone returns a deep copy of a Any.
+func (m *Any) Clone() *Any {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Any)
+}
+
+// Merge overlays the contents of another Any onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Any) Merge(other *Any) {
+	if m == nil || other == nil {
+		return
+	}
+	*m = *other.Clone()
+}
+
+// Equal returns true if two ApiKeySecurity objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ApiKeySecurity) Equal(other *ApiKeySecurity) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ApiKeySecurity.
+func (m *ApiKeySecurity) Clone() *ApiKeySecurity {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ApiKeySecurity)
+}
+
+// Merge overlays the contents of another ApiKeySecurity onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ApiKeySecurity) Merge(other *ApiKeySecurity) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two BasicAuthenticationSecurity objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *BasicAuthenticationSecurity) Equal(other *BasicAuthenticationSecurity) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a BasicAuthenticationSecurity.
+func (m *BasicAuthenticationSecurity) Clone() *BasicAuthenticationSecurity {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*BasicAuthenticationSecurity)
+}
+
+// Merge overlays the contents of another BasicAuthenticationSecurity onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *BasicAuthenticationSecurity) Merge(other *BasicAuthenticationSecurity) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two BodyParameter objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *BodyParameter) Equal(other *BodyParameter) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if !m.Schema.Equal(other.Schema) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a BodyParameter.
+func (m *BodyParameter) Clone() *BodyParameter {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*BodyParameter)
+}
+
+// Merge overlays the contents of another BodyParameter onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *BodyParameter) Merge(other *BodyParameter) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.Schema != nil {
+		if m.Schema == nil {
+			m.Schema = other.Schema.Clone()
+		} else {
+			m.Schema.Merge(other.Schema)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Contact objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Contact) Equal(other *Contact) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if m.Email != other.Email {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Contact.
+func (m *Contact) Clone() *Contact {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Contact)
+}
+
+// Merge overlays the contents of another Contact onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Contact) Merge(other *Contact) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	if other.Email != "" {
+		m.Email = other.Email
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Default objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Default) Equal(other *Default) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Default.
+func (m *Default) Clone() *Default {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Default)
+}
+
+// Merge overlays the contents of another Default onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Default) Merge(other *Default) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Definitions objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Definitions) Equal(other *Definitions) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Definitions.
+func (m *Definitions) Clone() *Definitions {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Definitions)
+}
+
+// Merge overlays the contents of another Definitions onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Definitions) Merge(other *Definitions) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSchema))
+		}
+	}
+}
+
+// Equal returns true if two Document objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Document) Equal(other *Document) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Swagger != other.Swagger {
+		return false
+	}
+	if !m.Info.Equal(other.Info) {
+		return false
+	}
+	if m.Host != other.Host {
+		return false
+	}
+	if m.BasePath != other.BasePath {
+		return false
+	}
+	if len(m.Schemes) != len(other.Schemes) {
+		return false
+	}
+	for i := range m.Schemes {
+		if m.Schemes[i] != other.Schemes[i] {
+			return false
+		}
+	}
+	if len(m.Consumes) != len(other.Consumes) {
+		return false
+	}
+	for i := range m.Consumes {
+		if m.Consumes[i] != other.Consumes[i] {
+			return false
+		}
+	}
+	if len(m.Produces) != len(other.Produces) {
+		return false
+	}
+	for i := range m.Produces {
+		if m.Produces[i] != other.Produces[i] {
+			return false
+		}
+	}
+	if !m.Paths.Equal(other.Paths) {
+		return false
+	}
+	if !m.Definitions.Equal(other.Definitions) {
+		return false
+	}
+	if !m.Parameters.Equal(other.Parameters) {
+		return false
+	}
+	if !m.Responses.Equal(other.Responses) {
+		return false
+	}
+	if len(m.Security) != len(other.Security) {
+		return false
+	}
+	for i := range m.Security {
+		if !m.Security[i].Equal(other.Security[i]) {
+			return false
+		}
+	}
+	if !m.SecurityDefinitions.Equal(other.SecurityDefinitions) {
+		return false
+	}
+	if len(m.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range m.Tags {
+		if !m.Tags[i].Equal(other.Tags[i]) {
+			return false
+		}
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Document.
+func (m *Document) Clone() *Document {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Document)
+}
+
+// Merge overlays the contents of another Document onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Document) Merge(other *Document) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Swagger != "" {
+		m.Swagger = other.Swagger
+	}
+	if other.Info != nil {
+		if m.Info == nil {
+			m.Info = other.Info.Clone()
+		} else {
+			m.Info.Merge(other.Info)
+		}
+	}
+	if other.Host != "" {
+		m.Host = other.Host
+	}
+	if other.BasePath != "" {
+		m.BasePath = other.BasePath
+	}
+	if len(other.Schemes) != 0 {
+		m.Schemes = append([]string(nil), other.Schemes...)
+	}
+	if len(other.Consumes) != 0 {
+		m.Consumes = append([]string(nil), other.Consumes...)
+	}
+	if len(other.Produces) != 0 {
+		m.Produces = append([]string(nil), other.Produces...)
+	}
+	if other.Paths != nil {
+		if m.Paths == nil {
+			m.Paths = other.Paths.Clone()
+		} else {
+			m.Paths.Merge(other.Paths)
+		}
+	}
+	if other.Definitions != nil {
+		if m.Definitions == nil {
+			m.Definitions = other.Definitions.Clone()
+		} else {
+			m.Definitions.Merge(other.Definitions)
+		}
+	}
+	if other.Parameters != nil {
+		if m.Parameters == nil {
+			m.Parameters = other.Parameters.Clone()
+		} else {
+			m.Parameters.Merge(other.Parameters)
+		}
+	}
+	if other.Responses != nil {
+		if m.Responses == nil {
+			m.Responses = other.Responses.Clone()
+		} else {
+			m.Responses.Merge(other.Responses)
+		}
+	}
+	if len(other.Security) != 0 {
+		m.Security = make([]*SecurityRequirement, len(other.Security))
+		for i, item := range other.Security {
+			m.Security[i] = item.Clone()
+		}
+	}
+	if other.SecurityDefinitions != nil {
+		if m.SecurityDefinitions == nil {
+			m.SecurityDefinitions = other.SecurityDefinitions.Clone()
+		} else {
+			m.SecurityDefinitions.Merge(other.SecurityDefinitions)
+		}
+	}
+	if len(other.Tags) != 0 {
+		m.Tags = make([]*Tag, len(other.Tags))
+		for i, item := range other.Tags {
+			m.Tags[i] = item.Clone()
+		}
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Examples objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Examples) Equal(other *Examples) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Examples.
+func (m *Examples) Clone() *Examples {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Examples)
+}
+
+// Merge overlays the contents of another Examples onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Examples) Merge(other *Examples) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ExternalDocs objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ExternalDocs) Equal(other *ExternalDocs) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ExternalDocs.
+func (m *ExternalDocs) Clone() *ExternalDocs {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ExternalDocs)
+}
+
+// Merge overlays the contents of another ExternalDocs onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ExternalDocs) Merge(other *ExternalDocs) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two FileSchema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *FileSchema) Equal(other *FileSchema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if len(m.Required) != len(other.Required) {
+		return false
+	}
+	for i := range m.Required {
+		if m.Required[i] != other.Required[i] {
+			return false
+		}
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.ReadOnly != other.ReadOnly {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if !m.Example.Equal(other.Example) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a FileSchema.
+func (m *FileSchema) Clone() *FileSchema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*FileSchema)
+}
+
+// Merge overlays the contents of another FileSchema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *FileSchema) Merge(other *FileSchema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Title != "" {
+		m.Title = other.Title
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if len(other.Required) != 0 {
+		m.Required = append([]string(nil), other.Required...)
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.ReadOnly {
+		m.ReadOnly = true
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	if other.Example != nil {
+		if m.Example == nil {
+			m.Example = other.Example.Clone()
+		} else {
+			m.Example.Merge(other.Example)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two FormDataParameterSubSchema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *FormDataParameterSubSchema) Equal(other *FormDataParameterSubSchema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.AllowEmptyValue != other.AllowEmptyValue {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.CollectionFormat != other.CollectionFormat {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a FormDataParameterSubSchema.
+func (m *FormDataParameterSubSchema) Clone() *FormDataParameterSubSchema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*FormDataParameterSubSchema)
+}
+
+// Merge overlays the contents of another FormDataParameterSubSchema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *FormDataParameterSubSchema) Merge(other *FormDataParameterSubSchema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.AllowEmptyValue {
+		m.AllowEmptyValue = true
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.CollectionFormat != "" {
+		m.CollectionFormat = other.CollectionFormat
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Header objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Header) Equal(other *Header) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.CollectionFormat != other.CollectionFormat {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Header.
+func (m *Header) Clone() *Header {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Header)
+}
+
+// Merge overlays the contents of another Header onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Header) Merge(other *Header) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.CollectionFormat != "" {
+		m.CollectionFormat = other.CollectionFormat
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two HeaderParameterSubSchema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *HeaderParameterSubSchema) Equal(other *HeaderParameterSubSchema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.CollectionFormat != other.CollectionFormat {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a HeaderParameterSubSchema.
+func (m *HeaderParameterSubSchema) Clone() *HeaderParameterSubSchema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*HeaderParameterSubSchema)
+}
+
+// Merge overlays the contents of another HeaderParameterSubSchema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *HeaderParameterSubSchema) Merge(other *HeaderParameterSubSchema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.CollectionFormat != "" {
+		m.CollectionFormat = other.CollectionFormat
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Headers objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Headers) Equal(other *Headers) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Headers.
+func (m *Headers) Clone() *Headers {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Headers)
+}
+
+// Merge overlays the contents of another Headers onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Headers) Merge(other *Headers) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedHeader))
+		}
+	}
+}
+
+// Equal returns true if two Info objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Info) Equal(other *Info) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Version != other.Version {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.TermsOfService != other.TermsOfService {
+		return false
+	}
+	if !m.Contact.Equal(other.Contact) {
+		return false
+	}
+	if !m.License.Equal(other.License) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Info.
+func (m *Info) Clone() *Info {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Info)
+}
+
+// Merge overlays the contents of another Info onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Info) Merge(other *Info) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Title != "" {
+		m.Title = other.Title
+	}
+	if other.Version != "" {
+		m.Version = other.Version
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.TermsOfService != "" {
+		m.TermsOfService = other.TermsOfService
+	}
+	if other.Contact != nil {
+		if m.Contact == nil {
+			m.Contact = other.Contact.Clone()
+		} else {
+			m.Contact.Merge(other.Contact)
+		}
+	}
+	if other.License != nil {
+		if m.License == nil {
+			m.License = other.License.Clone()
+		} else {
+			m.License.Merge(other.License)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ItemsItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ItemsItem) Equal(other *ItemsItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Schema) != len(other.Schema) {
+		return false
+	}
+	for i := range m.Schema {
+		if !m.Schema[i].Equal(other.Schema[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ItemsItem.
+func (m *ItemsItem) Clone() *ItemsItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ItemsItem)
+}
+
+// Merge overlays the contents of another ItemsItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ItemsItem) Merge(other *ItemsItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Schema) != 0 {
+		m.Schema = make([]*Schema, len(other.Schema))
+		for i, item := range other.Schema {
+			m.Schema[i] = item.Clone()
+		}
+	}
+}
+
+// Equal returns true if two JsonReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *JsonReference) Equal(other *JsonReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a JsonReference.
+func (m *JsonReference) Clone() *JsonReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*JsonReference)
+}
+
+// Merge overlays the contents of another JsonReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *JsonReference) Merge(other *JsonReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+}
+
+// Equal returns true if two License objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *License) Equal(other *License) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a License.
+func (m *License) Clone() *License {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*License)
+}
+
+// Merge overlays the contents of another License onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *License) Merge(other *License) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two NonBodyParameter objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *NonBodyParameter) Equal(other *NonBodyParameter) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *NonBodyParameter_HeaderParameterSubSchema:
+		o, ok := other.Oneof.(*NonBodyParameter_HeaderParameterSubSchema)
+		return ok && v.HeaderParameterSubSchema.Equal(o.HeaderParameterSubSchema)
+	case *NonBodyParameter_FormDataParameterSubSchema:
+		o, ok := other.Oneof.(*NonBodyParameter_FormDataParameterSubSchema)
+		return ok && v.FormDataParameterSubSchema.Equal(o.FormDataParameterSubSchema)
+	case *NonBodyParameter_QueryParameterSubSchema:
+		o, ok := other.Oneof.(*NonBodyParameter_QueryParameterSubSchema)
+		return ok && v.QueryParameterSubSchema.Equal(o.QueryParameterSubSchema)
+	case *NonBodyParameter_PathParameterSubSchema:
+		o, ok := other.Oneof.(*NonBodyParameter_PathParameterSubSchema)
+		return ok && v.PathParameterSubSchema.Equal(o.PathParameterSubSchema)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a NonBodyParameter.
+func (m *NonBodyParameter) Clone() *NonBodyParameter {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*NonBodyParameter)
+}
+
+// Merge overlays the contents of another NonBodyParameter onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *NonBodyParameter) Merge(other *NonBodyParameter) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*NonBodyParameter_HeaderParameterSubSchema); ok && v.HeaderParameterSubSchema != nil {
+		if o, ok := other.Oneof.(*NonBodyParameter_HeaderParameterSubSchema); ok {
+			v.HeaderParameterSubSchema.Merge(o.HeaderParameterSubSchema)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*NonBodyParameter_FormDataParameterSubSchema); ok && v.FormDataParameterSubSchema != nil {
+		if o, ok := other.Oneof.(*NonBodyParameter_FormDataParameterSubSchema); ok {
+			v.FormDataParameterSubSchema.Merge(o.FormDataParameterSubSchema)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*NonBodyParameter_QueryParameterSubSchema); ok && v.QueryParameterSubSchema != nil {
+		if o, ok := other.Oneof.(*NonBodyParameter_QueryParameterSubSchema); ok {
+			v.QueryParameterSubSchema.Merge(o.QueryParameterSubSchema)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*NonBodyParameter_PathParameterSubSchema); ok && v.PathParameterSubSchema != nil {
+		if o, ok := other.Oneof.(*NonBodyParameter_PathParameterSubSchema); ok {
+			v.PathParameterSubSchema.Merge(o.PathParameterSubSchema)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Oauth2AccessCodeSecurity objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Oauth2AccessCodeSecurity) Equal(other *Oauth2AccessCodeSecurity) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Flow != other.Flow {
+		return false
+	}
+	if !m.Scopes.Equal(other.Scopes) {
+		return false
+	}
+	if m.AuthorizationUrl != other.AuthorizationUrl {
+		return false
+	}
+	if m.TokenUrl != other.TokenUrl {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Oauth2AccessCodeSecurity.
+func (m *Oauth2AccessCodeSecurity) Clone() *Oauth2AccessCodeSecurity {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Oauth2AccessCodeSecurity)
+}
+
+// Merge overlays the contents of another Oauth2AccessCodeSecurity onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Oauth2AccessCodeSecurity) Merge(other *Oauth2AccessCodeSecurity) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Flow != "" {
+		m.Flow = other.Flow
+	}
+	if other.Scopes != nil {
+		if m.Scopes == nil {
+			m.Scopes = other.Scopes.Clone()
+		} else {
+			m.Scopes.Merge(other.Scopes)
+		}
+	}
+	if other.AuthorizationUrl != "" {
+		m.AuthorizationUrl = other.AuthorizationUrl
+	}
+	if other.TokenUrl != "" {
+		m.TokenUrl = other.TokenUrl
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Oauth2ApplicationSecurity objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Oauth2ApplicationSecurity) Equal(other *Oauth2ApplicationSecurity) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Flow != other.Flow {
+		return false
+	}
+	if !m.Scopes.Equal(other.Scopes) {
+		return false
+	}
+	if m.TokenUrl != other.TokenUrl {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Oauth2ApplicationSecurity.
+func (m *Oauth2ApplicationSecurity) Clone() *Oauth2ApplicationSecurity {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Oauth2ApplicationSecurity)
+}
+
+// Merge overlays the contents of another Oauth2ApplicationSecurity onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Oauth2ApplicationSecurity) Merge(other *Oauth2ApplicationSecurity) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Flow != "" {
+		m.Flow = other.Flow
+	}
+	if other.Scopes != nil {
+		if m.Scopes == nil {
+			m.Scopes = other.Scopes.Clone()
+		} else {
+			m.Scopes.Merge(other.Scopes)
+		}
+	}
+	if other.TokenUrl != "" {
+		m.TokenUrl = other.TokenUrl
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Oauth2ImplicitSecurity objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Oauth2ImplicitSecurity) Equal(other *Oauth2ImplicitSecurity) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Flow != other.Flow {
+		return false
+	}
+	if !m.Scopes.Equal(other.Scopes) {
+		return false
+	}
+	if m.AuthorizationUrl != other.AuthorizationUrl {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Oauth2ImplicitSecurity.
+func (m *Oauth2ImplicitSecurity) Clone() *Oauth2ImplicitSecurity {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Oauth2ImplicitSecurity)
+}
+
+// Merge overlays the contents of another Oauth2ImplicitSecurity onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Oauth2ImplicitSecurity) Merge(other *Oauth2ImplicitSecurity) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Flow != "" {
+		m.Flow = other.Flow
+	}
+	if other.Scopes != nil {
+		if m.Scopes == nil {
+			m.Scopes = other.Scopes.Clone()
+		} else {
+			m.Scopes.Merge(other.Scopes)
+		}
+	}
+	if other.AuthorizationUrl != "" {
+		m.AuthorizationUrl = other.AuthorizationUrl
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Oauth2PasswordSecurity objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Oauth2PasswordSecurity) Equal(other *Oauth2PasswordSecurity) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Flow != other.Flow {
+		return false
+	}
+	if !m.Scopes.Equal(other.Scopes) {
+		return false
+	}
+	if m.TokenUrl != other.TokenUrl {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Oauth2PasswordSecurity.
+func (m *Oauth2PasswordSecurity) Clone() *Oauth2PasswordSecurity {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Oauth2PasswordSecurity)
+}
+
+// Merge overlays the contents of another Oauth2PasswordSecurity onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Oauth2PasswordSecurity) Merge(other *Oauth2PasswordSecurity) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Flow != "" {
+		m.Flow = other.Flow
+	}
+	if other.Scopes != nil {
+		if m.Scopes == nil {
+			m.Scopes = other.Scopes.Clone()
+		} else {
+			m.Scopes.Merge(other.Scopes)
+		}
+	}
+	if other.TokenUrl != "" {
+		m.TokenUrl = other.TokenUrl
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Oauth2Scopes objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Oauth2Scopes) Equal(other *Oauth2Scopes) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value == otherItem.Value {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Oauth2Scopes.
+func (m *Oauth2Scopes) Clone() *Oauth2Scopes {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Oauth2Scopes)
+}
+
+// Merge overlays the contents of another Oauth2Scopes onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Oauth2Scopes) Merge(other *Oauth2Scopes) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				existing.Value = item.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedString))
+		}
+	}
+}
+
+// Equal returns true if two Operation objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Operation) Equal(other *Operation) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range m.Tags {
+		if m.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if m.Summary != other.Summary {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if m.OperationId != other.OperationId {
+		return false
+	}
+	if len(m.Produces) != len(other.Produces) {
+		return false
+	}
+	for i := range m.Produces {
+		if m.Produces[i] != other.Produces[i] {
+			return false
+		}
+	}
+	if len(m.Consumes) != len(other.Consumes) {
+		return false
+	}
+	for i := range m.Consumes {
+		if m.Consumes[i] != other.Consumes[i] {
+			return false
+		}
+	}
+	if len(m.Parameters) != len(other.Parameters) {
+		return false
+	}
+	for i := range m.Parameters {
+		if !m.Parameters[i].Equal(other.Parameters[i]) {
+			return false
+		}
+	}
+	if !m.Responses.Equal(other.Responses) {
+		return false
+	}
+	if len(m.Schemes) != len(other.Schemes) {
+		return false
+	}
+	for i := range m.Schemes {
+		if m.Schemes[i] != other.Schemes[i] {
+			return false
+		}
+	}
+	if m.Deprecated != other.Deprecated {
+		return false
+	}
+	if len(m.Security) != len(other.Security) {
+		return false
+	}
+	for i := range m.Security {
+		if !m.Security[i].Equal(other.Security[i]) {
+			return false
+		}
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Operation.
+func (m *Operation) Clone() *Operation {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Operation)
+}
+
+// Merge overlays the contents of another Operation onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Operation) Merge(other *Operation) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Tags) != 0 {
+		m.Tags = append([]string(nil), other.Tags...)
+	}
+	if other.Summary != "" {
+		m.Summary = other.Summary
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	if other.OperationId != "" {
+		m.OperationId = other.OperationId
+	}
+	if len(other.Produces) != 0 {
+		m.Produces = append([]string(nil), other.Produces...)
+	}
+	if len(other.Consumes) != 0 {
+		m.Consumes = append([]string(nil), other.Consumes...)
+	}
+	if len(other.Parameters) != 0 {
+		m.Parameters = make([]*ParametersItem, len(other.Parameters))
+		for i, item := range other.Parameters {
+			m.Parameters[i] = item.Clone()
+		}
+	}
+	if other.Responses != nil {
+		if m.Responses == nil {
+			m.Responses = other.Responses.Clone()
+		} else {
+			m.Responses.Merge(other.Responses)
+		}
+	}
+	if len(other.Schemes) != 0 {
+		m.Schemes = append([]string(nil), other.Schemes...)
+	}
+	if other.Deprecated {
+		m.Deprecated = true
+	}
+	if len(other.Security) != 0 {
+		m.Security = make([]*SecurityRequirement, len(other.Security))
+		for i, item := range other.Security {
+			m.Security[i] = item.Clone()
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Parameter objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Parameter) Equal(other *Parameter) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *Parameter_BodyParameter:
+		o, ok := other.Oneof.(*Parameter_BodyParameter)
+		return ok && v.BodyParameter.Equal(o.BodyParameter)
+	case *Parameter_NonBodyParameter:
+		o, ok := other.Oneof.(*Parameter_NonBodyParameter)
+		return ok && v.NonBodyParameter.Equal(o.NonBodyParameter)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a Parameter.
+func (m *Parameter) Clone() *Parameter {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Parameter)
+}
+
+// Merge overlays the contents of another Parameter onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Parameter) Merge(other *Parameter) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*Parameter_BodyParameter); ok && v.BodyParameter != nil {
+		if o, ok := other.Oneof.(*Parameter_BodyParameter); ok {
+			v.BodyParameter.Merge(o.BodyParameter)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*Parameter_NonBodyParameter); ok && v.NonBodyParameter != nil {
+		if o, ok := other.Oneof.(*Parameter_NonBodyParameter); ok {
+			v.NonBodyParameter.Merge(o.NonBodyParameter)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two ParameterDefinitions objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ParameterDefinitions) Equal(other *ParameterDefinitions) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ParameterDefinitions.
+func (m *ParameterDefinitions) Clone() *ParameterDefinitions {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ParameterDefinitions)
+}
+
+// Merge overlays the contents of another ParameterDefinitions onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ParameterDefinitions) Merge(other *ParameterDefinitions) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedParameter))
+		}
+	}
+}
+
+// Equal returns true if two ParametersItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ParametersItem) Equal(other *ParametersItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *ParametersItem_Parameter:
+		o, ok := other.Oneof.(*ParametersItem_Parameter)
+		return ok && v.Parameter.Equal(o.Parameter)
+	case *ParametersItem_JsonReference:
+		o, ok := other.Oneof.(*ParametersItem_JsonReference)
+		return ok && v.JsonReference.Equal(o.JsonReference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a ParametersItem.
+func (m *ParametersItem) Clone() *ParametersItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ParametersItem)
+}
+
+// Merge overlays the contents of another ParametersItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ParametersItem) Merge(other *ParametersItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*ParametersItem_Parameter); ok && v.Parameter != nil {
+		if o, ok := other.Oneof.(*ParametersItem_Parameter); ok {
+			v.Parameter.Merge(o.Parameter)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*ParametersItem_JsonReference); ok && v.JsonReference != nil {
+		if o, ok := other.Oneof.(*ParametersItem_JsonReference); ok {
+			v.JsonReference.Merge(o.JsonReference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two PathItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *PathItem) Equal(other *PathItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if !m.Get.Equal(other.Get) {
+		return false
+	}
+	if !m.Put.Equal(other.Put) {
+		return false
+	}
+	if !m.Post.Equal(other.Post) {
+		return false
+	}
+	if !m.Delete.Equal(other.Delete) {
+		return false
+	}
+	if !m.Options.Equal(other.Options) {
+		return false
+	}
+	if !m.Head.Equal(other.Head) {
+		return false
+	}
+	if !m.Patch.Equal(other.Patch) {
+		return false
+	}
+	if len(m.Parameters) != len(other.Parameters) {
+		return false
+	}
+	for i := range m.Parameters {
+		if !m.Parameters[i].Equal(other.Parameters[i]) {
+			return false
+		}
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a PathItem.
+func (m *PathItem) Clone() *PathItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*PathItem)
+}
+
+// Merge overlays the contents of another PathItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *PathItem) Merge(other *PathItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.Get != nil {
+		if m.Get == nil {
+			m.Get = other.Get.Clone()
+		} else {
+			m.Get.Merge(other.Get)
+		}
+	}
+	if other.Put != nil {
+		if m.Put == nil {
+			m.Put = other.Put.Clone()
+		} else {
+			m.Put.Merge(other.Put)
+		}
+	}
+	if other.Post != nil {
+		if m.Post == nil {
+			m.Post = other.Post.Clone()
+		} else {
+			m.Post.Merge(other.Post)
+		}
+	}
+	if other.Delete != nil {
+		if m.Delete == nil {
+			m.Delete = other.Delete.Clone()
+		} else {
+			m.Delete.Merge(other.Delete)
+		}
+	}
+	if other.Options != nil {
+		if m.Options == nil {
+			m.Options = other.Options.Clone()
+		} else {
+			m.Options.Merge(other.Options)
+		}
+	}
+	if other.Head != nil {
+		if m.Head == nil {
+			m.Head = other.Head.Clone()
+		} else {
+			m.Head.Merge(other.Head)
+		}
+	}
+	if other.Patch != nil {
+		if m.Patch == nil {
+			m.Patch = other.Patch.Clone()
+		} else {
+			m.Patch.Merge(other.Patch)
+		}
+	}
+	if len(other.Parameters) != 0 {
+		m.Parameters = make([]*ParametersItem, len(other.Parameters))
+		for i, item := range other.Parameters {
+			m.Parameters[i] = item.Clone()
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two PathParameterSubSchema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *PathParameterSubSchema) Equal(other *PathParameterSubSchema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.CollectionFormat != other.CollectionFormat {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a PathParameterSubSchema.
+func (m *PathParameterSubSchema) Clone() *PathParameterSubSchema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*PathParameterSubSchema)
+}
+
+// Merge overlays the contents of another PathParameterSubSchema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *PathParameterSubSchema) Merge(other *PathParameterSubSchema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.CollectionFormat != "" {
+		m.CollectionFormat = other.CollectionFormat
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Paths objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Paths) Equal(other *Paths) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(m.Path) != len(other.Path) {
+		return false
+	}
+	matchedPath := make([]bool, len(other.Path))
+	for _, item := range m.Path {
+		found := false
+		for j, otherItem := range other.Path {
+			if !matchedPath[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedPath[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Paths.
+func (m *Paths) Clone() *Paths {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Paths)
+}
+
+// Merge overlays the contents of another Paths onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Paths) Merge(other *Paths) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+	for _, item := range other.Path {
+		found := false
+		for _, existing := range m.Path {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.Path = append(m.Path, proto.Clone(item).(*NamedPathItem))
+		}
+	}
+}
+
+// Equal returns true if two PrimitivesItems objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *PrimitivesItems) Equal(other *PrimitivesItems) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.CollectionFormat != other.CollectionFormat {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a PrimitivesItems.
+func (m *PrimitivesItems) Clone() *PrimitivesItems {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*PrimitivesItems)
+}
+
+// Merge overlays the contents of another PrimitivesItems onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *PrimitivesItems) Merge(other *PrimitivesItems) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.CollectionFormat != "" {
+		m.CollectionFormat = other.CollectionFormat
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Properties objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Properties) Equal(other *Properties) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Properties.
+func (m *Properties) Clone() *Properties {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Properties)
+}
+
+// Merge overlays the contents of another Properties onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Properties) Merge(other *Properties) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSchema))
+		}
+	}
+}
+
+// Equal returns true if two QueryParameterSubSchema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *QueryParameterSubSchema) Equal(other *QueryParameterSubSchema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.AllowEmptyValue != other.AllowEmptyValue {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.CollectionFormat != other.CollectionFormat {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a QueryParameterSubSchema.
+func (m *QueryParameterSubSchema) Clone() *QueryParameterSubSchema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*QueryParameterSubSchema)
+}
+
+// Merge overlays the contents of another QueryParameterSubSchema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *QueryParameterSubSchema) Merge(other *QueryParameterSubSchema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.AllowEmptyValue {
+		m.AllowEmptyValue = true
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.CollectionFormat != "" {
+		m.CollectionFormat = other.CollectionFormat
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Response objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Response) Equal(other *Response) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Schema.Equal(other.Schema) {
+		return false
+	}
+	if !m.Headers.Equal(other.Headers) {
+		return false
+	}
+	if !m.Examples.Equal(other.Examples) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Response.
+func (m *Response) Clone() *Response {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Response)
+}
+
+// Merge overlays the contents of another Response onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Response) Merge(other *Response) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Schema != nil {
+		if m.Schema == nil {
+			m.Schema = other.Schema.Clone()
+		} else {
+			m.Schema.Merge(other.Schema)
+		}
+	}
+	if other.Headers != nil {
+		if m.Headers == nil {
+			m.Headers = other.Headers.Clone()
+		} else {
+			m.Headers.Merge(other.Headers)
+		}
+	}
+	if other.Examples != nil {
+		if m.Examples == nil {
+			m.Examples = other.Examples.Clone()
+		} else {
+			m.Examples.Merge(other.Examples)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ResponseDefinitions objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ResponseDefinitions) Equal(other *ResponseDefinitions) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ResponseDefinitions.
+func (m *ResponseDefinitions) Clone() *ResponseDefinitions {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ResponseDefinitions)
+}
+
+// Merge overlays the contents of another ResponseDefinitions onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ResponseDefinitions) Merge(other *ResponseDefinitions) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedResponse))
+		}
+	}
+}
+
+// Equal returns true if two ResponseValue objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ResponseValue) Equal(other *ResponseValue) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *ResponseValue_Response:
+		o, ok := other.Oneof.(*ResponseValue_Response)
+		return ok && v.Response.Equal(o.Response)
+	case *ResponseValue_JsonReference:
+		o, ok := other.Oneof.(*ResponseValue_JsonReference)
+		return ok && v.JsonReference.Equal(o.JsonReference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a ResponseValue.
+func (m *ResponseValue) Clone() *ResponseValue {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ResponseValue)
+}
+
+// Merge overlays the contents of another ResponseValue onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ResponseValue) Merge(other *ResponseValue) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*ResponseValue_Response); ok && v.Response != nil {
+		if o, ok := other.Oneof.(*ResponseValue_Response); ok {
+			v.Response.Merge(o.Response)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*ResponseValue_JsonReference); ok && v.JsonReference != nil {
+		if o, ok := other.Oneof.(*ResponseValue_JsonReference); ok {
+			v.JsonReference.Merge(o.JsonReference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Responses objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Responses) Equal(other *Responses) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.ResponseCode) != len(other.ResponseCode) {
+		return false
+	}
+	matchedResponseCode := make([]bool, len(other.ResponseCode))
+	for _, item := range m.ResponseCode {
+		found := false
+		for j, otherItem := range other.ResponseCode {
+			if !matchedResponseCode[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedResponseCode[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Responses.
+func (m *Responses) Clone() *Responses {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Responses)
+}
+
+// Merge overlays the contents of another Responses onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Responses) Merge(other *Responses) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.ResponseCode {
+		found := false
+		for _, existing := range m.ResponseCode {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.ResponseCode = append(m.ResponseCode, proto.Clone(item).(*NamedResponseValue))
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Schema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Schema) Equal(other *Schema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if m.MaxProperties != other.MaxProperties {
+		return false
+	}
+	if m.MinProperties != other.MinProperties {
+		return false
+	}
+	if len(m.Required) != len(other.Required) {
+		return false
+	}
+	for i := range m.Required {
+		if m.Required[i] != other.Required[i] {
+			return false
+		}
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if !m.AdditionalProperties.Equal(other.AdditionalProperties) {
+		return false
+	}
+	if !m.Type.Equal(other.Type) {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if len(m.AllOf) != len(other.AllOf) {
+		return false
+	}
+	for i := range m.AllOf {
+		if !m.AllOf[i].Equal(other.AllOf[i]) {
+			return false
+		}
+	}
+	if !m.Properties.Equal(other.Properties) {
+		return false
+	}
+	if m.Discriminator != other.Discriminator {
+		return false
+	}
+	if m.ReadOnly != other.ReadOnly {
+		return false
+	}
+	if !m.Xml.Equal(other.Xml) {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if !m.Example.Equal(other.Example) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Schema.
+func (m *Schema) Clone() *Schema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Schema)
+}
+
+// Merge overlays the contents of another Schema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Schema) Merge(other *Schema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Title != "" {
+		m.Title = other.Title
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if other.MaxProperties != 0 {
+		m.MaxProperties = other.MaxProperties
+	}
+	if other.MinProperties != 0 {
+		m.MinProperties = other.MinProperties
+	}
+	if len(other.Required) != 0 {
+		m.Required = append([]string(nil), other.Required...)
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.AdditionalProperties != nil {
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = other.AdditionalProperties.Clone()
+		} else {
+			m.AdditionalProperties.Merge(other.AdditionalProperties)
+		}
+	}
+	if other.Type != nil {
+		if m.Type == nil {
+			m.Type = other.Type.Clone()
+		} else {
+			m.Type.Merge(other.Type)
+		}
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if len(other.AllOf) != 0 {
+		m.AllOf = make([]*Schema, len(other.AllOf))
+		for i, item := range other.AllOf {
+			m.AllOf[i] = item.Clone()
+		}
+	}
+	if other.Properties != nil {
+		if m.Properties == nil {
+			m.Properties = other.Properties.Clone()
+		} else {
+			m.Properties.Merge(other.Properties)
+		}
+	}
+	if other.Discriminator != "" {
+		m.Discriminator = other.Discriminator
+	}
+	if other.ReadOnly {
+		m.ReadOnly = true
+	}
+	if other.Xml != nil {
+		if m.Xml == nil {
+			m.Xml = other.Xml.Clone()
+		} else {
+			m.Xml.Merge(other.Xml)
+		}
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	if other.Example != nil {
+		if m.Example == nil {
+			m.Example = other.Example.Clone()
+		} else {
+			m.Example.Merge(other.Example)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two SchemaItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SchemaItem) Equal(other *SchemaItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *SchemaItem_Schema:
+		o, ok := other.Oneof.(*SchemaItem_Schema)
+		return ok && v.Schema.Equal(o.Schema)
+	case *SchemaItem_FileSchema:
+		o, ok := other.Oneof.(*SchemaItem_FileSchema)
+		return ok && v.FileSchema.Equal(o.FileSchema)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a SchemaItem.
+func (m *SchemaItem) Clone() *SchemaItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SchemaItem)
+}
+
+// Merge overlays the contents of another SchemaItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SchemaItem) Merge(other *SchemaItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*SchemaItem_Schema); ok && v.Schema != nil {
+		if o, ok := other.Oneof.(*SchemaItem_Schema); ok {
+			v.Schema.Merge(o.Schema)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SchemaItem_FileSchema); ok && v.FileSchema != nil {
+		if o, ok := other.Oneof.(*SchemaItem_FileSchema); ok {
+			v.FileSchema.Merge(o.FileSchema)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two SecurityDefinitions objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecurityDefinitions) Equal(other *SecurityDefinitions) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a SecurityDefinitions.
+func (m *SecurityDefinitions) Clone() *SecurityDefinitions {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecurityDefinitions)
+}
+
+// Merge overlays the contents of another SecurityDefinitions onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecurityDefinitions) Merge(other *SecurityDefinitions) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSecurityDefinitionsItem))
+		}
+	}
+}
+
+// Equal returns true if two SecurityDefinitionsItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecurityDefinitionsItem) Equal(other *SecurityDefinitionsItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *SecurityDefinitionsItem_BasicAuthenticationSecurity:
+		o, ok := other.Oneof.(*SecurityDefinitionsItem_BasicAuthenticationSecurity)
+		return ok && v.BasicAuthenticationSecurity.Equal(o.BasicAuthenticationSecurity)
+	case *SecurityDefinitionsItem_ApiKeySecurity:
+		o, ok := other.Oneof.(*SecurityDefinitionsItem_ApiKeySecurity)
+		return ok && v.ApiKeySecurity.Equal(o.ApiKeySecurity)
+	case *SecurityDefinitionsItem_Oauth2ImplicitSecurity:
+		o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2ImplicitSecurity)
+		return ok && v.Oauth2ImplicitSecurity.Equal(o.Oauth2ImplicitSecurity)
+	case *SecurityDefinitionsItem_Oauth2PasswordSecurity:
+		o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2PasswordSecurity)
+		return ok && v.Oauth2PasswordSecurity.Equal(o.Oauth2PasswordSecurity)
+	case *SecurityDefinitionsItem_Oauth2ApplicationSecurity:
+		o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2ApplicationSecurity)
+		return ok && v.Oauth2ApplicationSecurity.Equal(o.Oauth2ApplicationSecurity)
+	case *SecurityDefinitionsItem_Oauth2AccessCodeSecurity:
+		o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2AccessCodeSecurity)
+		return ok && v.Oauth2AccessCodeSecurity.Equal(o.Oauth2AccessCodeSecurity)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a SecurityDefinitionsItem.
+func (m *SecurityDefinitionsItem) Clone() *SecurityDefinitionsItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecurityDefinitionsItem)
+}
+
+// Merge overlays the contents of another SecurityDefinitionsItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecurityDefinitionsItem) Merge(other *SecurityDefinitionsItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*SecurityDefinitionsItem_BasicAuthenticationSecurity); ok && v.BasicAuthenticationSecurity != nil {
+		if o, ok := other.Oneof.(*SecurityDefinitionsItem_BasicAuthenticationSecurity); ok {
+			v.BasicAuthenticationSecurity.Merge(o.BasicAuthenticationSecurity)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SecurityDefinitionsItem_ApiKeySecurity); ok && v.ApiKeySecurity != nil {
+		if o, ok := other.Oneof.(*SecurityDefinitionsItem_ApiKeySecurity); ok {
+			v.ApiKeySecurity.Merge(o.ApiKeySecurity)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SecurityDefinitionsItem_Oauth2ImplicitSecurity); ok && v.Oauth2ImplicitSecurity != nil {
+		if o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2ImplicitSecurity); ok {
+			v.Oauth2ImplicitSecurity.Merge(o.Oauth2ImplicitSecurity)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SecurityDefinitionsItem_Oauth2PasswordSecurity); ok && v.Oauth2PasswordSecurity != nil {
+		if o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2PasswordSecurity); ok {
+			v.Oauth2PasswordSecurity.Merge(o.Oauth2PasswordSecurity)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SecurityDefinitionsItem_Oauth2ApplicationSecurity); ok && v.Oauth2ApplicationSecurity != nil {
+		if o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2ApplicationSecurity); ok {
+			v.Oauth2ApplicationSecurity.Merge(o.Oauth2ApplicationSecurity)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SecurityDefinitionsItem_Oauth2AccessCodeSecurity); ok && v.Oauth2AccessCodeSecurity != nil {
+		if o, ok := other.Oneof.(*SecurityDefinitionsItem_Oauth2AccessCodeSecurity); ok {
+			v.Oauth2AccessCodeSecurity.Merge(o.Oauth2AccessCodeSecurity)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two SecurityRequirement objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecurityRequirement) Equal(other *SecurityRequirement) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a SecurityRequirement.
+func (m *SecurityRequirement) Clone() *SecurityRequirement {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecurityRequirement)
+}
+
+// Merge overlays the contents of another SecurityRequirement onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecurityRequirement) Merge(other *SecurityRequirement) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedStringArray))
+		}
+	}
+}
+
+// Equal returns true if two StringArray objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *StringArray) Equal(other *StringArray) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Value) != len(other.Value) {
+		return false
+	}
+	for i := range m.Value {
+		if m.Value[i] != other.Value[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a StringArray.
+func (m *StringArray) Clone() *StringArray {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*StringArray)
+}
+
+// Merge overlays the contents of another StringArray onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *StringArray) Merge(other *StringArray) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Value) != 0 {
+		m.Value = append([]string(nil), other.Value...)
+	}
+}
+
+// Equal returns true if two Tag objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Tag) Equal(other *Tag) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Tag.
+func (m *Tag) Clone() *Tag {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Tag)
+}
+
+// Merge overlays the contents of another Tag onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Tag) Merge(other *Tag) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two TypeItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *TypeItem) Equal(other *TypeItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Value) != len(other.Value) {
+		return false
+	}
+	for i := range m.Value {
+		if m.Value[i] != other.Value[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a TypeItem.
+func (m *TypeItem) Clone() *TypeItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*TypeItem)
+}
+
+// Merge overlays the contents of another TypeItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *TypeItem) Merge(other *TypeItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Value) != 0 {
+		m.Value = append([]string(nil), other.Value...)
+	}
+}
+
+// Equal returns true if two VendorExtension objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *VendorExtension) Equal(other *VendorExtension) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a VendorExtension.
+func (m *VendorExtension) Clone() *VendorExtension {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*VendorExtension)
+}
+
+// Merge overlays the contents of another VendorExtension onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *VendorExtension) Merge(other *VendorExtension) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Xml objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Xml) Equal(other *Xml) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Namespace != other.Namespace {
+		return false
+	}
+	if m.Prefix != other.Prefix {
+		return false
+	}
+	if m.Attribute != other.Attribute {
+		return false
+	}
+	if m.Wrapped != other.Wrapped {
+		return false
+	}
+	if len(m.VendorExtension) != len(other.VendorExtension) {
+		return false
+	}
+	matchedVendorExtension := make([]bool, len(other.VendorExtension))
+	for _, item := range m.VendorExtension {
+		found := false
+		for j, otherItem := range other.VendorExtension {
+			if !matchedVendorExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedVendorExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Xml.
+func (m *Xml) Clone() *Xml {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Xml)
+}
+
+// Merge overlays the contents of another Xml onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Xml) Merge(other *Xml) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Namespace != "" {
+		m.Namespace = other.Namespace
+	}
+	if other.Prefix != "" {
+		m.Prefix = other.Prefix
+	}
+	if other.Attribute {
+		m.Attribute = true
+	}
+	if other.Wrapped {
+		m.Wrapped = true
+	}
+	for _, item := range other.VendorExtension {
+		found := false
+		for _, existing := range m.VendorExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.VendorExtension = append(m.VendorExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Get returns the value in a Default with the specified name, or nil if there is none.
+func (m *Default) Get(name string) *Any {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Default with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Default) Set(name string, value *Any) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedAny{Name: name, Value: value})
+}
+
+// Delete removes the value in a Default with the specified name.
+func (m *Default) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Default in the order that they appear.
+func (m *Default) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Definitions with the specified name, or nil if there is none.
+func (m *Definitions) Get(name string) *Schema {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Definitions with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Definitions) Set(name string, value *Schema) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSchema{Name: name, Value: value})
+}
+
+// Delete removes the value in a Definitions with the specified name.
+func (m *Definitions) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Definitions in the order that they appear.
+func (m *Definitions) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Examples with the specified name, or nil if there is none.
+func (m *Examples) Get(name string) *Any {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Examples with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Examples) Set(name string, value *Any) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedAny{Name: name, Value: value})
+}
+
+// Delete removes the value in a Examples with the specified name.
+func (m *Examples) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Examples in the order that they appear.
+func (m *Examples) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Headers with the specified name, or nil if there is none.
+func (m *Headers) Get(name string) *Header {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Headers with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Headers) Set(name string, value *Header) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedHeader{Name: name, Value: value})
+}
+
+// Delete removes the value in a Headers with the specified name.
+func (m *Headers) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Headers in the order that they appear.
+func (m *Headers) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Oauth2Scopes with the specified name, or "" if there is none.
+func (m *Oauth2Scopes) Get(name string) string {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return ""
+}
+
+// Set replaces the value in a Oauth2Scopes with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Oauth2Scopes) Set(name string, value string) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedString{Name: name, Value: value})
+}
+
+// Delete removes the value in a Oauth2Scopes with the specified name.
+func (m *Oauth2Scopes) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Oauth2Scopes in the order that they appear.
+func (m *Oauth2Scopes) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a ParameterDefinitions with the specified name, or nil if there is none.
+func (m *ParameterDefinitions) Get(name string) *Parameter {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a ParameterDefinitions with the specified name,
+// or adds it after the existing values if there is none.
+func (m *ParameterDefinitions) Set(name string, value *Parameter) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedParameter{Name: name, Value: value})
+}
+
+// Delete removes the value in a ParameterDefinitions with the specified name.
+func (m *ParameterDefinitions) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a ParameterDefinitions in the order that they appear.
+func (m *ParameterDefinitions) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Paths with the specified name, or nil if there is none.
+func (m *Paths) Get(name string) *PathItem {
+	for _, item := range m.GetPath() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Paths with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Paths) Set(name string, value *PathItem) {
+	for _, item := range m.Path {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.Path = append(m.Path, &NamedPathItem{Name: name, Value: value})
+}
+
+// Delete removes the value in a Paths with the specified name.
+func (m *Paths) Delete(name string) {
+	for i, item := range m.Path {
+		if item.Name == name {
+			m.Path = append(m.Path[:i], m.Path[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Paths in the order that they appear.
+func (m *Paths) Names() []string {
+	names := make([]string, 0, len(m.GetPath()))
+	for _, item := range m.GetPath() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Properties with the specified name, or nil if there is none.
+func (m *Properties) Get(name string) *Schema {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Properties with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Properties) Set(name string, value *Schema) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSchema{Name: name, Value: value})
+}
+
+// Delete removes the value in a Properties with the specified name.
+func (m *Properties) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Properties in the order that they appear.
+func (m *Properties) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a ResponseDefinitions with the specified name, or nil if there is none.
+func (m *ResponseDefinitions) Get(name string) *Response {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a ResponseDefinitions with the specified name,
+// or adds it after the existing values if there is none.
+func (m *ResponseDefinitions) Set(name string, value *Response) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedResponse{Name: name, Value: value})
+}
+
+// Delete removes the value in a ResponseDefinitions with the specified name.
+func (m *ResponseDefinitions) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a ResponseDefinitions in the order that they appear.
+func (m *ResponseDefinitions) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Responses with the specified name, or nil if there is none.
+func (m *Responses) Get(name string) *ResponseValue {
+	for _, item := range m.GetResponseCode() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Responses with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Responses) Set(name string, value *ResponseValue) {
+	for _, item := range m.ResponseCode {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.ResponseCode = append(m.ResponseCode, &NamedResponseValue{Name: name, Value: value})
+}
+
+// Delete removes the value in a Responses with the specified name.
+func (m *Responses) Delete(name string) {
+	for i, item := range m.ResponseCode {
+		if item.Name == name {
+			m.ResponseCode = append(m.ResponseCode[:i], m.ResponseCode[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Responses in the order that they appear.
+func (m *Responses) Names() []string {
+	names := make([]string, 0, len(m.GetResponseCode()))
+	for _, item := range m.GetResponseCode() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a SecurityDefinitions with the specified name, or nil if there is none.
+func (m *SecurityDefinitions) Get(name string) *SecurityDefinitionsItem {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a SecurityDefinitions with the specified name,
+// or adds it after the existing values if there is none.
+func (m *SecurityDefinitions) Set(name string, value *SecurityDefinitionsItem) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSecurityDefinitionsItem{Name: name, Value: value})
+}
+
+// Delete removes the value in a SecurityDefinitions with the specified name.
+func (m *SecurityDefinitions) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a SecurityDefinitions in the order that they appear.
+func (m *SecurityDefinitions) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a SecurityRequirement with the specified name, or nil if there is none.
+func (m *SecurityRequirement) Get(name string) *StringArray {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a SecurityRequirement with the specified name,
+// or adds it after the existing values if there is none.
+func (m *SecurityRequirement) Set(name string, value *StringArray) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedStringArray{Name: name, Value: value})
+}
+
+// Delete removes the value in a SecurityRequirement with the specified name.
+func (m *SecurityRequirement) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a SecurityRequirement in the order that they appear.
+func (m *SecurityRequirement) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a VendorExtension with the specified name, or nil if there is none.
+func (m *VendorExtension) Get(name string) *Any {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a VendorExtension with the specified name,
+// or adds it after the existing values if there is none.
+func (m *VendorExtension) Set(name string, value *Any) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedAny{Name: name, Value: value})
+}
+
+// Delete removes the value in a VendorExtension with the specified name.
+func (m *VendorExtension) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a VendorExtension in the order that they appear.
+func (m *VendorExtension) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
 var (
 	pattern0 = regexp.MustCompile("^x-")
 	pattern1 = regexp.MustCompile("^/")
@@ -0,0 +1,103 @@
+package openapi_v2
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/googleapis/gnostic/compiler"
+)
+
+func readDocument(t *testing.T, filename string) *Document {
+	info, err := compiler.ReadInfoForFile(filename)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	document, err := NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return document
+}
+
+// pathVisitor records the paths of the operations, parameters and definitions that it visits.
+type pathVisitor struct {
+	BaseVisitor
+	skipPaths      bool
+	stopOperations bool
+	paths          [][]string
+}
+
+func (v *pathVisitor) VisitPaths(node *Paths, path []string) error {
+	if v.skipPaths {
+		return SkipChildren
+	}
+	return nil
+}
+
+func (v *pathVisitor) VisitOperation(node *Operation, path []string) error {
+	v.paths = append(v.paths, path)
+	if v.stopOperations {
+		return errors.New("stop")
+	}
+	return nil
+}
+
+func (v *pathVisitor) VisitParameter(node *Parameter, path []string) error {
+	v.paths = append(v.paths, path)
+	return nil
+}
+
+func (v *pathVisitor) VisitSchema(node *Schema, path []string) error {
+	if len(path) == 2 && path[0] == "definitions" {
+		v.paths = append(v.paths, path)
+	}
+	return nil
+}
+
+var definitionPaths = [][]string{
+	{"definitions", "Pet"},
+	{"definitions", "Pets"},
+	{"definitions", "Error"},
+}
+
+func TestWalkPaths(t *testing.T) {
+	document := readDocument(t, "../examples/v2.0/yaml/petstore.yaml")
+	visitor := &pathVisitor{}
+	if err := Walk(document, visitor); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := append([][]string{
+		{"paths", "/pets", "get"},
+		{"paths", "/pets", "get", "parameters", "0"},
+		{"paths", "/pets", "post"},
+		{"paths", "/pets/{petId}", "get"},
+		{"paths", "/pets/{petId}", "get", "parameters", "0"},
+	}, definitionPaths...)
+	if !reflect.DeepEqual(visitor.paths, expected) {
+		t.Errorf("visited %q, expected %q", visitor.paths, expected)
+	}
+}
+
+func TestWalkSkipChildren(t *testing.T) {
+	document := readDocument(t, "../examples/v2.0/yaml/petstore.yaml")
+	visitor := &pathVisitor{skipPaths: true}
+	if err := Walk(document, visitor); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !reflect.DeepEqual(visitor.paths, definitionPaths) {
+		t.Errorf("visited %q, expected %q", visitor.paths, definitionPaths)
+	}
+}
+
+func TestWalkStopsOnError(t *testing.T) {
+	document := readDocument(t, "../examples/v2.0/yaml/petstore.yaml")
+	visitor := &pathVisitor{stopOperations: true}
+	if err := Walk(document, visitor); err == nil || err.Error() != "stop" {
+		t.Errorf("Walk returned %v, expected the visitor's error", err)
+	}
+	expected := [][]string{{"paths", "/pets", "get"}}
+	if !reflect.DeepEqual(visitor.paths, expected) {
+		t.Errorf("visited %q, expected %q", visitor.paths, expected)
+	}
+}
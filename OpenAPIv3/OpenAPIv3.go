@@ -18,6 +18,7 @@ package openapi_v3
 
 import (
 	"fmt"
+	"github.com/golang/protobuf/proto"
 	"github.com/googleapis/gnostic/compiler"
 	"gopkg.in/yaml.v2"
 	"regexp"
@@ -2443,6 +2444,44 @@ func NewNamedString(in interface{}, context *compiler.Context) (*NamedString, er
 	return x, compiler.NewErrorGroupOrNil(errors)
 }
 
+// NewNamedStringArray creates an object of type NamedStringArray if possible, returning an error if not.
+func NewNamedStringArray(in interface{}, context *compiler.Context) (*NamedStringArray, error) {
+	errors := make([]error, 0)
+	x := &NamedStringArray{}
+	m, ok := compiler.UnpackMap(in)
+	if !ok {
+		message := fmt.Sprintf("has unexpected value: %+v (%T)", in, in)
+		errors = append(errors, compiler.NewError(context, message))
+	} else {
+		allowedKeys := []string{"name", "value"}
+		var allowedPatterns []*regexp.Regexp
+		invalidKeys := compiler.InvalidKeysInMap(m, allowedKeys, allowedPatterns)
+		if len(invalidKeys) > 0 {
+			message := fmt.Sprintf("has invalid %s: %+v", compiler.PluralProperties(len(invalidKeys)), strings.Join(invalidKeys, ", "))
+			errors = append(errors, compiler.NewError(context, message))
+		}
+		// string name = 1;
+		v1 := compiler.MapValueForKey(m, "name")
+		if v1 != nil {
+			x.Name, ok = v1.(string)
+			if !ok {
+				message := fmt.Sprintf("has unexpected value for name: %+v (%T)", v1, v1)
+				errors = append(errors, compiler.NewError(context, message))
+			}
+		}
+		// StringArray value = 2;
+		v2 := compiler.MapValueForKey(m, "value")
+		if v2 != nil {
+			var err error
+			x.Value, err = NewStringArray(v2, compiler.NewContext("value", context))
+			if err != nil {
+				errors = append(errors, err)
+			}
+		}
+	}
+	return x, compiler.NewErrorGroupOrNil(errors)
+}
+
 // NewOauthFlow creates an object of type OauthFlow if possible, returning an error if not.
 func NewOauthFlow(in interface{}, context *compiler.Context) (*OauthFlow, error) {
 	errors := make([]error, 0)
@@ -4340,6 +4379,25 @@ func NewSecurityRequirement(in interface{}, context *compiler.Context) (*Securit
 			message := fmt.Sprintf("has invalid %s: %+v", compiler.PluralProperties(len(invalidKeys)), strings.Join(invalidKeys, ", "))
 			errors = append(errors, compiler.NewError(context, message))
 		}
+		// repeated NamedStringArray additional_properties = 1;
+		// MAP: StringArray ^[a-zA-Z0-9\.\-_]+$
+		x.AdditionalProperties = make([]*NamedStringArray, 0)
+		for _, item := range m {
+			k, ok := compiler.StringValue(item.Key)
+			if ok {
+				v := item.Value
+				if pattern4.MatchString(k) {
+					pair := &NamedStringArray{}
+					pair.Name = k
+					var err error
+					pair.Value, err = NewStringArray(v, compiler.NewContext(k, context))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					x.AdditionalProperties = append(x.AdditionalProperties, pair)
+				}
+			}
+		}
 	}
 	return x, compiler.NewErrorGroupOrNil(errors)
 }
@@ -5840,6 +5898,18 @@ func (m *NamedString) ResolveReferences(root string) (interface{}, error) {
 	return nil, compiler.NewErrorGroupOrNil(errors)
 }
 
+// ResolveReferences resolves references found inside NamedStringArray objects.
+func (m *NamedStringArray) ResolveReferences(root string) (interface{}, error) {
+	errors := make([]error, 0)
+	if m.Value != nil {
+		_, err := m.Value.ResolveReferences(root)
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return nil, compiler.NewErrorGroupOrNil(errors)
+}
+
 // ResolveReferences resolves references found inside OauthFlow objects.
 func (m *OauthFlow) ResolveReferences(root string) (interface{}, error) {
 	errors := make([]error, 0)
@@ -6488,6 +6558,14 @@ func (m *SchemasOrReferences) ResolveReferences(root string) (interface{}, error
 // ResolveReferences resolves references found inside SecurityRequirement objects.
 func (m *SecurityRequirement) ResolveReferences(root string) (interface{}, error) {
 	errors := make([]error, 0)
+	for _, item := range m.AdditionalProperties {
+		if item != nil {
+			_, err := item.ResolveReferences(root)
+			if err != nil {
+				errors = append(errors, err)
+			}
+		}
+	}
 	return nil, compiler.NewErrorGroupOrNil(errors)
 }
 
@@ -7559,6 +7637,19 @@ func (m *NamedString) ToRawInfo() interface{} {
 	return info
 }
 
+// ToRawInfo returns a description of NamedStringArray suitable for JSON or YAML export.
+func (m *NamedStringArray) ToRawInfo() interface{} {
+	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
+	if m.Name != "" {
+		info = append(info, yaml.MapItem{Key: "name", Value: m.Name})
+	}
+	// &{Name:value Type:StringArray StringEnumValues:[] MapType: Repeated:false Pattern: Implicit:false Description:Mapped value}
+	return info
+}
+
 // ToRawInfo returns a description of OauthFlow suitable for JSON or YAML export.
 func (m *OauthFlow) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
@@ -8242,6 +8333,12 @@ func (m *SecurityRequirement) ToRawInfo() interface{} {
 	if m == nil {
 		return info
 	}
+	if m.AdditionalProperties != nil {
+		for _, item := range m.AdditionalProperties {
+			info = append(info, yaml.MapItem{Key: item.Name, Value: item.Value.ToRawInfo()})
+		}
+	}
+	// &{Name:additionalProperties Type:NamedStringArray StringEnumValues:[] MapType:StringArray Repeated:true Pattern:^[a-zA-Z0-9\.\-_]+$ Implicit:true Description:}
 	return info
 }
 
@@ -8466,6 +8563,7593 @@ func (m *Xml) ToRawInfo() interface{} {
 	return info
 }
 
+// Visitor is implemented by types that inspect the nodes of a Document.
+// Each method is called with a node and the path of keys that leads to it
+// from the root of the document. Map keys and property names are used as keys,
+// and array elements are identified by their indices. Returning SkipChildren skips
+// the nodes below a node, and returning any other error stops the walk.
+// Implementations can embed BaseVisitor and override only the methods they need.
+type Visitor interface {
+	VisitAdditionalPropertiesItem(node *AdditionalPropertiesItem, path []string) error
+	VisitAny(node *Any, path []string) error
+	VisitAnyOrExpression(node *AnyOrExpression, path []string) error
+	VisitAnysOrExpressions(node *AnysOrExpressions, path []string) error
+	VisitCallback(node *Callback, path []string) error
+	VisitCallbackOrReference(node *CallbackOrReference, path []string) error
+	VisitCallbacksOrReferences(node *CallbacksOrReferences, path []string) error
+	VisitComponents(node *Components, path []string) error
+	VisitContact(node *Contact, path []string) error
+	VisitDefaultType(node *DefaultType, path []string) error
+	VisitDiscriminator(node *Discriminator, path []string) error
+	VisitDocument(node *Document, path []string) error
+	VisitEncoding(node *Encoding, path []string) error
+	VisitEncodings(node *Encodings, path []string) error
+	VisitExample(node *Example, path []string) error
+	VisitExampleOrReference(node *ExampleOrReference, path []string) error
+	VisitExamplesOrReferences(node *ExamplesOrReferences, path []string) error
+	VisitExpression(node *Expression, path []string) error
+	VisitExternalDocs(node *ExternalDocs, path []string) error
+	VisitHeader(node *Header, path []string) error
+	VisitHeaderOrReference(node *HeaderOrReference, path []string) error
+	VisitHeadersOrReferences(node *HeadersOrReferences, path []string) error
+	VisitInfo(node *Info, path []string) error
+	VisitItemsItem(node *ItemsItem, path []string) error
+	VisitLicense(node *License, path []string) error
+	VisitLink(node *Link, path []string) error
+	VisitLinkOrReference(node *LinkOrReference, path []string) error
+	VisitLinksOrReferences(node *LinksOrReferences, path []string) error
+	VisitMediaType(node *MediaType, path []string) error
+	VisitMediaTypes(node *MediaTypes, path []string) error
+	VisitOauthFlow(node *OauthFlow, path []string) error
+	VisitOauthFlows(node *OauthFlows, path []string) error
+	VisitObject(node *Object, path []string) error
+	VisitOperation(node *Operation, path []string) error
+	VisitParameter(node *Parameter, path []string) error
+	VisitParameterOrReference(node *ParameterOrReference, path []string) error
+	VisitParametersOrReferences(node *ParametersOrReferences, path []string) error
+	VisitPathItem(node *PathItem, path []string) error
+	VisitPaths(node *Paths, path []string) error
+	VisitProperties(node *Properties, path []string) error
+	VisitReference(node *Reference, path []string) error
+	VisitRequestBodiesOrReferences(node *RequestBodiesOrReferences, path []string) error
+	VisitRequestBody(node *RequestBody, path []string) error
+	VisitRequestBodyOrReference(node *RequestBodyOrReference, path []string) error
+	VisitResponse(node *Response, path []string) error
+	VisitResponseOrReference(node *ResponseOrReference, path []string) error
+	VisitResponses(node *Responses, path []string) error
+	VisitResponsesOrReferences(node *ResponsesOrReferences, path []string) error
+	VisitSchema(node *Schema, path []string) error
+	VisitSchemaOrReference(node *SchemaOrReference, path []string) error
+	VisitSchemasOrReferences(node *SchemasOrReferences, path []string) error
+	VisitSecurityRequirement(node *SecurityRequirement, path []string) error
+	VisitSecurityScheme(node *SecurityScheme, path []string) error
+	VisitSecuritySchemeOrReference(node *SecuritySchemeOrReference, path []string) error
+	VisitSecuritySchemesOrReferences(node *SecuritySchemesOrReferences, path []string) error
+	VisitServer(node *Server, path []string) error
+	VisitServerVariable(node *ServerVariable, path []string) error
+	VisitServerVariables(node *ServerVariables, path []string) error
+	VisitSpecificationExtension(node *SpecificationExtension, path []string) error
+	VisitStringArray(node *StringArray, path []string) error
+	VisitStrings(node *Strings, path []string) error
+	VisitTag(node *Tag, path []string) error
+	VisitXml(node *Xml, path []string) error
+}
+
+// SkipChildren is returned by Visitor methods to skip the nodes below the node that they were called with.
+var SkipChildren error = skipChildren{}
+
+type skipChildren struct{}
+
+func (skipChildren) Error() string { return "skip children" }
+
+// BaseVisitor is a Visitor whose methods do nothing.
+type BaseVisitor struct{}
+
+// VisitAdditionalPropertiesItem does nothing.
+func (BaseVisitor) VisitAdditionalPropertiesItem(node *AdditionalPropertiesItem, path []string) error {
+	return nil
+}
+
+// VisitAny does nothing.
+func (BaseVisitor) VisitAny(node *Any, path []string) error { return nil }
+
+// VisitAnyOrExpression does nothing.
+func (BaseVisitor) VisitAnyOrExpression(node *AnyOrExpression, path []string) error { return nil }
+
+// VisitAnysOrExpressions does nothing.
+func (BaseVisitor) VisitAnysOrExpressions(node *AnysOrExpressions, path []string) error { return nil }
+
+// VisitCallback does nothing.
+func (BaseVisitor) VisitCallback(node *Callback, path []string) error { return nil }
+
+// VisitCallbackOrReference does nothing.
+func (BaseVisitor) VisitCallbackOrReference(node *CallbackOrReference, path []string) error {
+	return nil
+}
+
+// VisitCallbacksOrReferences does nothing.
+func (BaseVisitor) VisitCallbacksOrReferences(node *CallbacksOrReferences, path []string) error {
+	return nil
+}
+
+// VisitComponents does nothing.
+func (BaseVisitor) VisitComponents(node *Components, path []string) error { return nil }
+
+// VisitContact does nothing.
+func (BaseVisitor) VisitContact(node *Contact, path []string) error { return nil }
+
+// VisitDefaultType does nothing.
+func (BaseVisitor) VisitDefaultType(node *DefaultType, path []string) error { return nil }
+
+// VisitDiscriminator does nothing.
+func (BaseVisitor) VisitDiscriminator(node *Discriminator, path []string) error { return nil }
+
+// VisitDocument does nothing.
+func (BaseVisitor) VisitDocument(node *Document, path []string) error { return nil }
+
+// VisitEncoding does nothing.
+func (BaseVisitor) VisitEncoding(node *Encoding, path []string) error { return nil }
+
+// VisitEncodings does nothing.
+func (BaseVisitor) VisitEncodings(node *Encodings, path []string) error { return nil }
+
+// VisitExample does nothing.
+func (BaseVisitor) VisitExample(node *Example, path []string) error { return nil }
+
+// VisitExampleOrReference does nothing.
+func (BaseVisitor) VisitExampleOrReference(node *ExampleOrReference, path []string) error { return nil }
+
+// VisitExamplesOrReferences does nothing.
+func (BaseVisitor) VisitExamplesOrReferences(node *ExamplesOrReferences, path []string) error {
+	return nil
+}
+
+// VisitExpression does nothing.
+func (BaseVisitor) VisitExpression(node *Expression, path []string) error { return nil }
+
+// VisitExternalDocs does nothing.
+func (BaseVisitor) VisitExternalDocs(node *ExternalDocs, path []string) error { return nil }
+
+// VisitHeader does nothing.
+func (BaseVisitor) VisitHeader(node *Header, path []string) error { return nil }
+
+// VisitHeaderOrReference does nothing.
+func (BaseVisitor) VisitHeaderOrReference(node *HeaderOrReference, path []string) error { return nil }
+
+// VisitHeadersOrReferences does nothing.
+func (BaseVisitor) VisitHeadersOrReferences(node *HeadersOrReferences, path []string) error {
+	return nil
+}
+
+// VisitInfo does nothing.
+func (BaseVisitor) VisitInfo(node *Info, path []string) error { return nil }
+
+// VisitItemsItem does nothing.
+func (BaseVisitor) VisitItemsItem(node *ItemsItem, path []string) error { return nil }
+
+// VisitLicense does nothing.
+func (BaseVisitor) VisitLicense(node *License, path []string) error { return nil }
+
+// VisitLink does nothing.
+func (BaseVisitor) VisitLink(node *Link, path []string) error { return nil }
+
+// VisitLinkOrReference does nothing.
+func (BaseVisitor) VisitLinkOrReference(node *LinkOrReference, path []string) error { return nil }
+
+// VisitLinksOrReferences does nothing.
+func (BaseVisitor) VisitLinksOrReferences(node *LinksOrReferences, path []string) error { return nil }
+
+// VisitMediaType does nothing.
+func (BaseVisitor) VisitMediaType(node *MediaType, path []string) error { return nil }
+
+// VisitMediaTypes does nothing.
+func (BaseVisitor) VisitMediaTypes(node *MediaTypes, path []string) error { return nil }
+
+// VisitOauthFlow does nothing.
+func (BaseVisitor) VisitOauthFlow(node *OauthFlow, path []string) error { return nil }
+
+// VisitOauthFlows does nothing.
+func (BaseVisitor) VisitOauthFlows(node *OauthFlows, path []string) error { return nil }
+
+// VisitObject does nothing.
+func (BaseVisitor) VisitObject(node *Object, path []string) error { return nil }
+
+// VisitOperation does nothing.
+func (BaseVisitor) VisitOperation(node *Operation, path []string) error { return nil }
+
+// VisitParameter does nothing.
+func (BaseVisitor) VisitParameter(node *Parameter, path []string) error { return nil }
+
+// VisitParameterOrReference does nothing.
+func (BaseVisitor) VisitParameterOrReference(node *ParameterOrReference, path []string) error {
+	return nil
+}
+
+// VisitParametersOrReferences does nothing.
+func (BaseVisitor) VisitParametersOrReferences(node *ParametersOrReferences, path []string) error {
+	return nil
+}
+
+// VisitPathItem does nothing.
+func (BaseVisitor) VisitPathItem(node *PathItem, path []string) error { return nil }
+
+// VisitPaths does nothing.
+func (BaseVisitor) VisitPaths(node *Paths, path []string) error { return nil }
+
+// VisitProperties does nothing.
+func (BaseVisitor) VisitProperties(node *Properties, path []string) error { return nil }
+
+// VisitReference does nothing.
+func (BaseVisitor) VisitReference(node *Reference, path []string) error { return nil }
+
+// VisitRequestBodiesOrReferences does nothing.
+func (BaseVisitor) VisitRequestBodiesOrReferences(node *RequestBodiesOrReferences, path []string) error {
+	return nil
+}
+
+// VisitRequestBody does nothing.
+func (BaseVisitor) VisitRequestBody(node *RequestBody, path []string) error { return nil }
+
+// VisitRequestBodyOrReference does nothing.
+func (BaseVisitor) VisitRequestBodyOrReference(node *RequestBodyOrReference, path []string) error {
+	return nil
+}
+
+// VisitResponse does nothing.
+func (BaseVisitor) VisitResponse(node *Response, path []string) error { return nil }
+
+// VisitResponseOrReference does nothing.
+func (BaseVisitor) VisitResponseOrReference(node *ResponseOrReference, path []string) error {
+	return nil
+}
+
+// VisitResponses does nothing.
+func (BaseVisitor) VisitResponses(node *Responses, path []string) error { return nil }
+
+// VisitResponsesOrReferences does nothing.
+func (BaseVisitor) VisitResponsesOrReferences(node *ResponsesOrReferences, path []string) error {
+	return nil
+}
+
+// VisitSchema does nothing.
+func (BaseVisitor) VisitSchema(node *Schema, path []string) error { return nil }
+
+// VisitSchemaOrReference does nothing.
+func (BaseVisitor) VisitSchemaOrReference(node *SchemaOrReference, path []string) error { return nil }
+
+// VisitSchemasOrReferences does nothing.
+func (BaseVisitor) VisitSchemasOrReferences(node *SchemasOrReferences, path []string) error {
+	return nil
+}
+
+// VisitSecurityRequirement does nothing.
+func (BaseVisitor) VisitSecurityRequirement(node *SecurityRequirement, path []string) error {
+	return nil
+}
+
+// VisitSecurityScheme does nothing.
+func (BaseVisitor) VisitSecurityScheme(node *SecurityScheme, path []string) error { return nil }
+
+// VisitSecuritySchemeOrReference does nothing.
+func (BaseVisitor) VisitSecuritySchemeOrReference(node *SecuritySchemeOrReference, path []string) error {
+	return nil
+}
+
+// VisitSecuritySchemesOrReferences does nothing.
+func (BaseVisitor) VisitSecuritySchemesOrReferences(node *SecuritySchemesOrReferences, path []string) error {
+	return nil
+}
+
+// VisitServer does nothing.
+func (BaseVisitor) VisitServer(node *Server, path []string) error { return nil }
+
+// VisitServerVariable does nothing.
+func (BaseVisitor) VisitServerVariable(node *ServerVariable, path []string) error { return nil }
+
+// VisitServerVariables does nothing.
+func (BaseVisitor) VisitServerVariables(node *ServerVariables, path []string) error { return nil }
+
+// VisitSpecificationExtension does nothing.
+func (BaseVisitor) VisitSpecificationExtension(node *SpecificationExtension, path []string) error {
+	return nil
+}
+
+// VisitStringArray does nothing.
+func (BaseVisitor) VisitStringArray(node *StringArray, path []string) error { return nil }
+
+// VisitStrings does nothing.
+func (BaseVisitor) VisitStrings(node *Strings, path []string) error { return nil }
+
+// VisitTag does nothing.
+func (BaseVisitor) VisitTag(node *Tag, path []string) error { return nil }
+
+// VisitXml does nothing.
+func (BaseVisitor) VisitXml(node *Xml, path []string) error { return nil }
+
+// Walk calls the methods of a visitor for a Document and for each node below it, in document order.
+// Nodes that wrap alternative types are visited before the node that they contain, at the same path.
+func Walk(document *Document, visitor Visitor) error {
+	return document.walk(visitor, []string{})
+}
+
+// keyPath returns a copy of path with keys appended.
+func keyPath(path []string, keys ...string) []string {
+	result := make([]string, 0, len(path)+len(keys))
+	result = append(result, path...)
+	return append(result, keys...)
+}
+
+// walk visits a AdditionalPropertiesItem and the nodes below it.
+func (m *AdditionalPropertiesItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAdditionalPropertiesItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetSchemaOrReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Any and the nodes below it.
+func (m *Any) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAny(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a AnyOrExpression and the nodes below it.
+func (m *AnyOrExpression) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAnyOrExpression(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetAny().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetExpression().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a AnysOrExpressions and the nodes below it.
+func (m *AnysOrExpressions) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAnysOrExpressions(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Callback and the nodes below it.
+func (m *Callback) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitCallback(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.Path {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a CallbackOrReference and the nodes below it.
+func (m *CallbackOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitCallbackOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetCallback().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a CallbacksOrReferences and the nodes below it.
+func (m *CallbacksOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitCallbacksOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Components and the nodes below it.
+func (m *Components) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitComponents(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Schemas.walk(visitor, keyPath(path, "schemas")); err != nil {
+		return err
+	}
+	if err := m.Responses.walk(visitor, keyPath(path, "responses")); err != nil {
+		return err
+	}
+	if err := m.Parameters.walk(visitor, keyPath(path, "parameters")); err != nil {
+		return err
+	}
+	if err := m.Examples.walk(visitor, keyPath(path, "examples")); err != nil {
+		return err
+	}
+	if err := m.RequestBodies.walk(visitor, keyPath(path, "requestBodies")); err != nil {
+		return err
+	}
+	if err := m.Headers.walk(visitor, keyPath(path, "headers")); err != nil {
+		return err
+	}
+	if err := m.SecuritySchemes.walk(visitor, keyPath(path, "securitySchemes")); err != nil {
+		return err
+	}
+	if err := m.Links.walk(visitor, keyPath(path, "links")); err != nil {
+		return err
+	}
+	if err := m.Callbacks.walk(visitor, keyPath(path, "callbacks")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Contact and the nodes below it.
+func (m *Contact) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitContact(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a DefaultType and the nodes below it.
+func (m *DefaultType) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDefaultType(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Discriminator and the nodes below it.
+func (m *Discriminator) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDiscriminator(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Mapping.walk(visitor, keyPath(path, "mapping")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Document and the nodes below it.
+func (m *Document) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDocument(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Info.walk(visitor, keyPath(path, "info")); err != nil {
+		return err
+	}
+	for i, item := range m.Servers {
+		if err := item.walk(visitor, keyPath(path, "servers", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.Paths.walk(visitor, keyPath(path, "paths")); err != nil {
+		return err
+	}
+	if err := m.Components.walk(visitor, keyPath(path, "components")); err != nil {
+		return err
+	}
+	for i, item := range m.Security {
+		if err := item.walk(visitor, keyPath(path, "security", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for i, item := range m.Tags {
+		if err := item.walk(visitor, keyPath(path, "tags", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Encoding and the nodes below it.
+func (m *Encoding) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitEncoding(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Headers.walk(visitor, keyPath(path, "headers")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Encodings and the nodes below it.
+func (m *Encodings) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitEncodings(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Example and the nodes below it.
+func (m *Example) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExample(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Value.walk(visitor, keyPath(path, "value")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ExampleOrReference and the nodes below it.
+func (m *ExampleOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExampleOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetExample().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a ExamplesOrReferences and the nodes below it.
+func (m *ExamplesOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExamplesOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Expression and the nodes below it.
+func (m *Expression) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExpression(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ExternalDocs and the nodes below it.
+func (m *ExternalDocs) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitExternalDocs(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Header and the nodes below it.
+func (m *Header) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitHeader(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Schema.walk(visitor, keyPath(path, "schema")); err != nil {
+		return err
+	}
+	if err := m.Example.walk(visitor, keyPath(path, "example")); err != nil {
+		return err
+	}
+	if err := m.Examples.walk(visitor, keyPath(path, "examples")); err != nil {
+		return err
+	}
+	if err := m.Content.walk(visitor, keyPath(path, "content")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a HeaderOrReference and the nodes below it.
+func (m *HeaderOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitHeaderOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetHeader().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a HeadersOrReferences and the nodes below it.
+func (m *HeadersOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitHeadersOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Info and the nodes below it.
+func (m *Info) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitInfo(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Contact.walk(visitor, keyPath(path, "contact")); err != nil {
+		return err
+	}
+	if err := m.License.walk(visitor, keyPath(path, "license")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ItemsItem and the nodes below it.
+func (m *ItemsItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitItemsItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.SchemaOrReference {
+		if err := item.walk(visitor, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a License and the nodes below it.
+func (m *License) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitLicense(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Link and the nodes below it.
+func (m *Link) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitLink(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Parameters.walk(visitor, keyPath(path, "parameters")); err != nil {
+		return err
+	}
+	if err := m.RequestBody.walk(visitor, keyPath(path, "requestBody")); err != nil {
+		return err
+	}
+	if err := m.Server.walk(visitor, keyPath(path, "server")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a LinkOrReference and the nodes below it.
+func (m *LinkOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitLinkOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetLink().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a LinksOrReferences and the nodes below it.
+func (m *LinksOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitLinksOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a MediaType and the nodes below it.
+func (m *MediaType) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitMediaType(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Schema.walk(visitor, keyPath(path, "schema")); err != nil {
+		return err
+	}
+	if err := m.Example.walk(visitor, keyPath(path, "example")); err != nil {
+		return err
+	}
+	if err := m.Examples.walk(visitor, keyPath(path, "examples")); err != nil {
+		return err
+	}
+	if err := m.Encoding.walk(visitor, keyPath(path, "encoding")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a MediaTypes and the nodes below it.
+func (m *MediaTypes) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitMediaTypes(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a OauthFlow and the nodes below it.
+func (m *OauthFlow) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauthFlow(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Scopes.walk(visitor, keyPath(path, "scopes")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a OauthFlows and the nodes below it.
+func (m *OauthFlows) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauthFlows(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Implicit.walk(visitor, keyPath(path, "implicit")); err != nil {
+		return err
+	}
+	if err := m.Password.walk(visitor, keyPath(path, "password")); err != nil {
+		return err
+	}
+	if err := m.ClientCredentials.walk(visitor, keyPath(path, "clientCredentials")); err != nil {
+		return err
+	}
+	if err := m.AuthorizationCode.walk(visitor, keyPath(path, "authorizationCode")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Object and the nodes below it.
+func (m *Object) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitObject(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Operation and the nodes below it.
+func (m *Operation) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOperation(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	for i, item := range m.Parameters {
+		if err := item.walk(visitor, keyPath(path, "parameters", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.RequestBody.walk(visitor, keyPath(path, "requestBody")); err != nil {
+		return err
+	}
+	if err := m.Responses.walk(visitor, keyPath(path, "responses")); err != nil {
+		return err
+	}
+	if err := m.Callbacks.walk(visitor, keyPath(path, "callbacks")); err != nil {
+		return err
+	}
+	for i, item := range m.Security {
+		if err := item.walk(visitor, keyPath(path, "security", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for i, item := range m.Servers {
+		if err := item.walk(visitor, keyPath(path, "servers", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Parameter and the nodes below it.
+func (m *Parameter) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParameter(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Schema.walk(visitor, keyPath(path, "schema")); err != nil {
+		return err
+	}
+	if err := m.Example.walk(visitor, keyPath(path, "example")); err != nil {
+		return err
+	}
+	if err := m.Examples.walk(visitor, keyPath(path, "examples")); err != nil {
+		return err
+	}
+	if err := m.Content.walk(visitor, keyPath(path, "content")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ParameterOrReference and the nodes below it.
+func (m *ParameterOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParameterOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetParameter().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a ParametersOrReferences and the nodes below it.
+func (m *ParametersOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParametersOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a PathItem and the nodes below it.
+func (m *PathItem) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitPathItem(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Get.walk(visitor, keyPath(path, "get")); err != nil {
+		return err
+	}
+	if err := m.Put.walk(visitor, keyPath(path, "put")); err != nil {
+		return err
+	}
+	if err := m.Post.walk(visitor, keyPath(path, "post")); err != nil {
+		return err
+	}
+	if err := m.Delete.walk(visitor, keyPath(path, "delete")); err != nil {
+		return err
+	}
+	if err := m.Options.walk(visitor, keyPath(path, "options")); err != nil {
+		return err
+	}
+	if err := m.Head.walk(visitor, keyPath(path, "head")); err != nil {
+		return err
+	}
+	if err := m.Patch.walk(visitor, keyPath(path, "patch")); err != nil {
+		return err
+	}
+	if err := m.Trace.walk(visitor, keyPath(path, "trace")); err != nil {
+		return err
+	}
+	for i, item := range m.Servers {
+		if err := item.walk(visitor, keyPath(path, "servers", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for i, item := range m.Parameters {
+		if err := item.walk(visitor, keyPath(path, "parameters", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Paths and the nodes below it.
+func (m *Paths) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitPaths(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.Path {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Properties and the nodes below it.
+func (m *Properties) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitProperties(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Reference and the nodes below it.
+func (m *Reference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a RequestBodiesOrReferences and the nodes below it.
+func (m *RequestBodiesOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitRequestBodiesOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a RequestBody and the nodes below it.
+func (m *RequestBody) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitRequestBody(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Content.walk(visitor, keyPath(path, "content")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a RequestBodyOrReference and the nodes below it.
+func (m *RequestBodyOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitRequestBodyOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetRequestBody().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Response and the nodes below it.
+func (m *Response) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponse(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Headers.walk(visitor, keyPath(path, "headers")); err != nil {
+		return err
+	}
+	if err := m.Content.walk(visitor, keyPath(path, "content")); err != nil {
+		return err
+	}
+	if err := m.Links.walk(visitor, keyPath(path, "links")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ResponseOrReference and the nodes below it.
+func (m *ResponseOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponseOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetResponse().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Responses and the nodes below it.
+func (m *Responses) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponses(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for _, item := range m.ResponseOrReference {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ResponsesOrReferences and the nodes below it.
+func (m *ResponsesOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponsesOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Schema and the nodes below it.
+func (m *Schema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Discriminator.walk(visitor, keyPath(path, "discriminator")); err != nil {
+		return err
+	}
+	if err := m.Xml.walk(visitor, keyPath(path, "xml")); err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	if err := m.Example.walk(visitor, keyPath(path, "example")); err != nil {
+		return err
+	}
+	for i, item := range m.Enum {
+		if err := item.walk(visitor, keyPath(path, "enum", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for i, item := range m.AllOf {
+		if err := item.walk(visitor, keyPath(path, "allOf", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for i, item := range m.OneOf {
+		if err := item.walk(visitor, keyPath(path, "oneOf", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	for i, item := range m.AnyOf {
+		if err := item.walk(visitor, keyPath(path, "anyOf", fmt.Sprintf("%d", i))); err != nil {
+			return err
+		}
+	}
+	if err := m.Not.walk(visitor, keyPath(path, "not")); err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Properties.walk(visitor, keyPath(path, "properties")); err != nil {
+		return err
+	}
+	if err := m.AdditionalProperties.walk(visitor, keyPath(path, "additionalProperties")); err != nil {
+		return err
+	}
+	if err := m.Default.walk(visitor, keyPath(path, "default")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SchemaOrReference and the nodes below it.
+func (m *SchemaOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchemaOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetSchema().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a SchemasOrReferences and the nodes below it.
+func (m *SchemasOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchemasOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SecurityRequirement and the nodes below it.
+func (m *SecurityRequirement) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecurityRequirement(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SecurityScheme and the nodes below it.
+func (m *SecurityScheme) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecurityScheme(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Flows.walk(visitor, keyPath(path, "flows")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SecuritySchemeOrReference and the nodes below it.
+func (m *SecuritySchemeOrReference) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecuritySchemeOrReference(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.GetSecurityScheme().walk(visitor, path); err != nil {
+		return err
+	}
+	if err := m.GetReference().walk(visitor, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a SecuritySchemesOrReferences and the nodes below it.
+func (m *SecuritySchemesOrReferences) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSecuritySchemesOrReferences(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Server and the nodes below it.
+func (m *Server) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitServer(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Variables.walk(visitor, keyPath(path, "variables")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ServerVariable and the nodes below it.
+func (m *ServerVariable) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitServerVariable(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a ServerVariables and the nodes below it.
+func (m *ServerVariables) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitServerVariables(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a SpecificationExtension and the nodes below it.
+func (m *SpecificationExtension) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSpecificationExtension(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a StringArray and the nodes below it.
+func (m *StringArray) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitStringArray(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Strings and the nodes below it.
+func (m *Strings) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitStrings(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Tag and the nodes below it.
+func (m *Tag) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitTag(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.ExternalDocs.walk(visitor, keyPath(path, "externalDocs")); err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Xml and the nodes below it.
+func (m *Xml) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitXml(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.SpecificationExtension {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Equal returns true if two AdditionalPropertiesItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *AdditionalPropertiesItem) Equal(other *AdditionalPropertiesItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *AdditionalPropertiesItem_SchemaOrReference:
+		o, ok := other.Oneof.(*AdditionalPropertiesItem_SchemaOrReference)
+		return ok && v.SchemaOrReference.Equal(o.SchemaOrReference)
+	case *AdditionalPropertiesItem_Boolean:
+		o, ok := other.Oneof.(*AdditionalPropertiesItem_Boolean)
+		return ok && v.Boolean == o.Boolean
+	}
+	return false
+}
+
+// Clone returns a deep copy of a AdditionalPropertiesItem.
+func (m *AdditionalPropertiesItem) Clone() *AdditionalPropertiesItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*AdditionalPropertiesItem)
+}
+
+// Merge overlays the contents of another AdditionalPropertiesItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *AdditionalPropertiesItem) Merge(other *AdditionalPropertiesItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*AdditionalPropertiesItem_SchemaOrReference); ok && v.SchemaOrReference != nil {
+		if o, ok := other.Oneof.(*AdditionalPropertiesItem_SchemaOrReference); ok {
+			v.SchemaOrReference.Merge(o.SchemaOrReference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Any objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Any) Equal(other *Any) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return proto.Equal(m, other)
+}
+
+// Clone returns a deep copy of a Any.
+func (m *Any) Clone() *Any {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Any)
+}
+
+// Merge overlays the contents of another Any onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Any) Merge(other *Any) {
+	if m == nil || other == nil {
+		return
+	}
+	*m = *other.Clone()
+}
+
+// Equal returns true if two AnyOrExpression objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *AnyOrExpression) Equal(other *AnyOrExpression) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *AnyOrExpression_Any:
+		o, ok := other.Oneof.(*AnyOrExpression_Any)
+		return ok && v.Any.Equal(o.Any)
+	case *AnyOrExpression_Expression:
+		o, ok := other.Oneof.(*AnyOrExpression_Expression)
+		return ok && v.Expression.Equal(o.Expression)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a AnyOrExpression.
+func (m *AnyOrExpression) Clone() *AnyOrExpression {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*AnyOrExpression)
+}
+
+// Merge overlays the contents of another AnyOrExpression onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *AnyOrExpression) Merge(other *AnyOrExpression) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*AnyOrExpression_Any); ok && v.Any != nil {
+		if o, ok := other.Oneof.(*AnyOrExpression_Any); ok {
+			v.Any.Merge(o.Any)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*AnyOrExpression_Expression); ok && v.Expression != nil {
+		if o, ok := other.Oneof.(*AnyOrExpression_Expression); ok {
+			v.Expression.Merge(o.Expression)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two AnysOrExpressions objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *AnysOrExpressions) Equal(other *AnysOrExpressions) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a AnysOrExpressions.
+func (m *AnysOrExpressions) Clone() *AnysOrExpressions {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*AnysOrExpressions)
+}
+
+// Merge overlays the contents of another AnysOrExpressions onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *AnysOrExpressions) Merge(other *AnysOrExpressions) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedAnyOrExpression))
+		}
+	}
+}
+
+// Equal returns true if two Callback objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Callback) Equal(other *Callback) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Path) != len(other.Path) {
+		return false
+	}
+	matchedPath := make([]bool, len(other.Path))
+	for _, item := range m.Path {
+		found := false
+		for j, otherItem := range other.Path {
+			if !matchedPath[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedPath[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Callback.
+func (m *Callback) Clone() *Callback {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Callback)
+}
+
+// Merge overlays the contents of another Callback onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Callback) Merge(other *Callback) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.Path {
+		found := false
+		for _, existing := range m.Path {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.Path = append(m.Path, proto.Clone(item).(*NamedPathItem))
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two CallbackOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *CallbackOrReference) Equal(other *CallbackOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *CallbackOrReference_Callback:
+		o, ok := other.Oneof.(*CallbackOrReference_Callback)
+		return ok && v.Callback.Equal(o.Callback)
+	case *CallbackOrReference_Reference:
+		o, ok := other.Oneof.(*CallbackOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a CallbackOrReference.
+func (m *CallbackOrReference) Clone() *CallbackOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*CallbackOrReference)
+}
+
+// Merge overlays the contents of another CallbackOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *CallbackOrReference) Merge(other *CallbackOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*CallbackOrReference_Callback); ok && v.Callback != nil {
+		if o, ok := other.Oneof.(*CallbackOrReference_Callback); ok {
+			v.Callback.Merge(o.Callback)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*CallbackOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*CallbackOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two CallbacksOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *CallbacksOrReferences) Equal(other *CallbacksOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a CallbacksOrReferences.
+func (m *CallbacksOrReferences) Clone() *CallbacksOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*CallbacksOrReferences)
+}
+
+// Merge overlays the contents of another CallbacksOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *CallbacksOrReferences) Merge(other *CallbacksOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedCallbackOrReference))
+		}
+	}
+}
+
+// Equal returns true if two Components objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Components) Equal(other *Components) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Schemas.Equal(other.Schemas) {
+		return false
+	}
+	if !m.Responses.Equal(other.Responses) {
+		return false
+	}
+	if !m.Parameters.Equal(other.Parameters) {
+		return false
+	}
+	if !m.Examples.Equal(other.Examples) {
+		return false
+	}
+	if !m.RequestBodies.Equal(other.RequestBodies) {
+		return false
+	}
+	if !m.Headers.Equal(other.Headers) {
+		return false
+	}
+	if !m.SecuritySchemes.Equal(other.SecuritySchemes) {
+		return false
+	}
+	if !m.Links.Equal(other.Links) {
+		return false
+	}
+	if !m.Callbacks.Equal(other.Callbacks) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Components.
+func (m *Components) Clone() *Components {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Components)
+}
+
+// Merge overlays the contents of another Components onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Components) Merge(other *Components) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Schemas != nil {
+		if m.Schemas == nil {
+			m.Schemas = other.Schemas.Clone()
+		} else {
+			m.Schemas.Merge(other.Schemas)
+		}
+	}
+	if other.Responses != nil {
+		if m.Responses == nil {
+			m.Responses = other.Responses.Clone()
+		} else {
+			m.Responses.Merge(other.Responses)
+		}
+	}
+	if other.Parameters != nil {
+		if m.Parameters == nil {
+			m.Parameters = other.Parameters.Clone()
+		} else {
+			m.Parameters.Merge(other.Parameters)
+		}
+	}
+	if other.Examples != nil {
+		if m.Examples == nil {
+			m.Examples = other.Examples.Clone()
+		} else {
+			m.Examples.Merge(other.Examples)
+		}
+	}
+	if other.RequestBodies != nil {
+		if m.RequestBodies == nil {
+			m.RequestBodies = other.RequestBodies.Clone()
+		} else {
+			m.RequestBodies.Merge(other.RequestBodies)
+		}
+	}
+	if other.Headers != nil {
+		if m.Headers == nil {
+			m.Headers = other.Headers.Clone()
+		} else {
+			m.Headers.Merge(other.Headers)
+		}
+	}
+	if other.SecuritySchemes != nil {
+		if m.SecuritySchemes == nil {
+			m.SecuritySchemes = other.SecuritySchemes.Clone()
+		} else {
+			m.SecuritySchemes.Merge(other.SecuritySchemes)
+		}
+	}
+	if other.Links != nil {
+		if m.Links == nil {
+			m.Links = other.Links.Clone()
+		} else {
+			m.Links.Merge(other.Links)
+		}
+	}
+	if other.Callbacks != nil {
+		if m.Callbacks == nil {
+			m.Callbacks = other.Callbacks.Clone()
+		} else {
+			m.Callbacks.Merge(other.Callbacks)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Contact objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Contact) Equal(other *Contact) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if m.Email != other.Email {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Contact.
+func (m *Contact) Clone() *Contact {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Contact)
+}
+
+// Merge overlays the contents of another Contact onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Contact) Merge(other *Contact) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	if other.Email != "" {
+		m.Email = other.Email
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two DefaultType objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *DefaultType) Equal(other *DefaultType) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *DefaultType_Number:
+		o, ok := other.Oneof.(*DefaultType_Number)
+		return ok && v.Number == o.Number
+	case *DefaultType_Boolean:
+		o, ok := other.Oneof.(*DefaultType_Boolean)
+		return ok && v.Boolean == o.Boolean
+	case *DefaultType_String_:
+		o, ok := other.Oneof.(*DefaultType_String_)
+		return ok && v.String_ == o.String_
+	}
+	return false
+}
+
+// Clone returns a deep copy of a DefaultType.
+func (m *DefaultType) Clone() *DefaultType {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*DefaultType)
+}
+
+// Merge overlays the contents of another DefaultType onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *DefaultType) Merge(other *DefaultType) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Discriminator objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Discriminator) Equal(other *Discriminator) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.PropertyName != other.PropertyName {
+		return false
+	}
+	if !m.Mapping.Equal(other.Mapping) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Discriminator.
+func (m *Discriminator) Clone() *Discriminator {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Discriminator)
+}
+
+// Merge overlays the contents of another Discriminator onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Discriminator) Merge(other *Discriminator) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.PropertyName != "" {
+		m.PropertyName = other.PropertyName
+	}
+	if other.Mapping != nil {
+		if m.Mapping == nil {
+			m.Mapping = other.Mapping.Clone()
+		} else {
+			m.Mapping.Merge(other.Mapping)
+		}
+	}
+}
+
+// Equal returns true if two Document objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Document) Equal(other *Document) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Openapi != other.Openapi {
+		return false
+	}
+	if !m.Info.Equal(other.Info) {
+		return false
+	}
+	if len(m.Servers) != len(other.Servers) {
+		return false
+	}
+	for i := range m.Servers {
+		if !m.Servers[i].Equal(other.Servers[i]) {
+			return false
+		}
+	}
+	if !m.Paths.Equal(other.Paths) {
+		return false
+	}
+	if !m.Components.Equal(other.Components) {
+		return false
+	}
+	if len(m.Security) != len(other.Security) {
+		return false
+	}
+	for i := range m.Security {
+		if !m.Security[i].Equal(other.Security[i]) {
+			return false
+		}
+	}
+	if len(m.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range m.Tags {
+		if !m.Tags[i].Equal(other.Tags[i]) {
+			return false
+		}
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Document.
+func (m *Document) Clone() *Document {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Document)
+}
+
+// Merge overlays the contents of another Document onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Document) Merge(other *Document) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Openapi != "" {
+		m.Openapi = other.Openapi
+	}
+	if other.Info != nil {
+		if m.Info == nil {
+			m.Info = other.Info.Clone()
+		} else {
+			m.Info.Merge(other.Info)
+		}
+	}
+	if len(other.Servers) != 0 {
+		m.Servers = make([]*Server, len(other.Servers))
+		for i, item := range other.Servers {
+			m.Servers[i] = item.Clone()
+		}
+	}
+	if other.Paths != nil {
+		if m.Paths == nil {
+			m.Paths = other.Paths.Clone()
+		} else {
+			m.Paths.Merge(other.Paths)
+		}
+	}
+	if other.Components != nil {
+		if m.Components == nil {
+			m.Components = other.Components.Clone()
+		} else {
+			m.Components.Merge(other.Components)
+		}
+	}
+	if len(other.Security) != 0 {
+		m.Security = make([]*SecurityRequirement, len(other.Security))
+		for i, item := range other.Security {
+			m.Security[i] = item.Clone()
+		}
+	}
+	if len(other.Tags) != 0 {
+		m.Tags = make([]*Tag, len(other.Tags))
+		for i, item := range other.Tags {
+			m.Tags[i] = item.Clone()
+		}
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Encoding objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Encoding) Equal(other *Encoding) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.ContentType != other.ContentType {
+		return false
+	}
+	if !m.Headers.Equal(other.Headers) {
+		return false
+	}
+	if m.Style != other.Style {
+		return false
+	}
+	if m.Explode != other.Explode {
+		return false
+	}
+	if m.AllowReserved != other.AllowReserved {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Encoding.
+func (m *Encoding) Clone() *Encoding {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Encoding)
+}
+
+// Merge overlays the contents of another Encoding onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Encoding) Merge(other *Encoding) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.ContentType != "" {
+		m.ContentType = other.ContentType
+	}
+	if other.Headers != nil {
+		if m.Headers == nil {
+			m.Headers = other.Headers.Clone()
+		} else {
+			m.Headers.Merge(other.Headers)
+		}
+	}
+	if other.Style != "" {
+		m.Style = other.Style
+	}
+	if other.Explode {
+		m.Explode = true
+	}
+	if other.AllowReserved {
+		m.AllowReserved = true
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Encodings objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Encodings) Equal(other *Encodings) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Encodings.
+func (m *Encodings) Clone() *Encodings {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Encodings)
+}
+
+// Merge overlays the contents of another Encodings onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Encodings) Merge(other *Encodings) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedEncoding))
+		}
+	}
+}
+
+// Equal returns true if two Example objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Example) Equal(other *Example) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Summary != other.Summary {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Value.Equal(other.Value) {
+		return false
+	}
+	if m.ExternalValue != other.ExternalValue {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Example.
+func (m *Example) Clone() *Example {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Example)
+}
+
+// Merge overlays the contents of another Example onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Example) Merge(other *Example) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Summary != "" {
+		m.Summary = other.Summary
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Value != nil {
+		if m.Value == nil {
+			m.Value = other.Value.Clone()
+		} else {
+			m.Value.Merge(other.Value)
+		}
+	}
+	if other.ExternalValue != "" {
+		m.ExternalValue = other.ExternalValue
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ExampleOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ExampleOrReference) Equal(other *ExampleOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *ExampleOrReference_Example:
+		o, ok := other.Oneof.(*ExampleOrReference_Example)
+		return ok && v.Example.Equal(o.Example)
+	case *ExampleOrReference_Reference:
+		o, ok := other.Oneof.(*ExampleOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a ExampleOrReference.
+func (m *ExampleOrReference) Clone() *ExampleOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ExampleOrReference)
+}
+
+// Merge overlays the contents of another ExampleOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ExampleOrReference) Merge(other *ExampleOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*ExampleOrReference_Example); ok && v.Example != nil {
+		if o, ok := other.Oneof.(*ExampleOrReference_Example); ok {
+			v.Example.Merge(o.Example)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*ExampleOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*ExampleOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two ExamplesOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ExamplesOrReferences) Equal(other *ExamplesOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ExamplesOrReferences.
+func (m *ExamplesOrReferences) Clone() *ExamplesOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ExamplesOrReferences)
+}
+
+// Merge overlays the contents of another ExamplesOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ExamplesOrReferences) Merge(other *ExamplesOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedExampleOrReference))
+		}
+	}
+}
+
+// Equal returns true if two Expression objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Expression) Equal(other *Expression) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Expression.
+func (m *Expression) Clone() *Expression {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Expression)
+}
+
+// Merge overlays the contents of another Expression onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Expression) Merge(other *Expression) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ExternalDocs objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ExternalDocs) Equal(other *ExternalDocs) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ExternalDocs.
+func (m *ExternalDocs) Clone() *ExternalDocs {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ExternalDocs)
+}
+
+// Merge overlays the contents of another ExternalDocs onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ExternalDocs) Merge(other *ExternalDocs) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Header objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Header) Equal(other *Header) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.Deprecated != other.Deprecated {
+		return false
+	}
+	if m.AllowEmptyValue != other.AllowEmptyValue {
+		return false
+	}
+	if m.Style != other.Style {
+		return false
+	}
+	if m.Explode != other.Explode {
+		return false
+	}
+	if m.AllowReserved != other.AllowReserved {
+		return false
+	}
+	if !m.Schema.Equal(other.Schema) {
+		return false
+	}
+	if !m.Example.Equal(other.Example) {
+		return false
+	}
+	if !m.Examples.Equal(other.Examples) {
+		return false
+	}
+	if !m.Content.Equal(other.Content) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Header.
+func (m *Header) Clone() *Header {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Header)
+}
+
+// Merge overlays the contents of another Header onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Header) Merge(other *Header) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.Deprecated {
+		m.Deprecated = true
+	}
+	if other.AllowEmptyValue {
+		m.AllowEmptyValue = true
+	}
+	if other.Style != "" {
+		m.Style = other.Style
+	}
+	if other.Explode {
+		m.Explode = true
+	}
+	if other.AllowReserved {
+		m.AllowReserved = true
+	}
+	if other.Schema != nil {
+		if m.Schema == nil {
+			m.Schema = other.Schema.Clone()
+		} else {
+			m.Schema.Merge(other.Schema)
+		}
+	}
+	if other.Example != nil {
+		if m.Example == nil {
+			m.Example = other.Example.Clone()
+		} else {
+			m.Example.Merge(other.Example)
+		}
+	}
+	if other.Examples != nil {
+		if m.Examples == nil {
+			m.Examples = other.Examples.Clone()
+		} else {
+			m.Examples.Merge(other.Examples)
+		}
+	}
+	if other.Content != nil {
+		if m.Content == nil {
+			m.Content = other.Content.Clone()
+		} else {
+			m.Content.Merge(other.Content)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two HeaderOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *HeaderOrReference) Equal(other *HeaderOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *HeaderOrReference_Header:
+		o, ok := other.Oneof.(*HeaderOrReference_Header)
+		return ok && v.Header.Equal(o.Header)
+	case *HeaderOrReference_Reference:
+		o, ok := other.Oneof.(*HeaderOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a HeaderOrReference.
+func (m *HeaderOrReference) Clone() *HeaderOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*HeaderOrReference)
+}
+
+// Merge overlays the contents of another HeaderOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *HeaderOrReference) Merge(other *HeaderOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*HeaderOrReference_Header); ok && v.Header != nil {
+		if o, ok := other.Oneof.(*HeaderOrReference_Header); ok {
+			v.Header.Merge(o.Header)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*HeaderOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*HeaderOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two HeadersOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *HeadersOrReferences) Equal(other *HeadersOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a HeadersOrReferences.
+func (m *HeadersOrReferences) Clone() *HeadersOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*HeadersOrReferences)
+}
+
+// Merge overlays the contents of another HeadersOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *HeadersOrReferences) Merge(other *HeadersOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedHeaderOrReference))
+		}
+	}
+}
+
+// Equal returns true if two Info objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Info) Equal(other *Info) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.TermsOfService != other.TermsOfService {
+		return false
+	}
+	if !m.Contact.Equal(other.Contact) {
+		return false
+	}
+	if !m.License.Equal(other.License) {
+		return false
+	}
+	if m.Version != other.Version {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Info.
+func (m *Info) Clone() *Info {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Info)
+}
+
+// Merge overlays the contents of another Info onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Info) Merge(other *Info) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Title != "" {
+		m.Title = other.Title
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.TermsOfService != "" {
+		m.TermsOfService = other.TermsOfService
+	}
+	if other.Contact != nil {
+		if m.Contact == nil {
+			m.Contact = other.Contact.Clone()
+		} else {
+			m.Contact.Merge(other.Contact)
+		}
+	}
+	if other.License != nil {
+		if m.License == nil {
+			m.License = other.License.Clone()
+		} else {
+			m.License.Merge(other.License)
+		}
+	}
+	if other.Version != "" {
+		m.Version = other.Version
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ItemsItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ItemsItem) Equal(other *ItemsItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.SchemaOrReference) != len(other.SchemaOrReference) {
+		return false
+	}
+	for i := range m.SchemaOrReference {
+		if !m.SchemaOrReference[i].Equal(other.SchemaOrReference[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ItemsItem.
+func (m *ItemsItem) Clone() *ItemsItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ItemsItem)
+}
+
+// Merge overlays the contents of another ItemsItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ItemsItem) Merge(other *ItemsItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.SchemaOrReference) != 0 {
+		m.SchemaOrReference = make([]*SchemaOrReference, len(other.SchemaOrReference))
+		for i, item := range other.SchemaOrReference {
+			m.SchemaOrReference[i] = item.Clone()
+		}
+	}
+}
+
+// Equal returns true if two License objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *License) Equal(other *License) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a License.
+func (m *License) Clone() *License {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*License)
+}
+
+// Merge overlays the contents of another License onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *License) Merge(other *License) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Link objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Link) Equal(other *Link) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.OperationRef != other.OperationRef {
+		return false
+	}
+	if m.OperationId != other.OperationId {
+		return false
+	}
+	if !m.Parameters.Equal(other.Parameters) {
+		return false
+	}
+	if !m.RequestBody.Equal(other.RequestBody) {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Server.Equal(other.Server) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Link.
+func (m *Link) Clone() *Link {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Link)
+}
+
+// Merge overlays the contents of another Link onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Link) Merge(other *Link) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.OperationRef != "" {
+		m.OperationRef = other.OperationRef
+	}
+	if other.OperationId != "" {
+		m.OperationId = other.OperationId
+	}
+	if other.Parameters != nil {
+		if m.Parameters == nil {
+			m.Parameters = other.Parameters.Clone()
+		} else {
+			m.Parameters.Merge(other.Parameters)
+		}
+	}
+	if other.RequestBody != nil {
+		if m.RequestBody == nil {
+			m.RequestBody = other.RequestBody.Clone()
+		} else {
+			m.RequestBody.Merge(other.RequestBody)
+		}
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Server != nil {
+		if m.Server == nil {
+			m.Server = other.Server.Clone()
+		} else {
+			m.Server.Merge(other.Server)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two LinkOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *LinkOrReference) Equal(other *LinkOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *LinkOrReference_Link:
+		o, ok := other.Oneof.(*LinkOrReference_Link)
+		return ok && v.Link.Equal(o.Link)
+	case *LinkOrReference_Reference:
+		o, ok := other.Oneof.(*LinkOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a LinkOrReference.
+func (m *LinkOrReference) Clone() *LinkOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*LinkOrReference)
+}
+
+// Merge overlays the contents of another LinkOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *LinkOrReference) Merge(other *LinkOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*LinkOrReference_Link); ok && v.Link != nil {
+		if o, ok := other.Oneof.(*LinkOrReference_Link); ok {
+			v.Link.Merge(o.Link)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*LinkOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*LinkOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two LinksOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *LinksOrReferences) Equal(other *LinksOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a LinksOrReferences.
+func (m *LinksOrReferences) Clone() *LinksOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*LinksOrReferences)
+}
+
+// Merge overlays the contents of another LinksOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *LinksOrReferences) Merge(other *LinksOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedLinkOrReference))
+		}
+	}
+}
+
+// Equal returns true if two MediaType objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *MediaType) Equal(other *MediaType) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Schema.Equal(other.Schema) {
+		return false
+	}
+	if !m.Example.Equal(other.Example) {
+		return false
+	}
+	if !m.Examples.Equal(other.Examples) {
+		return false
+	}
+	if !m.Encoding.Equal(other.Encoding) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a MediaType.
+func (m *MediaType) Clone() *MediaType {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*MediaType)
+}
+
+// Merge overlays the contents of another MediaType onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *MediaType) Merge(other *MediaType) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Schema != nil {
+		if m.Schema == nil {
+			m.Schema = other.Schema.Clone()
+		} else {
+			m.Schema.Merge(other.Schema)
+		}
+	}
+	if other.Example != nil {
+		if m.Example == nil {
+			m.Example = other.Example.Clone()
+		} else {
+			m.Example.Merge(other.Example)
+		}
+	}
+	if other.Examples != nil {
+		if m.Examples == nil {
+			m.Examples = other.Examples.Clone()
+		} else {
+			m.Examples.Merge(other.Examples)
+		}
+	}
+	if other.Encoding != nil {
+		if m.Encoding == nil {
+			m.Encoding = other.Encoding.Clone()
+		} else {
+			m.Encoding.Merge(other.Encoding)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two MediaTypes objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *MediaTypes) Equal(other *MediaTypes) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a MediaTypes.
+func (m *MediaTypes) Clone() *MediaTypes {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*MediaTypes)
+}
+
+// Merge overlays the contents of another MediaTypes onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *MediaTypes) Merge(other *MediaTypes) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedMediaType))
+		}
+	}
+}
+
+// Equal returns true if two OauthFlow objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *OauthFlow) Equal(other *OauthFlow) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.AuthorizationUrl != other.AuthorizationUrl {
+		return false
+	}
+	if m.TokenUrl != other.TokenUrl {
+		return false
+	}
+	if m.RefreshUrl != other.RefreshUrl {
+		return false
+	}
+	if !m.Scopes.Equal(other.Scopes) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a OauthFlow.
+func (m *OauthFlow) Clone() *OauthFlow {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*OauthFlow)
+}
+
+// Merge overlays the contents of another OauthFlow onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *OauthFlow) Merge(other *OauthFlow) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.AuthorizationUrl != "" {
+		m.AuthorizationUrl = other.AuthorizationUrl
+	}
+	if other.TokenUrl != "" {
+		m.TokenUrl = other.TokenUrl
+	}
+	if other.RefreshUrl != "" {
+		m.RefreshUrl = other.RefreshUrl
+	}
+	if other.Scopes != nil {
+		if m.Scopes == nil {
+			m.Scopes = other.Scopes.Clone()
+		} else {
+			m.Scopes.Merge(other.Scopes)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two OauthFlows objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *OauthFlows) Equal(other *OauthFlows) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Implicit.Equal(other.Implicit) {
+		return false
+	}
+	if !m.Password.Equal(other.Password) {
+		return false
+	}
+	if !m.ClientCredentials.Equal(other.ClientCredentials) {
+		return false
+	}
+	if !m.AuthorizationCode.Equal(other.AuthorizationCode) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a OauthFlows.
+func (m *OauthFlows) Clone() *OauthFlows {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*OauthFlows)
+}
+
+// Merge overlays the contents of another OauthFlows onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *OauthFlows) Merge(other *OauthFlows) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Implicit != nil {
+		if m.Implicit == nil {
+			m.Implicit = other.Implicit.Clone()
+		} else {
+			m.Implicit.Merge(other.Implicit)
+		}
+	}
+	if other.Password != nil {
+		if m.Password == nil {
+			m.Password = other.Password.Clone()
+		} else {
+			m.Password.Merge(other.Password)
+		}
+	}
+	if other.ClientCredentials != nil {
+		if m.ClientCredentials == nil {
+			m.ClientCredentials = other.ClientCredentials.Clone()
+		} else {
+			m.ClientCredentials.Merge(other.ClientCredentials)
+		}
+	}
+	if other.AuthorizationCode != nil {
+		if m.AuthorizationCode == nil {
+			m.AuthorizationCode = other.AuthorizationCode.Clone()
+		} else {
+			m.AuthorizationCode.Merge(other.AuthorizationCode)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Object objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Object) Equal(other *Object) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Object.
+func (m *Object) Clone() *Object {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Object)
+}
+
+// Merge overlays the contents of another Object onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Object) Merge(other *Object) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Operation objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Operation) Equal(other *Operation) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range m.Tags {
+		if m.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if m.Summary != other.Summary {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if m.OperationId != other.OperationId {
+		return false
+	}
+	if len(m.Parameters) != len(other.Parameters) {
+		return false
+	}
+	for i := range m.Parameters {
+		if !m.Parameters[i].Equal(other.Parameters[i]) {
+			return false
+		}
+	}
+	if !m.RequestBody.Equal(other.RequestBody) {
+		return false
+	}
+	if !m.Responses.Equal(other.Responses) {
+		return false
+	}
+	if !m.Callbacks.Equal(other.Callbacks) {
+		return false
+	}
+	if m.Deprecated != other.Deprecated {
+		return false
+	}
+	if len(m.Security) != len(other.Security) {
+		return false
+	}
+	for i := range m.Security {
+		if !m.Security[i].Equal(other.Security[i]) {
+			return false
+		}
+	}
+	if len(m.Servers) != len(other.Servers) {
+		return false
+	}
+	for i := range m.Servers {
+		if !m.Servers[i].Equal(other.Servers[i]) {
+			return false
+		}
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Operation.
+func (m *Operation) Clone() *Operation {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Operation)
+}
+
+// Merge overlays the contents of another Operation onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Operation) Merge(other *Operation) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Tags) != 0 {
+		m.Tags = append([]string(nil), other.Tags...)
+	}
+	if other.Summary != "" {
+		m.Summary = other.Summary
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	if other.OperationId != "" {
+		m.OperationId = other.OperationId
+	}
+	if len(other.Parameters) != 0 {
+		m.Parameters = make([]*ParameterOrReference, len(other.Parameters))
+		for i, item := range other.Parameters {
+			m.Parameters[i] = item.Clone()
+		}
+	}
+	if other.RequestBody != nil {
+		if m.RequestBody == nil {
+			m.RequestBody = other.RequestBody.Clone()
+		} else {
+			m.RequestBody.Merge(other.RequestBody)
+		}
+	}
+	if other.Responses != nil {
+		if m.Responses == nil {
+			m.Responses = other.Responses.Clone()
+		} else {
+			m.Responses.Merge(other.Responses)
+		}
+	}
+	if other.Callbacks != nil {
+		if m.Callbacks == nil {
+			m.Callbacks = other.Callbacks.Clone()
+		} else {
+			m.Callbacks.Merge(other.Callbacks)
+		}
+	}
+	if other.Deprecated {
+		m.Deprecated = true
+	}
+	if len(other.Security) != 0 {
+		m.Security = make([]*SecurityRequirement, len(other.Security))
+		for i, item := range other.Security {
+			m.Security[i] = item.Clone()
+		}
+	}
+	if len(other.Servers) != 0 {
+		m.Servers = make([]*Server, len(other.Servers))
+		for i, item := range other.Servers {
+			m.Servers[i] = item.Clone()
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Parameter objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Parameter) Equal(other *Parameter) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.Deprecated != other.Deprecated {
+		return false
+	}
+	if m.AllowEmptyValue != other.AllowEmptyValue {
+		return false
+	}
+	if m.Style != other.Style {
+		return false
+	}
+	if m.Explode != other.Explode {
+		return false
+	}
+	if m.AllowReserved != other.AllowReserved {
+		return false
+	}
+	if !m.Schema.Equal(other.Schema) {
+		return false
+	}
+	if !m.Example.Equal(other.Example) {
+		return false
+	}
+	if !m.Examples.Equal(other.Examples) {
+		return false
+	}
+	if !m.Content.Equal(other.Content) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Parameter.
+func (m *Parameter) Clone() *Parameter {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Parameter)
+}
+
+// Merge overlays the contents of another Parameter onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Parameter) Merge(other *Parameter) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.Deprecated {
+		m.Deprecated = true
+	}
+	if other.AllowEmptyValue {
+		m.AllowEmptyValue = true
+	}
+	if other.Style != "" {
+		m.Style = other.Style
+	}
+	if other.Explode {
+		m.Explode = true
+	}
+	if other.AllowReserved {
+		m.AllowReserved = true
+	}
+	if other.Schema != nil {
+		if m.Schema == nil {
+			m.Schema = other.Schema.Clone()
+		} else {
+			m.Schema.Merge(other.Schema)
+		}
+	}
+	if other.Example != nil {
+		if m.Example == nil {
+			m.Example = other.Example.Clone()
+		} else {
+			m.Example.Merge(other.Example)
+		}
+	}
+	if other.Examples != nil {
+		if m.Examples == nil {
+			m.Examples = other.Examples.Clone()
+		} else {
+			m.Examples.Merge(other.Examples)
+		}
+	}
+	if other.Content != nil {
+		if m.Content == nil {
+			m.Content = other.Content.Clone()
+		} else {
+			m.Content.Merge(other.Content)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ParameterOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ParameterOrReference) Equal(other *ParameterOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *ParameterOrReference_Parameter:
+		o, ok := other.Oneof.(*ParameterOrReference_Parameter)
+		return ok && v.Parameter.Equal(o.Parameter)
+	case *ParameterOrReference_Reference:
+		o, ok := other.Oneof.(*ParameterOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a ParameterOrReference.
+func (m *ParameterOrReference) Clone() *ParameterOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ParameterOrReference)
+}
+
+// Merge overlays the contents of another ParameterOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ParameterOrReference) Merge(other *ParameterOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*ParameterOrReference_Parameter); ok && v.Parameter != nil {
+		if o, ok := other.Oneof.(*ParameterOrReference_Parameter); ok {
+			v.Parameter.Merge(o.Parameter)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*ParameterOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*ParameterOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two ParametersOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ParametersOrReferences) Equal(other *ParametersOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ParametersOrReferences.
+func (m *ParametersOrReferences) Clone() *ParametersOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ParametersOrReferences)
+}
+
+// Merge overlays the contents of another ParametersOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ParametersOrReferences) Merge(other *ParametersOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedParameterOrReference))
+		}
+	}
+}
+
+// Equal returns true if two PathItem objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *PathItem) Equal(other *PathItem) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if m.Summary != other.Summary {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Get.Equal(other.Get) {
+		return false
+	}
+	if !m.Put.Equal(other.Put) {
+		return false
+	}
+	if !m.Post.Equal(other.Post) {
+		return false
+	}
+	if !m.Delete.Equal(other.Delete) {
+		return false
+	}
+	if !m.Options.Equal(other.Options) {
+		return false
+	}
+	if !m.Head.Equal(other.Head) {
+		return false
+	}
+	if !m.Patch.Equal(other.Patch) {
+		return false
+	}
+	if !m.Trace.Equal(other.Trace) {
+		return false
+	}
+	if len(m.Servers) != len(other.Servers) {
+		return false
+	}
+	for i := range m.Servers {
+		if !m.Servers[i].Equal(other.Servers[i]) {
+			return false
+		}
+	}
+	if len(m.Parameters) != len(other.Parameters) {
+		return false
+	}
+	for i := range m.Parameters {
+		if !m.Parameters[i].Equal(other.Parameters[i]) {
+			return false
+		}
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a PathItem.
+func (m *PathItem) Clone() *PathItem {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*PathItem)
+}
+
+// Merge overlays the contents of another PathItem onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *PathItem) Merge(other *PathItem) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.Summary != "" {
+		m.Summary = other.Summary
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Get != nil {
+		if m.Get == nil {
+			m.Get = other.Get.Clone()
+		} else {
+			m.Get.Merge(other.Get)
+		}
+	}
+	if other.Put != nil {
+		if m.Put == nil {
+			m.Put = other.Put.Clone()
+		} else {
+			m.Put.Merge(other.Put)
+		}
+	}
+	if other.Post != nil {
+		if m.Post == nil {
+			m.Post = other.Post.Clone()
+		} else {
+			m.Post.Merge(other.Post)
+		}
+	}
+	if other.Delete != nil {
+		if m.Delete == nil {
+			m.Delete = other.Delete.Clone()
+		} else {
+			m.Delete.Merge(other.Delete)
+		}
+	}
+	if other.Options != nil {
+		if m.Options == nil {
+			m.Options = other.Options.Clone()
+		} else {
+			m.Options.Merge(other.Options)
+		}
+	}
+	if other.Head != nil {
+		if m.Head == nil {
+			m.Head = other.Head.Clone()
+		} else {
+			m.Head.Merge(other.Head)
+		}
+	}
+	if other.Patch != nil {
+		if m.Patch == nil {
+			m.Patch = other.Patch.Clone()
+		} else {
+			m.Patch.Merge(other.Patch)
+		}
+	}
+	if other.Trace != nil {
+		if m.Trace == nil {
+			m.Trace = other.Trace.Clone()
+		} else {
+			m.Trace.Merge(other.Trace)
+		}
+	}
+	if len(other.Servers) != 0 {
+		m.Servers = make([]*Server, len(other.Servers))
+		for i, item := range other.Servers {
+			m.Servers[i] = item.Clone()
+		}
+	}
+	if len(other.Parameters) != 0 {
+		m.Parameters = make([]*ParameterOrReference, len(other.Parameters))
+		for i, item := range other.Parameters {
+			m.Parameters[i] = item.Clone()
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Paths objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Paths) Equal(other *Paths) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Path) != len(other.Path) {
+		return false
+	}
+	matchedPath := make([]bool, len(other.Path))
+	for _, item := range m.Path {
+		found := false
+		for j, otherItem := range other.Path {
+			if !matchedPath[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedPath[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Paths.
+func (m *Paths) Clone() *Paths {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Paths)
+}
+
+// Merge overlays the contents of another Paths onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Paths) Merge(other *Paths) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.Path {
+		found := false
+		for _, existing := range m.Path {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.Path = append(m.Path, proto.Clone(item).(*NamedPathItem))
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Properties objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Properties) Equal(other *Properties) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Properties.
+func (m *Properties) Clone() *Properties {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Properties)
+}
+
+// Merge overlays the contents of another Properties onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Properties) Merge(other *Properties) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSchemaOrReference))
+		}
+	}
+}
+
+// Equal returns true if two Reference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Reference) Equal(other *Reference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Reference.
+func (m *Reference) Clone() *Reference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Reference)
+}
+
+// Merge overlays the contents of another Reference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Reference) Merge(other *Reference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+}
+
+// Equal returns true if two RequestBodiesOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *RequestBodiesOrReferences) Equal(other *RequestBodiesOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a RequestBodiesOrReferences.
+func (m *RequestBodiesOrReferences) Clone() *RequestBodiesOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*RequestBodiesOrReferences)
+}
+
+// Merge overlays the contents of another RequestBodiesOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *RequestBodiesOrReferences) Merge(other *RequestBodiesOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedRequestBodyOrReference))
+		}
+	}
+}
+
+// Equal returns true if two RequestBody objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *RequestBody) Equal(other *RequestBody) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Content.Equal(other.Content) {
+		return false
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a RequestBody.
+func (m *RequestBody) Clone() *RequestBody {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*RequestBody)
+}
+
+// Merge overlays the contents of another RequestBody onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *RequestBody) Merge(other *RequestBody) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Content != nil {
+		if m.Content == nil {
+			m.Content = other.Content.Clone()
+		} else {
+			m.Content.Merge(other.Content)
+		}
+	}
+	if other.Required {
+		m.Required = true
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two RequestBodyOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *RequestBodyOrReference) Equal(other *RequestBodyOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *RequestBodyOrReference_RequestBody:
+		o, ok := other.Oneof.(*RequestBodyOrReference_RequestBody)
+		return ok && v.RequestBody.Equal(o.RequestBody)
+	case *RequestBodyOrReference_Reference:
+		o, ok := other.Oneof.(*RequestBodyOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a RequestBodyOrReference.
+func (m *RequestBodyOrReference) Clone() *RequestBodyOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*RequestBodyOrReference)
+}
+
+// Merge overlays the contents of another RequestBodyOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *RequestBodyOrReference) Merge(other *RequestBodyOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*RequestBodyOrReference_RequestBody); ok && v.RequestBody != nil {
+		if o, ok := other.Oneof.(*RequestBodyOrReference_RequestBody); ok {
+			v.RequestBody.Merge(o.RequestBody)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*RequestBodyOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*RequestBodyOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Response objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Response) Equal(other *Response) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Headers.Equal(other.Headers) {
+		return false
+	}
+	if !m.Content.Equal(other.Content) {
+		return false
+	}
+	if !m.Links.Equal(other.Links) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Response.
+func (m *Response) Clone() *Response {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Response)
+}
+
+// Merge overlays the contents of another Response onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Response) Merge(other *Response) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Headers != nil {
+		if m.Headers == nil {
+			m.Headers = other.Headers.Clone()
+		} else {
+			m.Headers.Merge(other.Headers)
+		}
+	}
+	if other.Content != nil {
+		if m.Content == nil {
+			m.Content = other.Content.Clone()
+		} else {
+			m.Content.Merge(other.Content)
+		}
+	}
+	if other.Links != nil {
+		if m.Links == nil {
+			m.Links = other.Links.Clone()
+		} else {
+			m.Links.Merge(other.Links)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ResponseOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ResponseOrReference) Equal(other *ResponseOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *ResponseOrReference_Response:
+		o, ok := other.Oneof.(*ResponseOrReference_Response)
+		return ok && v.Response.Equal(o.Response)
+	case *ResponseOrReference_Reference:
+		o, ok := other.Oneof.(*ResponseOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a ResponseOrReference.
+func (m *ResponseOrReference) Clone() *ResponseOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ResponseOrReference)
+}
+
+// Merge overlays the contents of another ResponseOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ResponseOrReference) Merge(other *ResponseOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*ResponseOrReference_Response); ok && v.Response != nil {
+		if o, ok := other.Oneof.(*ResponseOrReference_Response); ok {
+			v.Response.Merge(o.Response)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*ResponseOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*ResponseOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two Responses objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Responses) Equal(other *Responses) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if len(m.ResponseOrReference) != len(other.ResponseOrReference) {
+		return false
+	}
+	matchedResponseOrReference := make([]bool, len(other.ResponseOrReference))
+	for _, item := range m.ResponseOrReference {
+		found := false
+		for j, otherItem := range other.ResponseOrReference {
+			if !matchedResponseOrReference[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedResponseOrReference[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Responses.
+func (m *Responses) Clone() *Responses {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Responses)
+}
+
+// Merge overlays the contents of another Responses onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Responses) Merge(other *Responses) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	for _, item := range other.ResponseOrReference {
+		found := false
+		for _, existing := range m.ResponseOrReference {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.ResponseOrReference = append(m.ResponseOrReference, proto.Clone(item).(*NamedResponseOrReference))
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ResponsesOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ResponsesOrReferences) Equal(other *ResponsesOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ResponsesOrReferences.
+func (m *ResponsesOrReferences) Clone() *ResponsesOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ResponsesOrReferences)
+}
+
+// Merge overlays the contents of another ResponsesOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ResponsesOrReferences) Merge(other *ResponsesOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedResponseOrReference))
+		}
+	}
+}
+
+// Equal returns true if two Schema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Schema) Equal(other *Schema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Nullable != other.Nullable {
+		return false
+	}
+	if !m.Discriminator.Equal(other.Discriminator) {
+		return false
+	}
+	if m.ReadOnly != other.ReadOnly {
+		return false
+	}
+	if m.WriteOnly != other.WriteOnly {
+		return false
+	}
+	if !m.Xml.Equal(other.Xml) {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if !m.Example.Equal(other.Example) {
+		return false
+	}
+	if m.Deprecated != other.Deprecated {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.MultipleOf != other.MultipleOf {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if m.ExclusiveMaximum != other.ExclusiveMaximum {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.ExclusiveMinimum != other.ExclusiveMinimum {
+		return false
+	}
+	if m.MaxLength != other.MaxLength {
+		return false
+	}
+	if m.MinLength != other.MinLength {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.MaxItems != other.MaxItems {
+		return false
+	}
+	if m.MinItems != other.MinItems {
+		return false
+	}
+	if m.UniqueItems != other.UniqueItems {
+		return false
+	}
+	if m.MaxProperties != other.MaxProperties {
+		return false
+	}
+	if m.MinProperties != other.MinProperties {
+		return false
+	}
+	if len(m.Required) != len(other.Required) {
+		return false
+	}
+	for i := range m.Required {
+		if m.Required[i] != other.Required[i] {
+			return false
+		}
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if !m.Enum[i].Equal(other.Enum[i]) {
+			return false
+		}
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if len(m.AllOf) != len(other.AllOf) {
+		return false
+	}
+	for i := range m.AllOf {
+		if !m.AllOf[i].Equal(other.AllOf[i]) {
+			return false
+		}
+	}
+	if len(m.OneOf) != len(other.OneOf) {
+		return false
+	}
+	for i := range m.OneOf {
+		if !m.OneOf[i].Equal(other.OneOf[i]) {
+			return false
+		}
+	}
+	if len(m.AnyOf) != len(other.AnyOf) {
+		return false
+	}
+	for i := range m.AnyOf {
+		if !m.AnyOf[i].Equal(other.AnyOf[i]) {
+			return false
+		}
+	}
+	if !m.Not.Equal(other.Not) {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if !m.Properties.Equal(other.Properties) {
+		return false
+	}
+	if !m.AdditionalProperties.Equal(other.AdditionalProperties) {
+		return false
+	}
+	if !m.Default.Equal(other.Default) {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Schema.
+func (m *Schema) Clone() *Schema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Schema)
+}
+
+// Merge overlays the contents of another Schema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Schema) Merge(other *Schema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Nullable {
+		m.Nullable = true
+	}
+	if other.Discriminator != nil {
+		if m.Discriminator == nil {
+			m.Discriminator = other.Discriminator.Clone()
+		} else {
+			m.Discriminator.Merge(other.Discriminator)
+		}
+	}
+	if other.ReadOnly {
+		m.ReadOnly = true
+	}
+	if other.WriteOnly {
+		m.WriteOnly = true
+	}
+	if other.Xml != nil {
+		if m.Xml == nil {
+			m.Xml = other.Xml.Clone()
+		} else {
+			m.Xml.Merge(other.Xml)
+		}
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	if other.Example != nil {
+		if m.Example == nil {
+			m.Example = other.Example.Clone()
+		} else {
+			m.Example.Merge(other.Example)
+		}
+	}
+	if other.Deprecated {
+		m.Deprecated = true
+	}
+	if other.Title != "" {
+		m.Title = other.Title
+	}
+	if other.MultipleOf != 0 {
+		m.MultipleOf = other.MultipleOf
+	}
+	if other.Maximum != 0 {
+		m.Maximum = other.Maximum
+	}
+	if other.ExclusiveMaximum {
+		m.ExclusiveMaximum = true
+	}
+	if other.Minimum != 0 {
+		m.Minimum = other.Minimum
+	}
+	if other.ExclusiveMinimum {
+		m.ExclusiveMinimum = true
+	}
+	if other.MaxLength != 0 {
+		m.MaxLength = other.MaxLength
+	}
+	if other.MinLength != 0 {
+		m.MinLength = other.MinLength
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.MaxItems != 0 {
+		m.MaxItems = other.MaxItems
+	}
+	if other.MinItems != 0 {
+		m.MinItems = other.MinItems
+	}
+	if other.UniqueItems {
+		m.UniqueItems = true
+	}
+	if other.MaxProperties != 0 {
+		m.MaxProperties = other.MaxProperties
+	}
+	if other.MinProperties != 0 {
+		m.MinProperties = other.MinProperties
+	}
+	if len(other.Required) != 0 {
+		m.Required = append([]string(nil), other.Required...)
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = make([]*Any, len(other.Enum))
+		for i, item := range other.Enum {
+			m.Enum[i] = item.Clone()
+		}
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if len(other.AllOf) != 0 {
+		m.AllOf = make([]*SchemaOrReference, len(other.AllOf))
+		for i, item := range other.AllOf {
+			m.AllOf[i] = item.Clone()
+		}
+	}
+	if len(other.OneOf) != 0 {
+		m.OneOf = make([]*SchemaOrReference, len(other.OneOf))
+		for i, item := range other.OneOf {
+			m.OneOf[i] = item.Clone()
+		}
+	}
+	if len(other.AnyOf) != 0 {
+		m.AnyOf = make([]*SchemaOrReference, len(other.AnyOf))
+		for i, item := range other.AnyOf {
+			m.AnyOf[i] = item.Clone()
+		}
+	}
+	if other.Not != nil {
+		if m.Not == nil {
+			m.Not = other.Not.Clone()
+		} else {
+			m.Not.Merge(other.Not)
+		}
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.Properties != nil {
+		if m.Properties == nil {
+			m.Properties = other.Properties.Clone()
+		} else {
+			m.Properties.Merge(other.Properties)
+		}
+	}
+	if other.AdditionalProperties != nil {
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = other.AdditionalProperties.Clone()
+		} else {
+			m.AdditionalProperties.Merge(other.AdditionalProperties)
+		}
+	}
+	if other.Default != nil {
+		if m.Default == nil {
+			m.Default = other.Default.Clone()
+		} else {
+			m.Default.Merge(other.Default)
+		}
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two SchemaOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SchemaOrReference) Equal(other *SchemaOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *SchemaOrReference_Schema:
+		o, ok := other.Oneof.(*SchemaOrReference_Schema)
+		return ok && v.Schema.Equal(o.Schema)
+	case *SchemaOrReference_Reference:
+		o, ok := other.Oneof.(*SchemaOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a SchemaOrReference.
+func (m *SchemaOrReference) Clone() *SchemaOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SchemaOrReference)
+}
+
+// Merge overlays the contents of another SchemaOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SchemaOrReference) Merge(other *SchemaOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*SchemaOrReference_Schema); ok && v.Schema != nil {
+		if o, ok := other.Oneof.(*SchemaOrReference_Schema); ok {
+			v.Schema.Merge(o.Schema)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SchemaOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*SchemaOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two SchemasOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SchemasOrReferences) Equal(other *SchemasOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a SchemasOrReferences.
+func (m *SchemasOrReferences) Clone() *SchemasOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SchemasOrReferences)
+}
+
+// Merge overlays the contents of another SchemasOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SchemasOrReferences) Merge(other *SchemasOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSchemaOrReference))
+		}
+	}
+}
+
+// Equal returns true if two SecurityRequirement objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecurityRequirement) Equal(other *SecurityRequirement) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for _, otherItem := range other.AdditionalProperties {
+			if item.Name == otherItem.Name {
+				found = item.Value.Equal(otherItem.Value)
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a SecurityRequirement.
+func (m *SecurityRequirement) Clone() *SecurityRequirement {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecurityRequirement)
+}
+
+// Merge overlays the contents of another SecurityRequirement onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecurityRequirement) Merge(other *SecurityRequirement) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedStringArray))
+		}
+	}
+}
+
+// Equal returns true if two SecurityScheme objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecurityScheme) Equal(other *SecurityScheme) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.In != other.In {
+		return false
+	}
+	if m.Scheme != other.Scheme {
+		return false
+	}
+	if m.BearerFormat != other.BearerFormat {
+		return false
+	}
+	if !m.Flows.Equal(other.Flows) {
+		return false
+	}
+	if m.OpenIdConnectUrl != other.OpenIdConnectUrl {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a SecurityScheme.
+func (m *SecurityScheme) Clone() *SecurityScheme {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecurityScheme)
+}
+
+// Merge overlays the contents of another SecurityScheme onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecurityScheme) Merge(other *SecurityScheme) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.In != "" {
+		m.In = other.In
+	}
+	if other.Scheme != "" {
+		m.Scheme = other.Scheme
+	}
+	if other.BearerFormat != "" {
+		m.BearerFormat = other.BearerFormat
+	}
+	if other.Flows != nil {
+		if m.Flows == nil {
+			m.Flows = other.Flows.Clone()
+		} else {
+			m.Flows.Merge(other.Flows)
+		}
+	}
+	if other.OpenIdConnectUrl != "" {
+		m.OpenIdConnectUrl = other.OpenIdConnectUrl
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two SecuritySchemeOrReference objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecuritySchemeOrReference) Equal(other *SecuritySchemeOrReference) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *SecuritySchemeOrReference_SecurityScheme:
+		o, ok := other.Oneof.(*SecuritySchemeOrReference_SecurityScheme)
+		return ok && v.SecurityScheme.Equal(o.SecurityScheme)
+	case *SecuritySchemeOrReference_Reference:
+		o, ok := other.Oneof.(*SecuritySchemeOrReference_Reference)
+		return ok && v.Reference.Equal(o.Reference)
+	}
+	return false
+}
+
+// Clone returns a deep copy of a SecuritySchemeOrReference.
+func (m *SecuritySchemeOrReference) Clone() *SecuritySchemeOrReference {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecuritySchemeOrReference)
+}
+
+// Merge overlays the contents of another SecuritySchemeOrReference onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecuritySchemeOrReference) Merge(other *SecuritySchemeOrReference) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	if v, ok := m.Oneof.(*SecuritySchemeOrReference_SecurityScheme); ok && v.SecurityScheme != nil {
+		if o, ok := other.Oneof.(*SecuritySchemeOrReference_SecurityScheme); ok {
+			v.SecurityScheme.Merge(o.SecurityScheme)
+			return
+		}
+	}
+	if v, ok := m.Oneof.(*SecuritySchemeOrReference_Reference); ok && v.Reference != nil {
+		if o, ok := other.Oneof.(*SecuritySchemeOrReference_Reference); ok {
+			v.Reference.Merge(o.Reference)
+			return
+		}
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two SecuritySchemesOrReferences objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SecuritySchemesOrReferences) Equal(other *SecuritySchemesOrReferences) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a SecuritySchemesOrReferences.
+func (m *SecuritySchemesOrReferences) Clone() *SecuritySchemesOrReferences {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SecuritySchemesOrReferences)
+}
+
+// Merge overlays the contents of another SecuritySchemesOrReferences onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SecuritySchemesOrReferences) Merge(other *SecuritySchemesOrReferences) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSecuritySchemeOrReference))
+		}
+	}
+}
+
+// Equal returns true if two Server objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Server) Equal(other *Server) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Url != other.Url {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Variables.Equal(other.Variables) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Server.
+func (m *Server) Clone() *Server {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Server)
+}
+
+// Merge overlays the contents of another Server onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Server) Merge(other *Server) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Url != "" {
+		m.Url = other.Url
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Variables != nil {
+		if m.Variables == nil {
+			m.Variables = other.Variables.Clone()
+		} else {
+			m.Variables.Merge(other.Variables)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ServerVariable objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ServerVariable) Equal(other *ServerVariable) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if m.Enum[i] != other.Enum[i] {
+			return false
+		}
+	}
+	if m.Default != other.Default {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ServerVariable.
+func (m *ServerVariable) Clone() *ServerVariable {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ServerVariable)
+}
+
+// Merge overlays the contents of another ServerVariable onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ServerVariable) Merge(other *ServerVariable) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = append([]string(nil), other.Enum...)
+	}
+	if other.Default != "" {
+		m.Default = other.Default
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two ServerVariables objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *ServerVariables) Equal(other *ServerVariables) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a ServerVariables.
+func (m *ServerVariables) Clone() *ServerVariables {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*ServerVariables)
+}
+
+// Merge overlays the contents of another ServerVariables onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *ServerVariables) Merge(other *ServerVariables) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedServerVariable))
+		}
+	}
+}
+
+// Equal returns true if two SpecificationExtension objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *SpecificationExtension) Equal(other *SpecificationExtension) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	switch v := m.Oneof.(type) {
+	case nil:
+		return other.Oneof == nil
+	case *SpecificationExtension_Number:
+		o, ok := other.Oneof.(*SpecificationExtension_Number)
+		return ok && v.Number == o.Number
+	case *SpecificationExtension_Boolean:
+		o, ok := other.Oneof.(*SpecificationExtension_Boolean)
+		return ok && v.Boolean == o.Boolean
+	case *SpecificationExtension_String_:
+		o, ok := other.Oneof.(*SpecificationExtension_String_)
+		return ok && v.String_ == o.String_
+	}
+	return false
+}
+
+// Clone returns a deep copy of a SpecificationExtension.
+func (m *SpecificationExtension) Clone() *SpecificationExtension {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*SpecificationExtension)
+}
+
+// Merge overlays the contents of another SpecificationExtension onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *SpecificationExtension) Merge(other *SpecificationExtension) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oneof == nil {
+		return
+	}
+	m.Oneof = other.Clone().Oneof
+}
+
+// Equal returns true if two StringArray objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *StringArray) Equal(other *StringArray) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Value) != len(other.Value) {
+		return false
+	}
+	for i := range m.Value {
+		if m.Value[i] != other.Value[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a StringArray.
+func (m *StringArray) Clone() *StringArray {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*StringArray)
+}
+
+// Merge overlays the contents of another StringArray onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *StringArray) Merge(other *StringArray) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Value) != 0 {
+		m.Value = append([]string(nil), other.Value...)
+	}
+}
+
+// Equal returns true if two Strings objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Strings) Equal(other *Strings) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value == otherItem.Value {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Strings.
+func (m *Strings) Clone() *Strings {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Strings)
+}
+
+// Merge overlays the contents of another Strings onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Strings) Merge(other *Strings) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				existing.Value = item.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedString))
+		}
+	}
+}
+
+// Equal returns true if two Tag objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Tag) Equal(other *Tag) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.ExternalDocs.Equal(other.ExternalDocs) {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Tag.
+func (m *Tag) Clone() *Tag {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Tag)
+}
+
+// Merge overlays the contents of another Tag onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Tag) Merge(other *Tag) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.ExternalDocs != nil {
+		if m.ExternalDocs == nil {
+			m.ExternalDocs = other.ExternalDocs.Clone()
+		} else {
+			m.ExternalDocs.Merge(other.ExternalDocs)
+		}
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Equal returns true if two Xml objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Xml) Equal(other *Xml) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Namespace != other.Namespace {
+		return false
+	}
+	if m.Prefix != other.Prefix {
+		return false
+	}
+	if m.Attribute != other.Attribute {
+		return false
+	}
+	if m.Wrapped != other.Wrapped {
+		return false
+	}
+	if len(m.SpecificationExtension) != len(other.SpecificationExtension) {
+		return false
+	}
+	matchedSpecificationExtension := make([]bool, len(other.SpecificationExtension))
+	for _, item := range m.SpecificationExtension {
+		found := false
+		for j, otherItem := range other.SpecificationExtension {
+			if !matchedSpecificationExtension[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedSpecificationExtension[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Xml.
+func (m *Xml) Clone() *Xml {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Xml)
+}
+
+// Merge overlays the contents of another Xml onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Xml) Merge(other *Xml) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Namespace != "" {
+		m.Namespace = other.Namespace
+	}
+	if other.Prefix != "" {
+		m.Prefix = other.Prefix
+	}
+	if other.Attribute {
+		m.Attribute = true
+	}
+	if other.Wrapped {
+		m.Wrapped = true
+	}
+	for _, item := range other.SpecificationExtension {
+		found := false
+		for _, existing := range m.SpecificationExtension {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.SpecificationExtension = append(m.SpecificationExtension, proto.Clone(item).(*NamedAny))
+		}
+	}
+}
+
+// Get returns the value in a AnysOrExpressions with the specified name, or nil if there is none.
+func (m *AnysOrExpressions) Get(name string) *AnyOrExpression {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a AnysOrExpressions with the specified name,
+// or adds it after the existing values if there is none.
+func (m *AnysOrExpressions) Set(name string, value *AnyOrExpression) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedAnyOrExpression{Name: name, Value: value})
+}
+
+// Delete removes the value in a AnysOrExpressions with the specified name.
+func (m *AnysOrExpressions) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a AnysOrExpressions in the order that they appear.
+func (m *AnysOrExpressions) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Callback with the specified name, or nil if there is none.
+func (m *Callback) Get(name string) *PathItem {
+	for _, item := range m.GetPath() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Callback with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Callback) Set(name string, value *PathItem) {
+	for _, item := range m.Path {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.Path = append(m.Path, &NamedPathItem{Name: name, Value: value})
+}
+
+// Delete removes the value in a Callback with the specified name.
+func (m *Callback) Delete(name string) {
+	for i, item := range m.Path {
+		if item.Name == name {
+			m.Path = append(m.Path[:i], m.Path[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Callback in the order that they appear.
+func (m *Callback) Names() []string {
+	names := make([]string, 0, len(m.GetPath()))
+	for _, item := range m.GetPath() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a CallbacksOrReferences with the specified name, or nil if there is none.
+func (m *CallbacksOrReferences) Get(name string) *CallbackOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a CallbacksOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *CallbacksOrReferences) Set(name string, value *CallbackOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedCallbackOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a CallbacksOrReferences with the specified name.
+func (m *CallbacksOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a CallbacksOrReferences in the order that they appear.
+func (m *CallbacksOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Encodings with the specified name, or nil if there is none.
+func (m *Encodings) Get(name string) *Encoding {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Encodings with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Encodings) Set(name string, value *Encoding) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedEncoding{Name: name, Value: value})
+}
+
+// Delete removes the value in a Encodings with the specified name.
+func (m *Encodings) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Encodings in the order that they appear.
+func (m *Encodings) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a ExamplesOrReferences with the specified name, or nil if there is none.
+func (m *ExamplesOrReferences) Get(name string) *ExampleOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a ExamplesOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *ExamplesOrReferences) Set(name string, value *ExampleOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedExampleOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a ExamplesOrReferences with the specified name.
+func (m *ExamplesOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a ExamplesOrReferences in the order that they appear.
+func (m *ExamplesOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Expression with the specified name, or nil if there is none.
+func (m *Expression) Get(name string) *Any {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Expression with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Expression) Set(name string, value *Any) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedAny{Name: name, Value: value})
+}
+
+// Delete removes the value in a Expression with the specified name.
+func (m *Expression) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Expression in the order that they appear.
+func (m *Expression) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a HeadersOrReferences with the specified name, or nil if there is none.
+func (m *HeadersOrReferences) Get(name string) *HeaderOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a HeadersOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *HeadersOrReferences) Set(name string, value *HeaderOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedHeaderOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a HeadersOrReferences with the specified name.
+func (m *HeadersOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a HeadersOrReferences in the order that they appear.
+func (m *HeadersOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a LinksOrReferences with the specified name, or nil if there is none.
+func (m *LinksOrReferences) Get(name string) *LinkOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a LinksOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *LinksOrReferences) Set(name string, value *LinkOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedLinkOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a LinksOrReferences with the specified name.
+func (m *LinksOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a LinksOrReferences in the order that they appear.
+func (m *LinksOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a MediaTypes with the specified name, or nil if there is none.
+func (m *MediaTypes) Get(name string) *MediaType {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a MediaTypes with the specified name,
+// or adds it after the existing values if there is none.
+func (m *MediaTypes) Set(name string, value *MediaType) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedMediaType{Name: name, Value: value})
+}
+
+// Delete removes the value in a MediaTypes with the specified name.
+func (m *MediaTypes) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a MediaTypes in the order that they appear.
+func (m *MediaTypes) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Object with the specified name, or nil if there is none.
+func (m *Object) Get(name string) *Any {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Object with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Object) Set(name string, value *Any) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedAny{Name: name, Value: value})
+}
+
+// Delete removes the value in a Object with the specified name.
+func (m *Object) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Object in the order that they appear.
+func (m *Object) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a ParametersOrReferences with the specified name, or nil if there is none.
+func (m *ParametersOrReferences) Get(name string) *ParameterOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a ParametersOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *ParametersOrReferences) Set(name string, value *ParameterOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedParameterOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a ParametersOrReferences with the specified name.
+func (m *ParametersOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a ParametersOrReferences in the order that they appear.
+func (m *ParametersOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Paths with the specified name, or nil if there is none.
+func (m *Paths) Get(name string) *PathItem {
+	for _, item := range m.GetPath() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Paths with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Paths) Set(name string, value *PathItem) {
+	for _, item := range m.Path {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.Path = append(m.Path, &NamedPathItem{Name: name, Value: value})
+}
+
+// Delete removes the value in a Paths with the specified name.
+func (m *Paths) Delete(name string) {
+	for i, item := range m.Path {
+		if item.Name == name {
+			m.Path = append(m.Path[:i], m.Path[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Paths in the order that they appear.
+func (m *Paths) Names() []string {
+	names := make([]string, 0, len(m.GetPath()))
+	for _, item := range m.GetPath() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Properties with the specified name, or nil if there is none.
+func (m *Properties) Get(name string) *SchemaOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Properties with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Properties) Set(name string, value *SchemaOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSchemaOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a Properties with the specified name.
+func (m *Properties) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Properties in the order that they appear.
+func (m *Properties) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a RequestBodiesOrReferences with the specified name, or nil if there is none.
+func (m *RequestBodiesOrReferences) Get(name string) *RequestBodyOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a RequestBodiesOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *RequestBodiesOrReferences) Set(name string, value *RequestBodyOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedRequestBodyOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a RequestBodiesOrReferences with the specified name.
+func (m *RequestBodiesOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a RequestBodiesOrReferences in the order that they appear.
+func (m *RequestBodiesOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Responses with the specified name, or nil if there is none.
+func (m *Responses) Get(name string) *ResponseOrReference {
+	for _, item := range m.GetResponseOrReference() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Responses with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Responses) Set(name string, value *ResponseOrReference) {
+	for _, item := range m.ResponseOrReference {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.ResponseOrReference = append(m.ResponseOrReference, &NamedResponseOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a Responses with the specified name.
+func (m *Responses) Delete(name string) {
+	for i, item := range m.ResponseOrReference {
+		if item.Name == name {
+			m.ResponseOrReference = append(m.ResponseOrReference[:i], m.ResponseOrReference[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Responses in the order that they appear.
+func (m *Responses) Names() []string {
+	names := make([]string, 0, len(m.GetResponseOrReference()))
+	for _, item := range m.GetResponseOrReference() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a ResponsesOrReferences with the specified name, or nil if there is none.
+func (m *ResponsesOrReferences) Get(name string) *ResponseOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a ResponsesOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *ResponsesOrReferences) Set(name string, value *ResponseOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedResponseOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a ResponsesOrReferences with the specified name.
+func (m *ResponsesOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a ResponsesOrReferences in the order that they appear.
+func (m *ResponsesOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a SchemasOrReferences with the specified name, or nil if there is none.
+func (m *SchemasOrReferences) Get(name string) *SchemaOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a SchemasOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *SchemasOrReferences) Set(name string, value *SchemaOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSchemaOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a SchemasOrReferences with the specified name.
+func (m *SchemasOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a SchemasOrReferences in the order that they appear.
+func (m *SchemasOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a SecurityRequirement with the specified name, or nil if there is none.
+func (m *SecurityRequirement) Get(name string) *StringArray {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a SecurityRequirement with the specified name,
+// or adds it after the existing values if there is none.
+func (m *SecurityRequirement) Set(name string, value *StringArray) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedStringArray{Name: name, Value: value})
+}
+
+// Delete removes the value in a SecurityRequirement with the specified name.
+func (m *SecurityRequirement) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a SecurityRequirement in the order that they appear.
+func (m *SecurityRequirement) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a SecuritySchemesOrReferences with the specified name, or nil if there is none.
+func (m *SecuritySchemesOrReferences) Get(name string) *SecuritySchemeOrReference {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a SecuritySchemesOrReferences with the specified name,
+// or adds it after the existing values if there is none.
+func (m *SecuritySchemesOrReferences) Set(name string, value *SecuritySchemeOrReference) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSecuritySchemeOrReference{Name: name, Value: value})
+}
+
+// Delete removes the value in a SecuritySchemesOrReferences with the specified name.
+func (m *SecuritySchemesOrReferences) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a SecuritySchemesOrReferences in the order that they appear.
+func (m *SecuritySchemesOrReferences) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a ServerVariables with the specified name, or nil if there is none.
+func (m *ServerVariables) Get(name string) *ServerVariable {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a ServerVariables with the specified name,
+// or adds it after the existing values if there is none.
+func (m *ServerVariables) Set(name string, value *ServerVariable) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedServerVariable{Name: name, Value: value})
+}
+
+// Delete removes the value in a ServerVariables with the specified name.
+func (m *ServerVariables) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a ServerVariables in the order that they appear.
+func (m *ServerVariables) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Strings with the specified name, or "" if there is none.
+func (m *Strings) Get(name string) string {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return ""
+}
+
+// Set replaces the value in a Strings with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Strings) Set(name string, value string) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedString{Name: name, Value: value})
+}
+
+// Delete removes the value in a Strings with the specified name.
+func (m *Strings) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Strings in the order that they appear.
+func (m *Strings) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
 var (
 	pattern0 = regexp.MustCompile("^")
 	pattern1 = regexp.MustCompile("^x-")
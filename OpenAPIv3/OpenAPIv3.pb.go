@@ -54,6 +54,7 @@ It has these top-level messages:
 	NamedSecuritySchemeOrReference
 	NamedServerVariable
 	NamedString
+	NamedStringArray
 	OauthFlow
 	OauthFlows
 	Object
@@ -2288,6 +2289,32 @@ func (m *NamedString) GetValue() string {
 	return ""
 }
 
+// Automatically-generated message used to represent maps of StringArray as ordered (name,value) pairs.
+type NamedStringArray struct {
+	// Map key
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Mapped value
+	Value *StringArray `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *NamedStringArray) Reset()                    { *m = NamedStringArray{} }
+func (m *NamedStringArray) String() string            { return proto.CompactTextString(m) }
+func (*NamedStringArray) ProtoMessage()               {}
+func (*NamedStringArray) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *NamedStringArray) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamedStringArray) GetValue() *StringArray {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
 // Configuration details for a supported OAuth Flow
 type OauthFlow struct {
 	AuthorizationUrl       string      `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl" json:"authorization_url,omitempty"`
@@ -2300,7 +2327,7 @@ type OauthFlow struct {
 func (m *OauthFlow) Reset()                    { *m = OauthFlow{} }
 func (m *OauthFlow) String() string            { return proto.CompactTextString(m) }
 func (*OauthFlow) ProtoMessage()               {}
-func (*OauthFlow) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*OauthFlow) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *OauthFlow) GetAuthorizationUrl() string {
 	if m != nil {
@@ -2349,7 +2376,7 @@ type OauthFlows struct {
 func (m *OauthFlows) Reset()                    { *m = OauthFlows{} }
 func (m *OauthFlows) String() string            { return proto.CompactTextString(m) }
 func (*OauthFlows) ProtoMessage()               {}
-func (*OauthFlows) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*OauthFlows) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *OauthFlows) GetImplicit() *OauthFlow {
 	if m != nil {
@@ -2393,7 +2420,7 @@ type Object struct {
 func (m *Object) Reset()                    { *m = Object{} }
 func (m *Object) String() string            { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()               {}
-func (*Object) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*Object) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *Object) GetAdditionalProperties() []*NamedAny {
 	if m != nil {
@@ -2422,7 +2449,7 @@ type Operation struct {
 func (m *Operation) Reset()                    { *m = Operation{} }
 func (m *Operation) String() string            { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()               {}
-func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *Operation) GetTags() []string {
 	if m != nil {
@@ -2536,7 +2563,7 @@ type Parameter struct {
 func (m *Parameter) Reset()                    { *m = Parameter{} }
 func (m *Parameter) String() string            { return proto.CompactTextString(m) }
 func (*Parameter) ProtoMessage()               {}
-func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *Parameter) GetName() string {
 	if m != nil {
@@ -2646,7 +2673,7 @@ type ParameterOrReference struct {
 func (m *ParameterOrReference) Reset()                    { *m = ParameterOrReference{} }
 func (m *ParameterOrReference) String() string            { return proto.CompactTextString(m) }
 func (*ParameterOrReference) ProtoMessage()               {}
-func (*ParameterOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*ParameterOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 type isParameterOrReference_Oneof interface {
 	isParameterOrReference_Oneof()
@@ -2764,7 +2791,7 @@ type ParametersOrReferences struct {
 func (m *ParametersOrReferences) Reset()                    { *m = ParametersOrReferences{} }
 func (m *ParametersOrReferences) String() string            { return proto.CompactTextString(m) }
 func (*ParametersOrReferences) ProtoMessage()               {}
-func (*ParametersOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*ParametersOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *ParametersOrReferences) GetAdditionalProperties() []*NamedParameterOrReference {
 	if m != nil {
@@ -2794,7 +2821,7 @@ type PathItem struct {
 func (m *PathItem) Reset()                    { *m = PathItem{} }
 func (m *PathItem) String() string            { return proto.CompactTextString(m) }
 func (*PathItem) ProtoMessage()               {}
-func (*PathItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*PathItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *PathItem) GetXRef() string {
 	if m != nil {
@@ -2903,7 +2930,7 @@ type Paths struct {
 func (m *Paths) Reset()                    { *m = Paths{} }
 func (m *Paths) String() string            { return proto.CompactTextString(m) }
 func (*Paths) ProtoMessage()               {}
-func (*Paths) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*Paths) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *Paths) GetPath() []*NamedPathItem {
 	if m != nil {
@@ -2926,7 +2953,7 @@ type Properties struct {
 func (m *Properties) Reset()                    { *m = Properties{} }
 func (m *Properties) String() string            { return proto.CompactTextString(m) }
 func (*Properties) ProtoMessage()               {}
-func (*Properties) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*Properties) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *Properties) GetAdditionalProperties() []*NamedSchemaOrReference {
 	if m != nil {
@@ -2943,7 +2970,7 @@ type Reference struct {
 func (m *Reference) Reset()                    { *m = Reference{} }
 func (m *Reference) String() string            { return proto.CompactTextString(m) }
 func (*Reference) ProtoMessage()               {}
-func (*Reference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*Reference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *Reference) GetXRef() string {
 	if m != nil {
@@ -2959,7 +2986,7 @@ type RequestBodiesOrReferences struct {
 func (m *RequestBodiesOrReferences) Reset()                    { *m = RequestBodiesOrReferences{} }
 func (m *RequestBodiesOrReferences) String() string            { return proto.CompactTextString(m) }
 func (*RequestBodiesOrReferences) ProtoMessage()               {}
-func (*RequestBodiesOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*RequestBodiesOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *RequestBodiesOrReferences) GetAdditionalProperties() []*NamedRequestBodyOrReference {
 	if m != nil {
@@ -2979,7 +3006,7 @@ type RequestBody struct {
 func (m *RequestBody) Reset()                    { *m = RequestBody{} }
 func (m *RequestBody) String() string            { return proto.CompactTextString(m) }
 func (*RequestBody) ProtoMessage()               {}
-func (*RequestBody) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*RequestBody) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *RequestBody) GetDescription() string {
 	if m != nil {
@@ -3019,7 +3046,7 @@ type RequestBodyOrReference struct {
 func (m *RequestBodyOrReference) Reset()                    { *m = RequestBodyOrReference{} }
 func (m *RequestBodyOrReference) String() string            { return proto.CompactTextString(m) }
 func (*RequestBodyOrReference) ProtoMessage()               {}
-func (*RequestBodyOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*RequestBodyOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 type isRequestBodyOrReference_Oneof interface {
 	isRequestBodyOrReference_Oneof()
@@ -3142,7 +3169,7 @@ type Response struct {
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *Response) GetDescription() string {
 	if m != nil {
@@ -3189,7 +3216,7 @@ type ResponseOrReference struct {
 func (m *ResponseOrReference) Reset()                    { *m = ResponseOrReference{} }
 func (m *ResponseOrReference) String() string            { return proto.CompactTextString(m) }
 func (*ResponseOrReference) ProtoMessage()               {}
-func (*ResponseOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*ResponseOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 type isResponseOrReference_Oneof interface {
 	isResponseOrReference_Oneof()
@@ -3310,7 +3337,7 @@ type Responses struct {
 func (m *Responses) Reset()                    { *m = Responses{} }
 func (m *Responses) String() string            { return proto.CompactTextString(m) }
 func (*Responses) ProtoMessage()               {}
-func (*Responses) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*Responses) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 func (m *Responses) GetDefault() *ResponseOrReference {
 	if m != nil {
@@ -3340,7 +3367,7 @@ type ResponsesOrReferences struct {
 func (m *ResponsesOrReferences) Reset()                    { *m = ResponsesOrReferences{} }
 func (m *ResponsesOrReferences) String() string            { return proto.CompactTextString(m) }
 func (*ResponsesOrReferences) ProtoMessage()               {}
-func (*ResponsesOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*ResponsesOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *ResponsesOrReferences) GetAdditionalProperties() []*NamedResponseOrReference {
 	if m != nil {
@@ -3392,7 +3419,7 @@ type Schema struct {
 func (m *Schema) Reset()                    { *m = Schema{} }
 func (m *Schema) String() string            { return proto.CompactTextString(m) }
 func (*Schema) ProtoMessage()               {}
-func (*Schema) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*Schema) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *Schema) GetNullable() bool {
 	if m != nil {
@@ -3656,7 +3683,7 @@ type SchemaOrReference struct {
 func (m *SchemaOrReference) Reset()                    { *m = SchemaOrReference{} }
 func (m *SchemaOrReference) String() string            { return proto.CompactTextString(m) }
 func (*SchemaOrReference) ProtoMessage()               {}
-func (*SchemaOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*SchemaOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 type isSchemaOrReference_Oneof interface {
 	isSchemaOrReference_Oneof()
@@ -3774,7 +3801,7 @@ type SchemasOrReferences struct {
 func (m *SchemasOrReferences) Reset()                    { *m = SchemasOrReferences{} }
 func (m *SchemasOrReferences) String() string            { return proto.CompactTextString(m) }
 func (*SchemasOrReferences) ProtoMessage()               {}
-func (*SchemasOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*SchemasOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *SchemasOrReferences) GetAdditionalProperties() []*NamedSchemaOrReference {
 	if m != nil {
@@ -3785,13 +3812,20 @@ func (m *SchemasOrReferences) GetAdditionalProperties() []*NamedSchemaOrReferenc
 
 // Lists the required security schemes to execute this operation. The name used for each property MUST correspond to a security scheme declared in the Security Schemes under the Components Object.  Security Requirement Objects that contain multiple schemes require that all schemes MUST be satisfied for a request to be authorized. This enables support for scenarios where multiple query parameters or HTTP headers are required to convey security information.  When a list of Security Requirement Objects is defined on the Open API object or Operation Object, only one of Security Requirement Objects in the list needs to be satisfied to authorize the request.
 type SecurityRequirement struct {
+	AdditionalProperties []*NamedStringArray `protobuf:"bytes,1,rep,name=additional_properties,json=additionalProperties" json:"additional_properties,omitempty"`
 }
 
 func (m *SecurityRequirement) Reset()                    { *m = SecurityRequirement{} }
 func (m *SecurityRequirement) String() string            { return proto.CompactTextString(m) }
 func (*SecurityRequirement) ProtoMessage()               {}
-func (*SecurityRequirement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*SecurityRequirement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
+func (m *SecurityRequirement) GetAdditionalProperties() []*NamedStringArray {
+	if m != nil {
+		return m.AdditionalProperties
+	}
+	return nil
+}
 // Defines a security scheme that can be used by the operations. Supported schemes are HTTP authentication, an API key (either as a header or as a query parameter), OAuth2's common flows (implicit, password, application and access code) as defined in RFC6749, and OpenID Connect Discovery.
 type SecurityScheme struct {
 	Type                   string      `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
@@ -3808,7 +3842,7 @@ type SecurityScheme struct {
 func (m *SecurityScheme) Reset()                    { *m = SecurityScheme{} }
 func (m *SecurityScheme) String() string            { return proto.CompactTextString(m) }
 func (*SecurityScheme) ProtoMessage()               {}
-func (*SecurityScheme) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*SecurityScheme) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 func (m *SecurityScheme) GetType() string {
 	if m != nil {
@@ -3883,7 +3917,7 @@ type SecuritySchemeOrReference struct {
 func (m *SecuritySchemeOrReference) Reset()                    { *m = SecuritySchemeOrReference{} }
 func (m *SecuritySchemeOrReference) String() string            { return proto.CompactTextString(m) }
 func (*SecuritySchemeOrReference) ProtoMessage()               {}
-func (*SecuritySchemeOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*SecuritySchemeOrReference) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 type isSecuritySchemeOrReference_Oneof interface {
 	isSecuritySchemeOrReference_Oneof()
@@ -4001,7 +4035,7 @@ type SecuritySchemesOrReferences struct {
 func (m *SecuritySchemesOrReferences) Reset()                    { *m = SecuritySchemesOrReferences{} }
 func (m *SecuritySchemesOrReferences) String() string            { return proto.CompactTextString(m) }
 func (*SecuritySchemesOrReferences) ProtoMessage()               {}
-func (*SecuritySchemesOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*SecuritySchemesOrReferences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 func (m *SecuritySchemesOrReferences) GetAdditionalProperties() []*NamedSecuritySchemeOrReference {
 	if m != nil {
@@ -4021,7 +4055,7 @@ type Server struct {
 func (m *Server) Reset()                    { *m = Server{} }
 func (m *Server) String() string            { return proto.CompactTextString(m) }
 func (*Server) ProtoMessage()               {}
-func (*Server) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*Server) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 func (m *Server) GetUrl() string {
 	if m != nil {
@@ -4062,7 +4096,7 @@ type ServerVariable struct {
 func (m *ServerVariable) Reset()                    { *m = ServerVariable{} }
 func (m *ServerVariable) String() string            { return proto.CompactTextString(m) }
 func (*ServerVariable) ProtoMessage()               {}
-func (*ServerVariable) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*ServerVariable) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *ServerVariable) GetEnum() []string {
 	if m != nil {
@@ -4099,7 +4133,7 @@ type ServerVariables struct {
 func (m *ServerVariables) Reset()                    { *m = ServerVariables{} }
 func (m *ServerVariables) String() string            { return proto.CompactTextString(m) }
 func (*ServerVariables) ProtoMessage()               {}
-func (*ServerVariables) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*ServerVariables) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *ServerVariables) GetAdditionalProperties() []*NamedServerVariable {
 	if m != nil {
@@ -4120,7 +4154,7 @@ type SpecificationExtension struct {
 func (m *SpecificationExtension) Reset()                    { *m = SpecificationExtension{} }
 func (m *SpecificationExtension) String() string            { return proto.CompactTextString(m) }
 func (*SpecificationExtension) ProtoMessage()               {}
-func (*SpecificationExtension) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*SpecificationExtension) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 type isSpecificationExtension_Oneof interface {
 	isSpecificationExtension_Oneof()
@@ -4258,7 +4292,7 @@ type StringArray struct {
 func (m *StringArray) Reset()                    { *m = StringArray{} }
 func (m *StringArray) String() string            { return proto.CompactTextString(m) }
 func (*StringArray) ProtoMessage()               {}
-func (*StringArray) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*StringArray) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *StringArray) GetValue() []string {
 	if m != nil {
@@ -4274,7 +4308,7 @@ type Strings struct {
 func (m *Strings) Reset()                    { *m = Strings{} }
 func (m *Strings) String() string            { return proto.CompactTextString(m) }
 func (*Strings) ProtoMessage()               {}
-func (*Strings) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*Strings) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *Strings) GetAdditionalProperties() []*NamedString {
 	if m != nil {
@@ -4294,7 +4328,7 @@ type Tag struct {
 func (m *Tag) Reset()                    { *m = Tag{} }
 func (m *Tag) String() string            { return proto.CompactTextString(m) }
 func (*Tag) ProtoMessage()               {}
-func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *Tag) GetName() string {
 	if m != nil {
@@ -4337,7 +4371,7 @@ type Xml struct {
 func (m *Xml) Reset()                    { *m = Xml{} }
 func (m *Xml) String() string            { return proto.CompactTextString(m) }
 func (*Xml) ProtoMessage()               {}
-func (*Xml) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*Xml) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *Xml) GetName() string {
 	if m != nil {
@@ -4428,6 +4462,7 @@ func init() {
 	proto.RegisterType((*NamedSecuritySchemeOrReference)(nil), "openapi.v3.NamedSecuritySchemeOrReference")
 	proto.RegisterType((*NamedServerVariable)(nil), "openapi.v3.NamedServerVariable")
 	proto.RegisterType((*NamedString)(nil), "openapi.v3.NamedString")
+	proto.RegisterType((*NamedStringArray)(nil), "openapi.v3.NamedStringArray")
 	proto.RegisterType((*OauthFlow)(nil), "openapi.v3.OauthFlow")
 	proto.RegisterType((*OauthFlows)(nil), "openapi.v3.OauthFlows")
 	proto.RegisterType((*Object)(nil), "openapi.v3.Object")
@@ -4466,227 +4501,227 @@ func init() {
 func init() { proto.RegisterFile("OpenAPIv3/OpenAPIv3.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 3539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x49, 0x6f, 0x1c, 0x47,
-	0x96, 0x66, 0xd6, 0x5e, 0xaf, 0xb8, 0x06, 0x17, 0x95, 0x24, 0x4b, 0xa2, 0x48, 0xc9, 0x92, 0xb5,
-	0xda, 0x92, 0xe5, 0xb1, 0x61, 0x7b, 0x3c, 0x94, 0x44, 0x83, 0x02, 0x24, 0x97, 0x26, 0x25, 0xdb,
-	0x1a, 0x7b, 0x8c, 0x42, 0x30, 0x2b, 0x8a, 0x4c, 0x2b, 0x37, 0x65, 0x66, 0x49, 0xac, 0x39, 0x18,
-	0x98, 0x83, 0x81, 0xf1, 0x00, 0x33, 0x98, 0x01, 0x1a, 0xbe, 0xf5, 0xc5, 0xe7, 0x6e, 0x18, 0xfd,
-	0x27, 0x1a, 0x30, 0xd0, 0x7d, 0x6a, 0xa0, 0x7f, 0x40, 0x1f, 0xfb, 0xd2, 0x40, 0x1f, 0xfb, 0xd4,
-	0x88, 0x2d, 0x97, 0xca, 0xc8, 0x24, 0xab, 0x58, 0x46, 0x9f, 0xc8, 0x8c, 0xf7, 0xc5, 0x8b, 0xed,
-	0x6d, 0xf1, 0x5e, 0x14, 0x9c, 0xec, 0x78, 0xc4, 0xd9, 0x7a, 0xfc, 0xe0, 0xe5, 0xed, 0x9b, 0xd1,
-	0x7f, 0x37, 0x3c, 0xdf, 0x0d, 0x5d, 0x04, 0xae, 0x47, 0x1c, 0xec, 0x99, 0x37, 0x5e, 0xde, 0x3e,
-	0x75, 0x72, 0xcf, 0x75, 0xf7, 0x2c, 0x72, 0x93, 0x51, 0x76, 0x07, 0xfd, 0x9b, 0xd8, 0x19, 0x72,
-	0xd8, 0xc6, 0xff, 0x69, 0xd0, 0xde, 0xea, 0xf5, 0xcc, 0xd0, 0x74, 0x1d, 0x6c, 0x3d, 0xf6, 0x5d,
-	0x8f, 0xf8, 0xa1, 0x49, 0x82, 0x07, 0x21, 0xb1, 0x51, 0x07, 0x96, 0x03, 0x63, 0x9f, 0xd8, 0xb8,
-	0xeb, 0xfa, 0x5d, 0x9f, 0xf4, 0x89, 0x4f, 0x1c, 0x83, 0xb4, 0xb5, 0x75, 0xed, 0x72, 0xeb, 0xd6,
-	0x99, 0x1b, 0xf1, 0x08, 0x37, 0x9e, 0x30, 0x58, 0xc7, 0xd7, 0x25, 0x68, 0x67, 0x46, 0x5f, 0x0a,
-	0x46, 0x1b, 0xd1, 0x29, 0xa8, 0xef, 0xba, 0xae, 0x45, 0xb0, 0xd3, 0x2e, 0xad, 0x6b, 0x97, 0x1b,
-	0x3b, 0x33, 0xba, 0x6c, 0xb8, 0x5b, 0x87, 0xaa, 0xeb, 0x10, 0xb7, 0xbf, 0xb1, 0x0d, 0xe5, 0x2d,
-	0x67, 0x88, 0xae, 0x40, 0xf5, 0x25, 0xb6, 0x06, 0x72, 0xb8, 0x95, 0x1b, 0x7c, 0x11, 0x37, 0xe4,
-	0x22, 0x6e, 0x6c, 0x39, 0x43, 0x9d, 0x43, 0x10, 0x82, 0xca, 0x10, 0xdb, 0x16, 0x63, 0xda, 0xd4,
-	0xd9, 0xff, 0x1b, 0x43, 0x58, 0xd8, 0x72, 0x86, 0x1d, 0x7f, 0xfb, 0xc0, 0xf3, 0x49, 0x10, 0x98,
-	0xae, 0x83, 0x36, 0xa1, 0x8c, 0x9d, 0xa1, 0x60, 0xb8, 0x90, 0x9c, 0xff, 0x96, 0x33, 0xdc, 0x99,
-	0xd1, 0x29, 0x15, 0xbd, 0x0b, 0x40, 0xa2, 0x2e, 0x8c, 0x63, 0xeb, 0xd6, 0x5a, 0x12, 0x1b, 0x33,
-	0xdc, 0x99, 0xd1, 0x13, 0xd8, 0x78, 0x05, 0x5f, 0xc3, 0xd2, 0x96, 0x33, 0x0c, 0x92, 0x63, 0x07,
-	0xe8, 0x53, 0x58, 0xc5, 0xd1, 0x46, 0x77, 0xbd, 0x68, 0xa7, 0xdb, 0xda, 0x7a, 0xf9, 0x72, 0xeb,
-	0xd6, 0x7a, 0x72, 0x88, 0x4f, 0xb0, 0x4d, 0x7a, 0x23, 0xb3, 0xd7, 0x57, 0xb0, 0xe2, 0x9c, 0x36,
-	0xfe, 0x4b, 0x83, 0xc6, 0x3d, 0x6c, 0x59, 0xbb, 0xd8, 0x78, 0x8e, 0xae, 0x43, 0xc5, 0xc3, 0xe1,
-	0xbe, 0x60, 0x79, 0x32, 0xc3, 0xf2, 0x31, 0x0e, 0xf7, 0xe9, 0xc9, 0xea, 0x0c, 0x86, 0x1e, 0xc1,
-	0x89, 0xc0, 0x23, 0x86, 0xd9, 0x37, 0x0d, 0x4c, 0x19, 0x77, 0xc9, 0x41, 0x48, 0x1c, 0xb1, 0xee,
-	0x32, 0xdb, 0x74, 0xc5, 0xa4, 0xf4, 0xb5, 0x54, 0xa7, 0x6d, 0xd9, 0x67, 0xe3, 0x3b, 0x0d, 0x96,
-	0xe5, 0x54, 0x92, 0xa7, 0x7e, 0x0b, 0x1a, 0x86, 0x68, 0x8e, 0x0e, 0x33, 0xc1, 0x57, 0x76, 0xd9,
-	0x99, 0xd1, 0x23, 0x1c, 0xba, 0x03, 0xcd, 0x58, 0xe0, 0xf8, 0x21, 0xac, 0x26, 0x3b, 0x25, 0x05,
-	0x2d, 0x46, 0xc6, 0x47, 0xe0, 0xc3, 0xaa, 0xe4, 0x1b, 0x24, 0xe6, 0x12, 0xa0, 0x7f, 0x2b, 0x3e,
-	0x86, 0x0b, 0x99, 0x15, 0x2b, 0x56, 0x94, 0x73, 0x14, 0xbf, 0xaa, 0x02, 0xdc, 0x73, 0x6d, 0xcf,
-	0x75, 0x88, 0x13, 0x06, 0xe8, 0x3d, 0xa8, 0x73, 0x0d, 0x08, 0xc4, 0xaa, 0xcf, 0x65, 0x35, 0x26,
-	0x35, 0x37, 0x5d, 0xe2, 0xd1, 0x47, 0x74, 0xf5, 0x81, 0xe7, 0x3a, 0x01, 0x09, 0xc4, 0xea, 0xcf,
-	0xa7, 0x57, 0x2f, 0x88, 0xa9, 0xee, 0x71, 0x1f, 0x74, 0x17, 0xc0, 0xc3, 0x3e, 0xb6, 0x49, 0x48,
-	0xfc, 0xa0, 0x5d, 0x66, 0x1c, 0x36, 0x92, 0x1c, 0x1e, 0x47, 0xd4, 0x14, 0x8b, 0x44, 0x2f, 0xf4,
-	0x01, 0x34, 0xc8, 0x01, 0xb6, 0x3d, 0x8b, 0x04, 0xed, 0x0a, 0xe3, 0xb0, 0x9e, 0x56, 0x03, 0x4e,
-	0x4b, 0xf5, 0x8f, 0x7a, 0xa0, 0x87, 0x30, 0xef, 0x93, 0x17, 0x03, 0x12, 0x84, 0xdd, 0x5d, 0xb7,
-	0x47, 0x37, 0xb8, 0xca, 0x78, 0x5c, 0x4c, 0xaf, 0x83, 0x21, 0xee, 0x32, 0x40, 0x8a, 0xd1, 0x9c,
-	0x9f, 0x24, 0xd1, 0xbd, 0xdc, 0x27, 0xb8, 0x47, 0x17, 0x53, 0xcb, 0xee, 0xe5, 0x0e, 0x27, 0xa5,
-	0xf7, 0x52, 0xe0, 0x91, 0x0e, 0x8b, 0x01, 0x31, 0x06, 0xbe, 0x19, 0x0e, 0xbb, 0x6c, 0x7f, 0x49,
-	0xd0, 0xae, 0x33, 0x1e, 0x97, 0x52, 0xe7, 0x21, 0x30, 0x4f, 0x38, 0x24, 0xc5, 0x6b, 0x21, 0x48,
-	0x13, 0xd1, 0x6d, 0xa8, 0x5a, 0xa6, 0xf3, 0x3c, 0x68, 0x37, 0xb2, 0xa6, 0xf0, 0x21, 0x25, 0xa4,
-	0xba, 0x73, 0x2c, 0x3d, 0x54, 0x29, 0xde, 0x41, 0xbb, 0x99, 0x3d, 0x54, 0xa5, 0xbc, 0xea, 0x71,
-	0x9f, 0x22, 0x75, 0x85, 0x09, 0xd4, 0xf5, 0x17, 0x1a, 0xd4, 0xef, 0xb9, 0x4e, 0x88, 0x8d, 0x90,
-	0x1a, 0x50, 0x07, 0xdb, 0xdc, 0xd6, 0x36, 0x75, 0xf6, 0x3f, 0x5a, 0x84, 0xf2, 0xc0, 0x97, 0x36,
-	0x95, 0xfe, 0x8b, 0x56, 0xa0, 0x4a, 0x6c, 0x6c, 0x5a, 0x4c, 0xa0, 0x9a, 0x3a, 0xff, 0x28, 0x9a,
-	0x56, 0x65, 0x82, 0x69, 0xf5, 0xa1, 0x75, 0x9f, 0xf4, 0xf1, 0xc0, 0x0a, 0x9f, 0x0e, 0x3d, 0x82,
-	0xda, 0x50, 0x73, 0x06, 0xf6, 0x2e, 0xf1, 0xd9, 0xdc, 0xb4, 0x9d, 0x19, 0x5d, 0x7c, 0x17, 0x39,
-	0x13, 0xda, 0x2b, 0x08, 0x7d, 0xd3, 0xd9, 0xe3, 0x53, 0xa5, 0xbd, 0xf8, 0x77, 0x6c, 0x21, 0x0c,
-	0x98, 0xbb, 0x6f, 0x06, 0x86, 0x6f, 0xda, 0xa6, 0x83, 0x43, 0xd7, 0x47, 0x9b, 0x30, 0x27, 0xcc,
-	0xc1, 0xb0, 0x9b, 0xd8, 0x8c, 0x59, 0xd9, 0x48, 0x67, 0x8e, 0xae, 0x43, 0xdd, 0xc6, 0x9e, 0x47,
-	0x39, 0x73, 0xbd, 0x5c, 0x4e, 0x09, 0x11, 0x1b, 0x23, 0xd0, 0x25, 0x66, 0xe3, 0xa7, 0x32, 0x34,
-	0xee, 0xbb, 0xc6, 0xc0, 0x26, 0x4e, 0x88, 0xda, 0x50, 0x17, 0x58, 0xc1, 0x5a, 0x7e, 0xa2, 0x0b,
-	0x50, 0x31, 0x9d, 0xbe, 0x2b, 0x58, 0x2e, 0x26, 0x59, 0x3e, 0x70, 0xfa, 0xae, 0xce, 0xa8, 0xe8,
-	0x1a, 0xd4, 0x03, 0xe2, 0xbf, 0xe4, 0x1a, 0x4d, 0x37, 0x16, 0xa5, 0x05, 0x98, 0x92, 0x74, 0x09,
-	0x41, 0x97, 0xa0, 0x4a, 0x8d, 0xbc, 0xd4, 0xdd, 0xa5, 0xb4, 0xf6, 0x87, 0xfb, 0x81, 0xce, 0xe9,
-	0xe8, 0x1d, 0x00, 0x23, 0xb2, 0x5a, 0x42, 0x4b, 0x53, 0x0e, 0x2f, 0xb6, 0x69, 0x7a, 0x02, 0x89,
-	0xde, 0x87, 0x86, 0xd4, 0x8b, 0x76, 0x8d, 0xcd, 0xe7, 0x9c, 0x4a, 0xa1, 0xa8, 0x8e, 0x9b, 0x3e,
-	0xa1, 0x3b, 0xa0, 0x47, 0x1d, 0xd0, 0x26, 0x54, 0x42, 0xbc, 0x47, 0x35, 0xb1, 0x3c, 0xea, 0x8b,
-	0x9f, 0xe2, 0x3d, 0x9d, 0x11, 0xd1, 0x87, 0x30, 0x47, 0x65, 0xc9, 0xa7, 0x96, 0xba, 0xe7, 0x1a,
-	0x52, 0xdd, 0xda, 0x69, 0x33, 0xc4, 0x01, 0xf7, 0x5d, 0x23, 0xd0, 0x67, 0x49, 0xe2, 0xab, 0x48,
-	0x30, 0x9b, 0x13, 0x08, 0xe6, 0xff, 0x96, 0xa0, 0xb1, 0xed, 0x18, 0x6e, 0xcf, 0x74, 0xf6, 0xd0,
-	0x79, 0x98, 0x35, 0x5c, 0x27, 0x24, 0x4e, 0xd8, 0x0d, 0x87, 0x9e, 0x94, 0x95, 0x96, 0x68, 0x63,
-	0x92, 0x9b, 0xb0, 0x59, 0xa5, 0x31, 0x6d, 0xd6, 0x0a, 0x54, 0x83, 0x70, 0x68, 0x11, 0xa9, 0x68,
-	0xec, 0x83, 0xca, 0x0f, 0x39, 0xf0, 0x2c, 0xb7, 0x47, 0xd8, 0x99, 0x36, 0x74, 0xf9, 0x89, 0x2e,
-	0xc2, 0x3c, 0xb6, 0x2c, 0xf7, 0x55, 0xd7, 0x27, 0xec, 0xf8, 0x7b, 0xec, 0x18, 0x1b, 0xfa, 0x1c,
-	0x6b, 0xd5, 0x45, 0x63, 0xd1, 0x86, 0xd4, 0x26, 0xd8, 0x90, 0x2f, 0xa1, 0x29, 0xf7, 0x23, 0x40,
-	0x9f, 0x14, 0xfb, 0xd5, 0x6c, 0x2c, 0x22, 0xbb, 0xe6, 0x38, 0xd3, 0x3f, 0x69, 0x50, 0x17, 0x2e,
-	0x86, 0x2e, 0x3c, 0x18, 0xd8, 0x36, 0xf6, 0x87, 0x52, 0x71, 0xc4, 0x27, 0x5a, 0x87, 0x56, 0x8f,
-	0x50, 0x25, 0xf6, 0x42, 0x19, 0xad, 0x35, 0xf5, 0x64, 0x13, 0xba, 0x28, 0xc3, 0xc8, 0xb2, 0x32,
-	0xea, 0x93, 0x11, 0xe4, 0x45, 0x98, 0x8f, 0x44, 0x8d, 0xe3, 0x2b, 0x8c, 0x57, 0x24, 0x80, 0x9f,
-	0x31, 0x58, 0xc1, 0x0e, 0x56, 0x27, 0xd8, 0xc1, 0x6f, 0x35, 0x40, 0x62, 0x91, 0xc9, 0x80, 0xe9,
-	0x26, 0x3d, 0x68, 0xd6, 0x2a, 0x22, 0x87, 0x65, 0x85, 0xe3, 0xa5, 0xe6, 0x4e, 0xa0, 0x8e, 0x1d,
-	0x2d, 0x79, 0xb0, 0xa2, 0x72, 0xe7, 0xe8, 0x59, 0xf1, 0xa1, 0x6e, 0x66, 0x0f, 0x35, 0xb3, 0x98,
-	0x9c, 0xe3, 0xfd, 0x1c, 0x20, 0x11, 0x98, 0x3f, 0x28, 0x1e, 0x47, 0xbd, 0xa9, 0x6a, 0xc6, 0xff,
-	0xaf, 0xc1, 0x6c, 0xd2, 0x26, 0x8c, 0x8a, 0x88, 0x96, 0x15, 0x91, 0xac, 0xa3, 0x2b, 0x38, 0xe6,
-	0xf2, 0x04, 0xc7, 0xfc, 0xdf, 0x15, 0xa8, 0x71, 0x7d, 0x3f, 0xc2, 0x6c, 0x4e, 0x41, 0xc3, 0xe7,
-	0x26, 0xb3, 0xc7, 0xfd, 0x9a, 0x1e, 0x7d, 0xa3, 0xb3, 0x00, 0x3d, 0xe2, 0xf9, 0xc4, 0xc0, 0x21,
-	0xe9, 0x31, 0x89, 0x6e, 0xe8, 0x89, 0x16, 0x74, 0x05, 0x96, 0xb8, 0x1d, 0x20, 0xb6, 0x17, 0x0e,
-	0x13, 0x82, 0xdc, 0xd0, 0x17, 0x18, 0x61, 0x9b, 0xb6, 0x73, 0x51, 0x8e, 0x6c, 0x4c, 0x35, 0xc7,
-	0xc6, 0xd4, 0x0e, 0xb3, 0x31, 0x75, 0x95, 0x8d, 0xb9, 0x03, 0x35, 0x1e, 0xc5, 0xaa, 0x62, 0xa3,
-	0xcc, 0x35, 0x51, 0x17, 0x60, 0xf4, 0x46, 0x2c, 0xf2, 0x4d, 0xb5, 0xa2, 0x46, 0xc2, 0x9e, 0x8c,
-	0x4b, 0x61, 0xec, 0xb8, 0xf4, 0x4d, 0xa8, 0x0b, 0x23, 0xdd, 0x6e, 0x65, 0x5d, 0xdd, 0x23, 0xd2,
-	0x33, 0x31, 0xb5, 0xde, 0x81, 0x2e, 0x61, 0x45, 0xc2, 0x30, 0x3b, 0x81, 0x30, 0xfc, 0xa7, 0x06,
-	0x4b, 0x5c, 0x18, 0x92, 0x2a, 0x7f, 0x0d, 0x6a, 0xdc, 0xf8, 0x0b, 0x8d, 0x47, 0x59, 0x5f, 0x41,
-	0x83, 0x18, 0x8e, 0x39, 0xb6, 0xbe, 0x3b, 0xb0, 0xac, 0xf0, 0x3f, 0xe8, 0xf3, 0x62, 0x35, 0xdc,
-	0xc8, 0xac, 0x33, 0xb3, 0x8e, 0x1c, 0xa5, 0xfc, 0xb1, 0x04, 0x15, 0x1a, 0xc8, 0x50, 0xa1, 0x0b,
-	0xcd, 0xd0, 0x92, 0xfe, 0x92, 0x7f, 0x1c, 0xc1, 0x8a, 0x5f, 0x86, 0xc5, 0x90, 0xf8, 0x76, 0xd0,
-	0x75, 0xfb, 0x5d, 0x2a, 0x68, 0xa6, 0x21, 0x7d, 0xe3, 0x3c, 0x6b, 0xef, 0xf4, 0x9f, 0xf0, 0x56,
-	0x1a, 0xa0, 0x19, 0x3c, 0xa8, 0x15, 0x81, 0xcf, 0x72, 0x3a, 0x94, 0x61, 0x24, 0x5d, 0x62, 0x28,
-	0xdc, 0x32, 0x0d, 0xe2, 0x04, 0x44, 0x44, 0x3e, 0xcb, 0xe9, 0x58, 0x9e, 0x91, 0x74, 0x89, 0xa1,
-	0xea, 0x41, 0x83, 0x2b, 0xee, 0x31, 0x99, 0x27, 0x12, 0x9f, 0x45, 0x52, 0x52, 0x9f, 0x40, 0x4a,
-	0xbe, 0x80, 0x26, 0xbd, 0xa8, 0xf3, 0x3c, 0xcc, 0xa3, 0xbc, 0x3c, 0x4c, 0xf9, 0x70, 0x05, 0xcb,
-	0x66, 0x61, 0x36, 0xbe, 0x81, 0xba, 0x58, 0xd8, 0x11, 0xe3, 0xfe, 0x29, 0x9b, 0xc3, 0x3f, 0x97,
-	0xa0, 0x42, 0x6f, 0x49, 0x34, 0xe2, 0xa6, 0x22, 0xc2, 0x79, 0xfa, 0xa4, 0x2f, 0x23, 0xee, 0xa8,
-	0x51, 0x27, 0x7d, 0x1a, 0x69, 0xc5, 0x20, 0xb3, 0x27, 0xa5, 0x23, 0x6a, 0x7b, 0xd0, 0x43, 0x1f,
-	0x2a, 0x6e, 0xbb, 0x67, 0x46, 0xec, 0x47, 0x3a, 0x1b, 0x93, 0xba, 0xe8, 0xfe, 0x33, 0xcc, 0x26,
-	0xae, 0xaa, 0x43, 0x21, 0x37, 0xa7, 0x47, 0x18, 0xa4, 0x72, 0x31, 0xad, 0xf8, 0x7a, 0x9a, 0x09,
-	0x42, 0xaa, 0x59, 0xf1, 0xbd, 0x02, 0x35, 0x1e, 0x96, 0x8b, 0xdb, 0xab, 0x2a, 0x70, 0x17, 0x88,
-	0x69, 0x0b, 0xd2, 0x10, 0x16, 0xe8, 0x5e, 0x27, 0x6d, 0xcd, 0xeb, 0x50, 0xa1, 0x37, 0x52, 0x61,
-	0x69, 0x16, 0x47, 0x2f, 0xaf, 0x3b, 0x33, 0x3a, 0xa3, 0x1f, 0xdb, 0xca, 0x7c, 0x0d, 0x4b, 0x99,
-	0xcb, 0xf0, 0xf8, 0x69, 0xb0, 0x91, 0xd9, 0xe7, 0x58, 0x98, 0x5f, 0x97, 0xa0, 0x19, 0x19, 0xef,
-	0x84, 0x13, 0xd2, 0x26, 0x74, 0x42, 0xa5, 0x31, 0x9c, 0x50, 0x79, 0x6c, 0x27, 0xf4, 0x16, 0x34,
-	0x88, 0x08, 0x7f, 0x85, 0xb4, 0xa5, 0x36, 0x36, 0x8a, 0xaa, 0xf5, 0x08, 0x36, 0xed, 0xc8, 0xf3,
-	0x2b, 0x80, 0xd8, 0xd7, 0xa1, 0x4e, 0xf1, 0xa1, 0x9c, 0xca, 0xb0, 0x8e, 0xfa, 0xe6, 0x1c, 0xc7,
-	0x36, 0x34, 0xe4, 0x14, 0x94, 0x36, 0x26, 0x8a, 0xca, 0x4b, 0x45, 0x51, 0xf9, 0xc6, 0x57, 0xb0,
-	0xa2, 0x4a, 0x85, 0x2a, 0x59, 0xbe, 0x95, 0x66, 0x59, 0xa8, 0xbe, 0x82, 0x3d, 0x81, 0x76, 0x5e,
-	0x8a, 0x4f, 0x39, 0xc4, 0x9d, 0xf4, 0x10, 0xe7, 0x54, 0xd9, 0x9b, 0xa4, 0x5c, 0x89, 0x61, 0x3a,
-	0x30, 0x97, 0xba, 0xf1, 0x28, 0x79, 0x5f, 0x49, 0xf3, 0x5e, 0x51, 0xc9, 0x83, 0x64, 0x68, 0xc0,
-	0x89, 0x9c, 0x68, 0x5b, 0xc9, 0xfa, 0xed, 0x34, 0xeb, 0xb3, 0x0a, 0x41, 0x55, 0xcc, 0x1a, 0xc3,
-	0x9a, 0xda, 0xc7, 0x2b, 0xc7, 0xb8, 0x9d, 0x1e, 0xe3, 0x4c, 0x36, 0x7c, 0x51, 0x0c, 0x21, 0x8f,
-	0x77, 0xd4, 0x40, 0x8d, 0x7b, 0xbc, 0xa3, 0x26, 0x42, 0xb0, 0xff, 0x57, 0x98, 0x4f, 0x0b, 0xab,
-	0x92, 0xf1, 0xd5, 0x34, 0xe3, 0x55, 0x65, 0x38, 0x28, 0x59, 0xee, 0xc1, 0x49, 0x91, 0x48, 0x17,
-	0xde, 0xe3, 0xb0, 0x69, 0xbf, 0x93, 0xe6, 0xbe, 0xae, 0xcc, 0xc1, 0x16, 0xc8, 0x8c, 0xcc, 0xd8,
-	0x8f, 0x2d, 0x33, 0x51, 0xaa, 0x5f, 0x30, 0x7c, 0x0e, 0xa7, 0x19, 0xc3, 0x38, 0xe5, 0x3a, 0x3c,
-	0x6c, 0xee, 0xef, 0xa6, 0xd9, 0x6f, 0xa8, 0x33, 0xb7, 0x43, 0xc5, 0xec, 0xa5, 0x62, 0xc9, 0x3c,
-	0xf5, 0x71, 0x14, 0x4b, 0xc1, 0x63, 0x54, 0x44, 0x33, 0x16, 0x7d, 0x6c, 0x11, 0xcd, 0xfa, 0x04,
-	0x31, 0xc4, 0x0b, 0x38, 0xcb, 0x87, 0x48, 0x65, 0x80, 0x0f, 0x1b, 0xea, 0xfd, 0xf4, 0x50, 0x17,
-	0xf3, 0x13, 0xcd, 0x8a, 0x21, 0xbf, 0x84, 0x65, 0x31, 0x24, 0x8d, 0x07, 0x3e, 0xc3, 0xbe, 0x89,
-	0x77, 0x2d, 0xf5, 0x38, 0x6f, 0xa6, 0xc7, 0x39, 0x95, 0x0d, 0x2b, 0x64, 0x77, 0xc9, 0xfc, 0x9f,
-	0xa0, 0xc5, 0x99, 0xb3, 0x4c, 0xa5, 0x92, 0xe9, 0x4a, 0x92, 0x69, 0x53, 0x76, 0xfc, 0xab, 0x06,
-	0xcd, 0x0e, 0x1e, 0x84, 0xfb, 0x1f, 0x5b, 0xee, 0x2b, 0x74, 0x15, 0x96, 0xe8, 0xff, 0xae, 0x6f,
-	0xfe, 0x07, 0xf7, 0x46, 0x34, 0x62, 0xe4, 0x4c, 0x16, 0x53, 0x84, 0x4f, 0x7d, 0x0b, 0x9d, 0x86,
-	0x66, 0xe8, 0x3e, 0x27, 0x1c, 0xc4, 0x99, 0x36, 0x58, 0x03, 0x25, 0x9e, 0x83, 0x96, 0x4f, 0xfa,
-	0x3e, 0x09, 0xf6, 0x19, 0x99, 0x07, 0xf5, 0x20, 0x9a, 0x28, 0xe0, 0x2a, 0xf5, 0xe5, 0xae, 0x17,
-	0x15, 0x21, 0x94, 0x09, 0x57, 0x01, 0x99, 0xb6, 0x97, 0xfc, 0x7d, 0x09, 0x20, 0x5a, 0x34, 0x73,
-	0xdb, 0xa6, 0xed, 0x59, 0xa6, 0x61, 0x86, 0x22, 0xb0, 0x48, 0x59, 0x8b, 0x08, 0xa9, 0x47, 0x30,
-	0xda, 0xc5, 0xc3, 0x41, 0xf0, 0xca, 0xf5, 0x7b, 0x2a, 0x03, 0x93, 0xe8, 0x22, 0x61, 0xe8, 0x3e,
-	0x20, 0xc3, 0x32, 0x89, 0x13, 0x76, 0x0d, 0x9f, 0xf4, 0x88, 0x13, 0x9a, 0xd8, 0x92, 0x41, 0x46,
-	0x4e, 0xe7, 0x25, 0xde, 0xe1, 0x5e, 0x8c, 0xa7, 0x5c, 0xd2, 0x27, 0x64, 0xc8, 0xbc, 0x61, 0x3e,
-	0x97, 0x54, 0x87, 0x7b, 0xf4, 0xd2, 0x3f, 0xe5, 0xfd, 0x7c, 0x02, 0xb5, 0xce, 0xee, 0xd7, 0xc4,
-	0x08, 0xa7, 0x99, 0xf1, 0xf9, 0xbe, 0x0a, 0xcd, 0x8e, 0xbc, 0x0d, 0x50, 0x89, 0x66, 0x89, 0x65,
-	0xca, 0xa7, 0x29, 0xf2, 0xc8, 0x89, 0xfc, 0x61, 0xa9, 0x30, 0x7f, 0x58, 0xce, 0x86, 0xee, 0x99,
-	0x1c, 0x74, 0x65, 0xac, 0x1c, 0xf4, 0xe8, 0xed, 0xa5, 0x9a, 0xbd, 0xbd, 0xfc, 0x4b, 0xea, 0xf6,
-	0x52, 0xcb, 0x86, 0xc1, 0x4a, 0x3f, 0x91, 0xbc, 0xc0, 0x6c, 0x8f, 0x5c, 0x60, 0xea, 0x47, 0xb6,
-	0xd7, 0xa9, 0x7b, 0xcc, 0xed, 0x64, 0xd5, 0xb1, 0xa1, 0x8a, 0xf7, 0x05, 0x31, 0x59, 0x69, 0x3c,
-	0x76, 0x55, 0x2b, 0x9d, 0xd3, 0x82, 0x4c, 0x4e, 0x2b, 0x59, 0x66, 0x68, 0x8d, 0x5b, 0x66, 0x48,
-	0x94, 0x4c, 0x66, 0x0f, 0x2f, 0x99, 0x14, 0x48, 0xfb, 0xdc, 0x04, 0xd2, 0xfe, 0x9b, 0x0a, 0x34,
-	0xa3, 0xb3, 0x53, 0x9a, 0xda, 0x79, 0x28, 0x99, 0x32, 0xdf, 0x51, 0x32, 0x9d, 0x23, 0x88, 0x63,
-	0x32, 0x3b, 0x58, 0x29, 0xcc, 0x0e, 0x56, 0x8f, 0x96, 0x1d, 0xac, 0x1d, 0x92, 0x1d, 0xac, 0xe7,
-	0x64, 0x07, 0x1b, 0x87, 0x65, 0x07, 0x9b, 0xc5, 0xd9, 0x41, 0x98, 0xf0, 0x62, 0xd6, 0x1a, 0xe3,
-	0x62, 0x36, 0x7b, 0x9c, 0xec, 0xe0, 0xdc, 0xb1, 0xb3, 0x83, 0xf3, 0x13, 0xc8, 0xcc, 0xff, 0x68,
-	0xb0, 0xa2, 0x0c, 0x2e, 0xef, 0x40, 0x33, 0xd2, 0x78, 0x95, 0xf3, 0x89, 0x3a, 0xd1, 0xcb, 0x78,
-	0x84, 0x3c, 0xf6, 0x1d, 0x3e, 0x84, 0x35, 0xf5, 0x53, 0x01, 0xf4, 0x45, 0xb1, 0x05, 0xbf, 0xa8,
-	0x78, 0x7c, 0xa2, 0x30, 0x63, 0x6a, 0x93, 0xfe, 0xb7, 0x0a, 0x34, 0xa2, 0xc8, 0x77, 0x09, 0x2a,
-	0x89, 0xe4, 0x50, 0x59, 0x27, 0xfd, 0x63, 0x19, 0xf4, 0x4b, 0x50, 0xde, 0x23, 0xa1, 0xd2, 0x13,
-	0x46, 0x69, 0x27, 0x8a, 0xa0, 0x40, 0x6f, 0x10, 0x8a, 0xb4, 0x60, 0x1e, 0xd0, 0x1b, 0x84, 0xe8,
-	0x0d, 0xa8, 0x78, 0x6e, 0x10, 0x8a, 0xdc, 0x4e, 0x0e, 0x92, 0x41, 0xd0, 0x75, 0xa8, 0xf5, 0x88,
-	0x45, 0x42, 0x22, 0x6c, 0x74, 0x0e, 0x58, 0x80, 0xd0, 0x4d, 0xa8, 0xbb, 0x6c, 0xd6, 0x4a, 0x7b,
-	0x1c, 0xe3, 0x25, 0x8a, 0x4e, 0x65, 0x9f, 0xe0, 0x9e, 0x30, 0xc4, 0x79, 0x53, 0xa1, 0x10, 0x7a,
-	0xef, 0xf1, 0x70, 0x68, 0xec, 0x0b, 0x4d, 0xcc, 0xc1, 0x72, 0x0c, 0x05, 0x87, 0x3e, 0x36, 0xa4,
-	0xfa, 0xe5, 0x81, 0x19, 0x66, 0x4c, 0xa3, 0x9b, 0x76, 0x7f, 0x73, 0x13, 0xb8, 0xbf, 0x29, 0xab,
-	0xe0, 0xb7, 0x1a, 0x54, 0x59, 0x81, 0xfc, 0x1f, 0xfc, 0x9c, 0x8a, 0x00, 0xc4, 0x2a, 0x31, 0x7e,
-	0x6e, 0x3e, 0x6b, 0x4d, 0xd5, 0xba, 0x76, 0x16, 0x9a, 0xb1, 0x95, 0xc9, 0xea, 0xda, 0xc6, 0x10,
-	0x4e, 0xe6, 0x3e, 0xd3, 0x41, 0xff, 0x5e, 0x3c, 0xab, 0x4b, 0x99, 0x59, 0xe5, 0xc4, 0x21, 0xea,
-	0xa9, 0xfd, 0x4e, 0x83, 0x96, 0x9e, 0x9f, 0x68, 0x55, 0x14, 0xcf, 0x12, 0xf6, 0xbb, 0x74, 0x34,
-	0xfb, 0x9d, 0x74, 0xa8, 0xe5, 0x11, 0x87, 0x3a, 0xe5, 0x97, 0x2d, 0xdf, 0x6b, 0xb0, 0x96, 0x73,
-	0xfd, 0xfe, 0x60, 0x24, 0x82, 0xe3, 0x06, 0xfe, 0x44, 0x4e, 0x04, 0xb7, 0x33, 0x93, 0x0e, 0xdc,
-	0x8e, 0x6b, 0xe4, 0x7f, 0x59, 0x82, 0x86, 0x0c, 0xee, 0x8e, 0xb0, 0xc9, 0xc7, 0x78, 0xd8, 0x90,
-	0x38, 0x9f, 0xf2, 0xd1, 0xce, 0x27, 0x7a, 0x6a, 0x55, 0x19, 0xe3, 0xa9, 0xd5, 0x94, 0xaf, 0x2d,
-	0xdf, 0x69, 0xb0, 0xac, 0x4a, 0x65, 0xdc, 0xa2, 0xb2, 0xc3, 0x9b, 0x55, 0x0f, 0x1b, 0x65, 0x97,
-	0x9d, 0x19, 0x3d, 0xc2, 0x1d, 0xfb, 0xac, 0xfe, 0xa2, 0x51, 0x7d, 0x95, 0xd1, 0xf7, 0x7b, 0x50,
-	0xef, 0xf1, 0xc7, 0x52, 0xaa, 0x37, 0x86, 0xaa, 0xd4, 0x89, 0xc4, 0xa3, 0x67, 0xb0, 0x2a, 0x27,
-	0x95, 0x2e, 0x2b, 0x95, 0x72, 0x1e, 0x42, 0xaa, 0xb8, 0x2d, 0xfb, 0x8a, 0x6d, 0x99, 0x72, 0xb9,
-	0xc8, 0x87, 0x55, 0xe5, 0x7b, 0xc7, 0xf1, 0x9f, 0x72, 0xaa, 0x56, 0x90, 0x53, 0xb0, 0x6c, 0x41,
-	0x8d, 0x1b, 0x50, 0x6a, 0x20, 0x9c, 0x81, 0x65, 0xe1, 0x5d, 0x51, 0xb5, 0x6c, 0xe8, 0xd1, 0x37,
-	0xfa, 0x08, 0xe6, 0x7a, 0xc9, 0x37, 0x64, 0xe2, 0x40, 0x53, 0x9e, 0x22, 0xf5, 0xc8, 0x4c, 0x4f,
-	0xe3, 0xd1, 0x69, 0x2a, 0x0d, 0xb8, 0xd7, 0x75, 0x1d, 0x6b, 0x18, 0x9b, 0x1f, 0xdc, 0xeb, 0x38,
-	0xd6, 0x10, 0x9d, 0x01, 0x78, 0xe5, 0x9b, 0x21, 0xe1, 0x54, 0x1e, 0xed, 0x37, 0x59, 0x0b, 0x23,
-	0x9f, 0x87, 0xf2, 0x81, 0x6d, 0x89, 0xf8, 0x24, 0x15, 0x10, 0x3f, 0xb3, 0x2d, 0x9d, 0xd2, 0xb2,
-	0x97, 0xd7, 0xda, 0x58, 0x97, 0xd7, 0x44, 0xd8, 0x5d, 0x3f, 0x24, 0xec, 0x4e, 0xdf, 0x3d, 0x1a,
-	0x99, 0xbb, 0x47, 0x54, 0xf8, 0x6d, 0x26, 0x0b, 0xbf, 0xe7, 0xa0, 0x65, 0x0f, 0xac, 0xd0, 0xf4,
-	0x2c, 0xd2, 0x75, 0xfb, 0x2c, 0x12, 0xd1, 0x74, 0x90, 0x4d, 0x1d, 0x16, 0xe8, 0xd9, 0xf8, 0xc0,
-	0xb4, 0x07, 0x36, 0x8b, 0x3c, 0x34, 0x5d, 0x7e, 0xa2, 0xab, 0xb0, 0x44, 0x0e, 0x0c, 0x6b, 0x10,
-	0x98, 0x2f, 0x49, 0x57, 0x62, 0x66, 0xd9, 0xb8, 0x8b, 0x11, 0xe1, 0x91, 0x00, 0x53, 0x36, 0xa6,
-	0xc3, 0x20, 0x73, 0x82, 0x0d, 0xff, 0x1c, 0x61, 0x23, 0x30, 0xf3, 0xa3, 0x6c, 0x04, 0xf8, 0x0c,
-	0x80, 0x8d, 0x0f, 0xba, 0x16, 0x71, 0xf6, 0xc2, 0xfd, 0xf6, 0xc2, 0xba, 0x76, 0xb9, 0xac, 0x37,
-	0x6d, 0x7c, 0xf0, 0x90, 0x35, 0x30, 0xb2, 0xe9, 0x48, 0xf2, 0xa2, 0x20, 0x9b, 0x8e, 0x20, 0xb7,
-	0xa1, 0xee, 0xe1, 0x90, 0x6e, 0x6f, 0x7b, 0x89, 0x07, 0xad, 0xe2, 0x93, 0x4a, 0x01, 0xe5, 0x6b,
-	0x86, 0xc4, 0x0e, 0xda, 0x88, 0xf5, 0x6b, 0xd8, 0xf8, 0x80, 0x15, 0x80, 0x19, 0xd1, 0x74, 0x04,
-	0x71, 0x59, 0x10, 0x4d, 0x87, 0x13, 0xcf, 0xc3, 0xec, 0xc0, 0x31, 0x5f, 0x0c, 0x88, 0xa0, 0xaf,
-	0xb0, 0x99, 0xb7, 0x78, 0x1b, 0x87, 0x5c, 0x84, 0x79, 0xca, 0x3c, 0xa1, 0x1e, 0xab, 0x8c, 0xc9,
-	0x9c, 0x8d, 0x0f, 0x12, 0xf1, 0x05, 0x85, 0x99, 0x4e, 0x12, 0xb6, 0x26, 0x60, 0xa6, 0x93, 0x80,
-	0x25, 0xdd, 0xe5, 0x09, 0x96, 0x62, 0x89, 0xdd, 0xe5, 0x26, 0x54, 0x88, 0x33, 0xb0, 0xdb, 0xed,
-	0xec, 0x9b, 0x3e, 0x2a, 0x2b, 0x8c, 0xc8, 0xf2, 0x33, 0x43, 0x8f, 0xb4, 0x4f, 0xf2, 0x6b, 0x30,
-	0xfd, 0x1f, 0xbd, 0x0d, 0x35, 0x6c, 0x59, 0x54, 0x02, 0x4e, 0x1d, 0xa5, 0xa4, 0x5d, 0xc5, 0x96,
-	0xd5, 0xe9, 0xd3, 0x5e, 0xae, 0xc3, 0xe4, 0xe6, 0xf4, 0x91, 0x7a, 0xb9, 0x0e, 0xe1, 0xbd, 0xb0,
-	0x33, 0xa4, 0xbd, 0x5e, 0x3b, 0xda, 0x58, 0xce, 0xb0, 0xd3, 0x47, 0x17, 0xa0, 0xec, 0xb8, 0x61,
-	0xfb, 0x8c, 0xa2, 0x7a, 0xcb, 0xba, 0xe8, 0x94, 0x4c, 0xa3, 0x64, 0x7e, 0x0c, 0x67, 0xb3, 0x76,
-	0x3d, 0xaa, 0xe6, 0xeb, 0x1c, 0x83, 0xde, 0x01, 0x48, 0x6c, 0xf6, 0xb9, 0xac, 0x37, 0x8c, 0x77,
-	0x5d, 0x4f, 0x20, 0xf3, 0xad, 0xde, 0x3a, 0x63, 0x91, 0xb2, 0x7a, 0x79, 0xbf, 0xec, 0x50, 0x5b,
-	0x3d, 0xf4, 0x56, 0xec, 0x4d, 0xce, 0x67, 0x03, 0x90, 0xc4, 0xab, 0xdc, 0xd8, 0x8b, 0x8c, 0x44,
-	0x0b, 0x1b, 0xd9, 0x68, 0x61, 0x0d, 0x6a, 0x7d, 0xd7, 0xb7, 0x71, 0xd8, 0xde, 0x64, 0x44, 0xf1,
-	0x55, 0xe4, 0x25, 0x2e, 0x4c, 0xf8, 0xac, 0x26, 0x5b, 0x07, 0xb8, 0x36, 0x52, 0x08, 0x56, 0x1c,
-	0x1d, 0x7b, 0x1b, 0xcc, 0x4d, 0xfd, 0x14, 0x9e, 0xd5, 0x28, 0x9e, 0xf5, 0xff, 0x7c, 0xa1, 0xfb,
-	0x2a, 0x2c, 0x2b, 0xd2, 0x5f, 0x1b, 0x7f, 0x28, 0xc1, 0x7c, 0xba, 0xca, 0x10, 0x69, 0x9d, 0x96,
-	0xd0, 0xba, 0xc3, 0x5f, 0xdd, 0xc8, 0x94, 0x55, 0x39, 0x93, 0xb2, 0xaa, 0x44, 0x29, 0xab, 0x35,
-	0xb1, 0xc3, 0xf2, 0x1d, 0x99, 0xf8, 0x42, 0x9b, 0x30, 0xb7, 0x4b, 0xb0, 0x4f, 0xfc, 0xae, 0x38,
-	0x7d, 0xfe, 0x5e, 0x66, 0x96, 0x37, 0x7e, 0xcc, 0x65, 0xe0, 0x1a, 0x54, 0xfb, 0x96, 0xfb, 0x4a,
-	0x3e, 0xc8, 0x5f, 0x53, 0xe6, 0xa5, 0x03, 0x9d, 0x83, 0xd0, 0x75, 0x58, 0xa6, 0xf4, 0xae, 0xd9,
-	0xeb, 0x1a, 0xae, 0xe3, 0x10, 0x23, 0x64, 0x25, 0x83, 0x06, 0x2f, 0x3b, 0x50, 0xd2, 0x83, 0xde,
-	0x3d, 0x4e, 0xf8, 0xb4, 0xf8, 0xd5, 0xca, 0x24, 0xcf, 0x7f, 0x7f, 0xd0, 0xe0, 0x64, 0x7e, 0x15,
-	0x68, 0x1b, 0x16, 0x46, 0x7e, 0x65, 0x20, 0x24, 0xee, 0x54, 0x7e, 0xed, 0x67, 0x67, 0x46, 0x9f,
-	0x4f, 0xff, 0xb2, 0xe0, 0xd8, 0x12, 0xf8, 0x0d, 0x9c, 0x2e, 0xf8, 0x21, 0x03, 0xea, 0x16, 0x4b,
-	0xe2, 0x95, 0xac, 0x24, 0xe6, 0x16, 0xab, 0xd4, 0x12, 0xf9, 0x5b, 0x0d, 0x6a, 0xfc, 0x82, 0x2f,
-	0x9f, 0x11, 0x69, 0xf1, 0x33, 0xa2, 0xc3, 0x05, 0xee, 0x3d, 0x68, 0xbe, 0x14, 0x05, 0x2b, 0x59,
-	0xf1, 0x38, 0x9d, 0x5f, 0xd3, 0x0a, 0xf4, 0x18, 0x3d, 0xed, 0xbb, 0xda, 0x8f, 0x1a, 0xd5, 0xa1,
-	0xd1, 0x02, 0x1c, 0x73, 0x6f, 0xa2, 0xb2, 0xc0, 0xbc, 0x59, 0x3b, 0xb6, 0x98, 0x22, 0x11, 0x95,
-	0x63, 0x18, 0x15, 0x89, 0xa8, 0x29, 0xcf, 0x78, 0x0f, 0x16, 0x46, 0xb6, 0x07, 0x3d, 0x2d, 0x3e,
-	0xee, 0x73, 0x8a, 0xe3, 0x4e, 0xd5, 0x0c, 0xd5, 0x67, 0xfc, 0x02, 0xd6, 0x9e, 0x28, 0xa7, 0xf0,
-	0xf3, 0xfd, 0x56, 0x63, 0x13, 0x5a, 0xbc, 0xd2, 0xb7, 0xe5, 0xfb, 0x78, 0x18, 0x57, 0x28, 0xf9,
-	0x51, 0x88, 0x0a, 0xe5, 0xe7, 0x50, 0x17, 0xe5, 0x40, 0xf4, 0xb0, 0x78, 0xe1, 0x27, 0xb2, 0x0b,
-	0x67, 0x1d, 0x73, 0x16, 0xfc, 0x93, 0x06, 0xe5, 0xa7, 0x58, 0x5d, 0x2c, 0x3d, 0x5c, 0xa6, 0x33,
-	0x31, 0x78, 0x79, 0x5a, 0x3f, 0x62, 0x98, 0x44, 0x4a, 0xfe, 0xa8, 0x41, 0xf9, 0x99, 0x6d, 0x29,
-	0xd7, 0xf2, 0x1a, 0x34, 0xe9, 0xdf, 0xc0, 0xc3, 0x86, 0x2c, 0xfe, 0xc6, 0x0d, 0xd4, 0xd0, 0x7b,
-	0x3e, 0xe9, 0x9b, 0x07, 0x42, 0x96, 0xc5, 0x17, 0xed, 0x85, 0xc3, 0xd0, 0x37, 0x77, 0x07, 0xa1,
-	0x7c, 0x6b, 0x1c, 0x37, 0x50, 0x05, 0x79, 0xe5, 0x63, 0xcf, 0x8b, 0x0a, 0x12, 0xf2, 0x73, 0xca,
-	0x3f, 0x46, 0xb8, 0xfb, 0x3a, 0xcc, 0xbb, 0xfe, 0x9e, 0xec, 0xd2, 0x7d, 0x79, 0xfb, 0xee, 0xac,
-	0xf8, 0x49, 0xec, 0x63, 0xdf, 0x0d, 0xdd, 0xc7, 0xda, 0x0f, 0xa5, 0x72, 0x67, 0xeb, 0xc9, 0x6e,
-	0x8d, 0xfd, 0x7e, 0xf4, 0xf6, 0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x96, 0x1a, 0x1f, 0x3b,
-	0x3b, 0x00, 0x00,
+	// 3548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xcb, 0x6f, 0x1c, 0xc7,
+	0x99, 0x67, 0xcf, 0x7b, 0xbe, 0xe1, 0xb3, 0x48, 0x51, 0x2d, 0xc9, 0x92, 0x28, 0x4a, 0xb2, 0x64,
+	0x59, 0x0f, 0x5b, 0xb2, 0xbc, 0x36, 0x6c, 0xaf, 0x97, 0x92, 0x68, 0x50, 0x80, 0x64, 0xca, 0x2d,
+	0xc9, 0xf6, 0xda, 0x6b, 0x0c, 0x9a, 0x3d, 0x35, 0x64, 0x5b, 0xfd, 0x52, 0x77, 0x8f, 0xc4, 0xd9,
+	0x83, 0x81, 0x3d, 0x18, 0xd8, 0x5d, 0x20, 0x41, 0x02, 0x04, 0xbe, 0xe5, 0xe2, 0x73, 0x02, 0x23,
+	0xff, 0x44, 0x00, 0x03, 0xc9, 0x29, 0x40, 0xfe, 0x80, 0x1c, 0x73, 0x09, 0x90, 0x63, 0x4e, 0x41,
+	0xbd, 0xba, 0xab, 0xa7, 0xab, 0x9b, 0x9c, 0xe1, 0x18, 0x39, 0xcd, 0x54, 0xd5, 0xef, 0xfb, 0xea,
+	0xf5, 0xbd, 0xea, 0xab, 0x6a, 0x38, 0xb1, 0x1d, 0x60, 0x6f, 0xe3, 0xd1, 0xfd, 0x17, 0xb7, 0x6e,
+	0x24, 0xff, 0xae, 0x07, 0xa1, 0x1f, 0xfb, 0x08, 0xfc, 0x00, 0x7b, 0x66, 0x60, 0x5f, 0x7f, 0x71,
+	0xeb, 0xe4, 0x89, 0x5d, 0xdf, 0xdf, 0x75, 0xf0, 0x0d, 0xda, 0xb2, 0x33, 0xe8, 0xdf, 0x30, 0xbd,
+	0x21, 0x83, 0xad, 0xff, 0x42, 0x03, 0x7d, 0xa3, 0xd7, 0xb3, 0x63, 0xdb, 0xf7, 0x4c, 0xe7, 0x51,
+	0xe8, 0x07, 0x38, 0x8c, 0x6d, 0x1c, 0xdd, 0x8f, 0xb1, 0x8b, 0xb6, 0x61, 0x39, 0xb2, 0xf6, 0xb0,
+	0x6b, 0x76, 0xfd, 0xb0, 0x1b, 0xe2, 0x3e, 0x0e, 0xb1, 0x67, 0x61, 0x5d, 0x5b, 0xd3, 0x2e, 0x77,
+	0x6e, 0x9e, 0xbe, 0x9e, 0xf6, 0x70, 0xfd, 0x31, 0x85, 0x6d, 0x87, 0x86, 0x00, 0x6d, 0xcd, 0x18,
+	0x4b, 0xd1, 0x68, 0x25, 0x3a, 0x09, 0xcd, 0x1d, 0xdf, 0x77, 0xb0, 0xe9, 0xe9, 0x95, 0x35, 0xed,
+	0x72, 0x6b, 0x6b, 0xc6, 0x10, 0x15, 0x77, 0x9a, 0x50, 0xf7, 0x3d, 0xec, 0xf7, 0xd7, 0x37, 0xa1,
+	0xba, 0xe1, 0x0d, 0xd1, 0x15, 0xa8, 0xbf, 0x30, 0x9d, 0x81, 0xe8, 0x6e, 0xe5, 0x3a, 0x9b, 0xc4,
+	0x75, 0x31, 0x89, 0xeb, 0x1b, 0xde, 0xd0, 0x60, 0x10, 0x84, 0xa0, 0x36, 0x34, 0x5d, 0x87, 0x32,
+	0x6d, 0x1b, 0xf4, 0xff, 0xfa, 0x10, 0x16, 0x36, 0xbc, 0xe1, 0x76, 0xb8, 0xb9, 0x1f, 0x84, 0x38,
+	0x8a, 0x6c, 0xdf, 0x43, 0xe7, 0xa1, 0x6a, 0x7a, 0x43, 0xce, 0x70, 0x41, 0x1e, 0xff, 0x86, 0x37,
+	0xdc, 0x9a, 0x31, 0x48, 0x2b, 0x7a, 0x07, 0x00, 0x27, 0x24, 0x94, 0x63, 0xe7, 0xe6, 0xaa, 0x8c,
+	0x4d, 0x19, 0x6e, 0xcd, 0x18, 0x12, 0x36, 0x9d, 0xc1, 0xd7, 0xb0, 0xb4, 0xe1, 0x0d, 0x23, 0xb9,
+	0xef, 0x08, 0x3d, 0x85, 0x63, 0x66, 0xb2, 0xd0, 0xdd, 0x20, 0x59, 0x69, 0x5d, 0x5b, 0xab, 0x5e,
+	0xee, 0xdc, 0x5c, 0x93, 0xbb, 0xf8, 0xd8, 0x74, 0x71, 0x6f, 0x64, 0xf4, 0xc6, 0x8a, 0xa9, 0xd8,
+	0xa7, 0xf5, 0xff, 0xd5, 0xa0, 0x75, 0xd7, 0x74, 0x9c, 0x1d, 0xd3, 0x7a, 0x86, 0xae, 0x41, 0x2d,
+	0x30, 0xe3, 0x3d, 0xce, 0xf2, 0x44, 0x8e, 0xe5, 0x23, 0x33, 0xde, 0x23, 0x3b, 0x6b, 0x50, 0x18,
+	0x7a, 0x08, 0xc7, 0xa3, 0x00, 0x5b, 0x76, 0xdf, 0xb6, 0x4c, 0xc2, 0xb8, 0x8b, 0xf7, 0x63, 0xec,
+	0xf1, 0x79, 0x57, 0xe9, 0xa2, 0x2b, 0x06, 0x65, 0xac, 0x66, 0x88, 0x36, 0x05, 0xcd, 0xfa, 0xff,
+	0x69, 0xb0, 0x2c, 0x86, 0x22, 0xef, 0xfa, 0x4d, 0x68, 0x59, 0xbc, 0x3a, 0xd9, 0x4c, 0x89, 0xaf,
+	0x20, 0xd9, 0x9a, 0x31, 0x12, 0x1c, 0xba, 0x0d, 0xed, 0x54, 0xe0, 0xd8, 0x26, 0x1c, 0x93, 0x89,
+	0x64, 0x41, 0x4b, 0x91, 0xe9, 0x16, 0x84, 0x70, 0x4c, 0xf0, 0x8d, 0xa4, 0xb1, 0x44, 0xe8, 0x3f,
+	0xcb, 0xb7, 0xe1, 0x42, 0x6e, 0xc6, 0x8a, 0x19, 0x15, 0x6c, 0xc5, 0x6f, 0xea, 0x00, 0x77, 0x7d,
+	0x37, 0xf0, 0x3d, 0xec, 0xc5, 0x11, 0x7a, 0x17, 0x9a, 0x4c, 0x03, 0x22, 0x3e, 0xeb, 0xb3, 0x79,
+	0x8d, 0xc9, 0x8c, 0xcd, 0x10, 0x78, 0xf4, 0x21, 0x99, 0x7d, 0x14, 0xf8, 0x5e, 0x84, 0x23, 0x3e,
+	0xfb, 0x73, 0xd9, 0xd9, 0xf3, 0xc6, 0x0c, 0x79, 0x4a, 0x83, 0xee, 0x00, 0x04, 0x66, 0x68, 0xba,
+	0x38, 0xc6, 0x61, 0xa4, 0x57, 0x29, 0x87, 0x75, 0x99, 0xc3, 0xa3, 0xa4, 0x35, 0xc3, 0x42, 0xa2,
+	0x42, 0xef, 0x43, 0x0b, 0xef, 0x9b, 0x6e, 0xe0, 0xe0, 0x48, 0xaf, 0xad, 0x69, 0xa3, 0x32, 0xba,
+	0xc9, 0xdb, 0x32, 0xf4, 0x09, 0x05, 0x7a, 0x00, 0xf3, 0x21, 0x7e, 0x3e, 0xc0, 0x51, 0xdc, 0xdd,
+	0xf1, 0x7b, 0x64, 0x81, 0xeb, 0x94, 0xc7, 0xc5, 0xec, 0x3c, 0x28, 0xe2, 0x0e, 0x05, 0x64, 0x18,
+	0xcd, 0x85, 0x72, 0x13, 0x59, 0xcb, 0x3d, 0x6c, 0xf6, 0xc8, 0x64, 0x1a, 0xf9, 0xb5, 0xdc, 0x62,
+	0x4d, 0xd9, 0xb5, 0xe4, 0x78, 0x64, 0xc0, 0x62, 0x84, 0xad, 0x41, 0x68, 0xc7, 0xc3, 0x2e, 0x5d,
+	0x5f, 0x1c, 0xe9, 0x4d, 0xca, 0xe3, 0x52, 0x66, 0x3f, 0x38, 0xe6, 0x31, 0x83, 0x64, 0x78, 0x2d,
+	0x44, 0xd9, 0x46, 0x74, 0x0b, 0xea, 0x8e, 0xed, 0x3d, 0x8b, 0xf4, 0x56, 0xde, 0x14, 0x3e, 0x20,
+	0x0d, 0x19, 0x72, 0x86, 0x25, 0x9b, 0x2a, 0xc4, 0x3b, 0xd2, 0xdb, 0xf9, 0x4d, 0x55, 0xca, 0xab,
+	0x91, 0xd2, 0x94, 0xa9, 0x2b, 0x4c, 0xa0, 0xae, 0xbf, 0xd2, 0xa0, 0x79, 0xd7, 0xf7, 0x62, 0xd3,
+	0x8a, 0x89, 0x01, 0xf5, 0x4c, 0x97, 0xd9, 0xda, 0xb6, 0x41, 0xff, 0xa3, 0x45, 0xa8, 0x0e, 0x42,
+	0x61, 0x53, 0xc9, 0x5f, 0xb4, 0x02, 0x75, 0xec, 0x9a, 0xb6, 0x43, 0x05, 0xaa, 0x6d, 0xb0, 0x42,
+	0xd9, 0xb0, 0x6a, 0x13, 0x0c, 0xab, 0x0f, 0x9d, 0x7b, 0xb8, 0x6f, 0x0e, 0x9c, 0xf8, 0xc9, 0x30,
+	0xc0, 0x48, 0x87, 0x86, 0x37, 0x70, 0x77, 0x70, 0x48, 0xc7, 0xa6, 0x6d, 0xcd, 0x18, 0xbc, 0x5c,
+	0xe6, 0x4c, 0x08, 0x55, 0x14, 0x87, 0xb6, 0xb7, 0xcb, 0x86, 0x4a, 0xa8, 0x58, 0x39, 0xb5, 0x10,
+	0x16, 0xcc, 0xdd, 0xb3, 0x23, 0x2b, 0xb4, 0x5d, 0xdb, 0x33, 0x63, 0x3f, 0x44, 0xe7, 0x61, 0x8e,
+	0x9b, 0x83, 0x61, 0x57, 0x5a, 0x8c, 0x59, 0x51, 0x49, 0x46, 0x8e, 0xae, 0x41, 0xd3, 0x35, 0x83,
+	0x80, 0x70, 0x66, 0x7a, 0xb9, 0x9c, 0x11, 0x22, 0xda, 0x47, 0x64, 0x08, 0xcc, 0xfa, 0x8f, 0x55,
+	0x68, 0xdd, 0xf3, 0xad, 0x81, 0x8b, 0xbd, 0x18, 0xe9, 0xd0, 0xe4, 0x58, 0xce, 0x5a, 0x14, 0xd1,
+	0x05, 0xa8, 0xd9, 0x5e, 0xdf, 0xe7, 0x2c, 0x17, 0x65, 0x96, 0xf7, 0xbd, 0xbe, 0x6f, 0xd0, 0x56,
+	0x74, 0x15, 0x9a, 0x11, 0x0e, 0x5f, 0x30, 0x8d, 0x26, 0x0b, 0x8b, 0x32, 0x7d, 0xd3, 0x26, 0x43,
+	0x40, 0xd0, 0x25, 0xa8, 0x13, 0x23, 0x2f, 0x74, 0x77, 0x29, 0xab, 0xfd, 0xf1, 0x5e, 0x64, 0xb0,
+	0x76, 0xf4, 0x36, 0x80, 0x95, 0x58, 0x2d, 0xbd, 0x9e, 0x77, 0x78, 0xa9, 0x4d, 0x33, 0x24, 0x24,
+	0x7a, 0x0f, 0x5a, 0x42, 0x2f, 0xf4, 0xc6, 0x5a, 0x75, 0x54, 0x29, 0x85, 0x42, 0x11, 0x1d, 0xb7,
+	0x43, 0x4c, 0x56, 0xc0, 0x48, 0x08, 0xd0, 0x79, 0xa8, 0xc5, 0xe6, 0x2e, 0xd1, 0xc4, 0xea, 0xa8,
+	0x2f, 0x7e, 0x62, 0xee, 0x1a, 0xb4, 0x11, 0x7d, 0x00, 0x73, 0x44, 0x96, 0x42, 0x62, 0xa9, 0x7b,
+	0xbe, 0x25, 0xd4, 0x4d, 0xcf, 0x9a, 0x21, 0x06, 0xb8, 0xe7, 0x5b, 0x91, 0x31, 0x8b, 0xa5, 0x52,
+	0x99, 0x60, 0xb6, 0x27, 0x10, 0xcc, 0x9f, 0x57, 0xa0, 0xb5, 0xe9, 0x59, 0x7e, 0xcf, 0xf6, 0x76,
+	0xd1, 0x39, 0x98, 0xb5, 0x7c, 0x2f, 0xc6, 0x5e, 0xdc, 0x8d, 0x87, 0x81, 0x90, 0x95, 0x0e, 0xaf,
+	0xa3, 0x92, 0x2b, 0xd9, 0xac, 0xca, 0x98, 0x36, 0x6b, 0x05, 0xea, 0x51, 0x3c, 0x74, 0xb0, 0x50,
+	0x34, 0x5a, 0x20, 0xf2, 0x83, 0xf7, 0x03, 0xc7, 0xef, 0x61, 0xba, 0xa7, 0x2d, 0x43, 0x14, 0xd1,
+	0x45, 0x98, 0x37, 0x1d, 0xc7, 0x7f, 0xd9, 0x0d, 0x31, 0xdd, 0xfe, 0x1e, 0xdd, 0xc6, 0x96, 0x31,
+	0x47, 0x6b, 0x0d, 0x5e, 0x59, 0xb6, 0x20, 0x8d, 0x09, 0x16, 0xe4, 0x4b, 0x68, 0x8b, 0xf5, 0x88,
+	0xd0, 0xc7, 0xe5, 0x7e, 0x35, 0x1f, 0x8b, 0x08, 0xd2, 0x02, 0x67, 0xfa, 0x17, 0x0d, 0x9a, 0xdc,
+	0xc5, 0x90, 0x89, 0x47, 0x03, 0xd7, 0x35, 0xc3, 0xa1, 0x50, 0x1c, 0x5e, 0x44, 0x6b, 0xd0, 0xe9,
+	0x61, 0xa2, 0xc4, 0x41, 0x2c, 0xa2, 0xb5, 0xb6, 0x21, 0x57, 0xa1, 0x8b, 0x22, 0x8c, 0xac, 0x2a,
+	0xa3, 0x3e, 0x11, 0x41, 0x5e, 0x84, 0xf9, 0x44, 0xd4, 0x18, 0xbe, 0x46, 0x79, 0x25, 0x02, 0xf8,
+	0x29, 0x85, 0x95, 0xac, 0x60, 0x7d, 0x82, 0x15, 0xfc, 0x56, 0x03, 0xc4, 0x27, 0x29, 0x07, 0x4c,
+	0x37, 0xc8, 0x46, 0xd3, 0x5a, 0x5d, 0xcb, 0x1b, 0x19, 0x4e, 0x40, 0xcc, 0x1d, 0x47, 0x1d, 0x39,
+	0x5a, 0x0a, 0x60, 0x45, 0xe5, 0xce, 0xd1, 0xe7, 0xe5, 0x9b, 0x7a, 0x3e, 0xbf, 0xa9, 0xb9, 0xc9,
+	0x14, 0x6c, 0xef, 0x67, 0x00, 0x52, 0x60, 0x7e, 0xbf, 0xbc, 0x1f, 0xf5, 0xa2, 0xaa, 0x19, 0xff,
+	0x52, 0x83, 0x59, 0xd9, 0x26, 0x8c, 0x8a, 0x88, 0x96, 0x17, 0x91, 0xbc, 0xa3, 0x2b, 0xd9, 0xe6,
+	0xea, 0x04, 0xdb, 0xfc, 0xff, 0x35, 0x68, 0x30, 0x7d, 0x3f, 0xc4, 0x68, 0x4e, 0x42, 0x2b, 0x64,
+	0x26, 0xb3, 0xc7, 0xfc, 0x9a, 0x91, 0x94, 0xd1, 0x19, 0x80, 0x1e, 0x0e, 0x42, 0x6c, 0x99, 0x31,
+	0xee, 0x51, 0x89, 0x6e, 0x19, 0x52, 0x0d, 0xba, 0x02, 0x4b, 0xcc, 0x0e, 0x60, 0x37, 0x88, 0x87,
+	0x92, 0x20, 0xb7, 0x8c, 0x05, 0xda, 0xb0, 0x49, 0xea, 0x99, 0x28, 0x27, 0x36, 0xa6, 0x5e, 0x60,
+	0x63, 0x1a, 0x07, 0xd9, 0x98, 0xa6, 0xca, 0xc6, 0xdc, 0x86, 0x06, 0x8b, 0x62, 0x55, 0xb1, 0x51,
+	0xee, 0x98, 0x68, 0x70, 0x30, 0x7a, 0x2d, 0x15, 0xf9, 0xb6, 0x5a, 0x51, 0x13, 0x61, 0x97, 0xe3,
+	0x52, 0x18, 0x3b, 0x2e, 0x7d, 0x03, 0x9a, 0xdc, 0x48, 0xeb, 0x9d, 0xbc, 0xab, 0x7b, 0x88, 0x7b,
+	0xb6, 0x49, 0xac, 0x77, 0x64, 0x08, 0x58, 0x99, 0x30, 0xcc, 0x4e, 0x20, 0x0c, 0xff, 0xa3, 0xc1,
+	0x12, 0x13, 0x06, 0x59, 0xe5, 0xaf, 0x42, 0x83, 0x19, 0x7f, 0xae, 0xf1, 0x28, 0xef, 0x2b, 0x48,
+	0x10, 0xc3, 0x30, 0x47, 0xd6, 0x77, 0x0f, 0x96, 0x15, 0xfe, 0x07, 0x7d, 0x56, 0xae, 0x86, 0xeb,
+	0xb9, 0x79, 0xe6, 0xe6, 0x51, 0xa0, 0x94, 0x3f, 0x54, 0xa0, 0x46, 0x02, 0x19, 0x22, 0x74, 0xb1,
+	0x1d, 0x3b, 0xc2, 0x5f, 0xb2, 0xc2, 0x21, 0xac, 0xf8, 0x65, 0x58, 0x8c, 0x71, 0xe8, 0x46, 0x5d,
+	0xbf, 0xdf, 0x25, 0x82, 0x66, 0x5b, 0xc2, 0x37, 0xce, 0xd3, 0xfa, 0xed, 0xfe, 0x63, 0x56, 0x4b,
+	0x02, 0x34, 0x8b, 0x05, 0xb5, 0x7a, 0x2d, 0x6f, 0x3b, 0x79, 0xbc, 0x6b, 0x08, 0x0c, 0x81, 0x3b,
+	0xb6, 0x85, 0xbd, 0x08, 0xeb, 0xf5, 0x3c, 0xfc, 0x01, 0x6b, 0x32, 0x04, 0x86, 0xa8, 0x07, 0x09,
+	0xae, 0x98, 0xc7, 0xa4, 0x9e, 0x88, 0x17, 0xcb, 0xa4, 0xa4, 0x39, 0x81, 0x94, 0x7c, 0x01, 0x6d,
+	0x72, 0x50, 0x67, 0x79, 0x98, 0x87, 0x45, 0x79, 0x98, 0xea, 0xc1, 0x0a, 0x96, 0xcf, 0xc2, 0xac,
+	0x7f, 0x03, 0x4d, 0x3e, 0xb1, 0x43, 0xc6, 0xfd, 0x53, 0x36, 0x87, 0x7f, 0xad, 0x40, 0x8d, 0x9c,
+	0x92, 0x48, 0xc4, 0x4d, 0x44, 0x84, 0xf1, 0x0c, 0x71, 0x5f, 0x44, 0xdc, 0x49, 0xa5, 0x81, 0xfb,
+	0x24, 0xd2, 0x4a, 0x41, 0x76, 0x4f, 0x48, 0x47, 0x52, 0x77, 0xbf, 0x87, 0x3e, 0x50, 0x9c, 0x76,
+	0x4f, 0x8f, 0xd8, 0x8f, 0x6c, 0x36, 0x26, 0x73, 0xd0, 0xfd, 0x77, 0x98, 0x95, 0x8e, 0xaa, 0x43,
+	0x2e, 0x37, 0xa7, 0x46, 0x18, 0xc8, 0xf4, 0x46, 0x27, 0x3d, 0x9e, 0xe6, 0x82, 0x90, 0x7a, 0x5e,
+	0x7c, 0xaf, 0x40, 0x83, 0x85, 0xe5, 0x7a, 0x23, 0xaf, 0xdd, 0x3c, 0x70, 0xe7, 0x88, 0x69, 0x0b,
+	0xd2, 0x10, 0x16, 0xc8, 0x5a, 0xcb, 0xb6, 0xe6, 0x55, 0xa8, 0x91, 0x13, 0xa9, 0xae, 0xe5, 0x4f,
+	0x1b, 0x04, 0xba, 0x35, 0x63, 0xd0, 0xf6, 0x23, 0x5b, 0x99, 0xaf, 0x61, 0x29, 0x77, 0x18, 0x1e,
+	0x3f, 0x0d, 0x36, 0x32, 0xfa, 0x02, 0x0b, 0xf3, 0xdb, 0x0a, 0xb4, 0x13, 0xe3, 0x2d, 0x39, 0x21,
+	0x6d, 0x42, 0x27, 0x54, 0x19, 0xc3, 0x09, 0x55, 0xc7, 0x76, 0x42, 0x6f, 0x42, 0x0b, 0xf3, 0xf0,
+	0x57, 0xaf, 0xe5, 0x17, 0x36, 0x89, 0xaa, 0x8d, 0x04, 0x36, 0xed, 0xc8, 0xf3, 0x2b, 0x80, 0xd4,
+	0xd7, 0xa1, 0xed, 0xf2, 0x4d, 0x39, 0x99, 0x63, 0x9d, 0xd0, 0x16, 0x6c, 0xc7, 0x26, 0xb4, 0xc4,
+	0x10, 0x94, 0x36, 0x26, 0x89, 0xca, 0x2b, 0x65, 0x51, 0xf9, 0xfa, 0x57, 0xb0, 0xa2, 0x4a, 0x85,
+	0x2a, 0x59, 0xbe, 0x99, 0x65, 0x59, 0xaa, 0xbe, 0x9c, 0x3d, 0x06, 0xbd, 0x28, 0xc5, 0xa7, 0xec,
+	0xe2, 0x76, 0xb6, 0x8b, 0xb3, 0xaa, 0xec, 0x8d, 0x2c, 0x57, 0xbc, 0x9b, 0x6d, 0x98, 0xcb, 0x9c,
+	0x78, 0x94, 0xbc, 0xaf, 0x64, 0x79, 0xaf, 0xa8, 0xe4, 0x41, 0x30, 0xb4, 0xe0, 0x78, 0x41, 0xb4,
+	0xad, 0x64, 0xfd, 0x56, 0x96, 0xf5, 0x19, 0x85, 0xa0, 0x2a, 0x46, 0x6d, 0xc2, 0xaa, 0xda, 0xc7,
+	0x2b, 0xfb, 0xb8, 0x95, 0xed, 0xe3, 0x74, 0x3e, 0x7c, 0x51, 0x74, 0x21, 0xb6, 0x77, 0xd4, 0x40,
+	0x8d, 0xbb, 0xbd, 0xa3, 0x26, 0x82, 0xb3, 0xff, 0x04, 0xe6, 0xb3, 0xc2, 0xaa, 0x64, 0xfc, 0x7a,
+	0x96, 0xf1, 0x31, 0x65, 0x38, 0x28, 0x58, 0xee, 0xc2, 0x09, 0x9e, 0x48, 0xe7, 0xde, 0xe3, 0xa0,
+	0x61, 0xbf, 0x9d, 0xe5, 0xbe, 0xa6, 0xcc, 0xc1, 0x96, 0xc8, 0x8c, 0xc8, 0xd8, 0x8f, 0x2d, 0x33,
+	0x82, 0x50, 0x30, 0x7c, 0x06, 0xa7, 0x28, 0xc3, 0x34, 0xe5, 0x3a, 0x3c, 0x68, 0xec, 0xef, 0x64,
+	0xd9, 0xaf, 0xab, 0x33, 0xb7, 0x43, 0xc5, 0xe8, 0x85, 0x62, 0x89, 0x3c, 0xf5, 0x51, 0x14, 0x4b,
+	0xc1, 0x63, 0x54, 0x44, 0x73, 0x16, 0x7d, 0x6c, 0x11, 0xcd, 0xfb, 0x04, 0xde, 0xc5, 0x73, 0x38,
+	0xc3, 0xba, 0xc8, 0x64, 0x80, 0x0f, 0xea, 0xea, 0xbd, 0x6c, 0x57, 0x17, 0x8b, 0x13, 0xcd, 0x8a,
+	0x2e, 0xbf, 0x84, 0x65, 0xde, 0x25, 0x89, 0x07, 0x3e, 0x35, 0x43, 0xdb, 0xdc, 0x71, 0xd4, 0xfd,
+	0xbc, 0x91, 0xed, 0xe7, 0x64, 0x3e, 0xac, 0x10, 0xe4, 0x82, 0xf9, 0xbf, 0x41, 0x87, 0x31, 0xa7,
+	0x99, 0x4a, 0x25, 0xd3, 0x15, 0x99, 0x69, 0x5b, 0x10, 0x3e, 0x85, 0x45, 0x89, 0x70, 0x23, 0x0c,
+	0x4d, 0xb5, 0x65, 0xbf, 0x96, 0x1d, 0xd2, 0xf1, 0x7c, 0x7a, 0x94, 0xd2, 0x0a, 0xb6, 0x7f, 0xd7,
+	0xa0, 0xbd, 0x6d, 0x0e, 0xe2, 0xbd, 0x8f, 0x1c, 0xff, 0x25, 0x7a, 0x1d, 0x96, 0xc8, 0x7f, 0x3f,
+	0xb4, 0xff, 0x9b, 0x39, 0x39, 0x12, 0x88, 0x32, 0xee, 0x8b, 0x99, 0x86, 0xa7, 0xa1, 0x83, 0x4e,
+	0x41, 0x3b, 0xf6, 0x9f, 0x61, 0x06, 0x62, 0x63, 0x6d, 0xd1, 0x0a, 0xd2, 0x78, 0x16, 0x3a, 0x21,
+	0xee, 0x87, 0x38, 0xda, 0xa3, 0xcd, 0xec, 0xac, 0x00, 0xbc, 0x8a, 0x00, 0x5e, 0x27, 0x21, 0x82,
+	0x1f, 0x24, 0x77, 0x1b, 0xca, 0x3c, 0x2e, 0x87, 0x4c, 0xdb, 0xf9, 0xfe, 0xb1, 0x02, 0x90, 0x4c,
+	0x9a, 0x46, 0x03, 0xb6, 0x1b, 0x38, 0xb6, 0x65, 0xc7, 0xba, 0x96, 0x37, 0x42, 0x09, 0xd2, 0x48,
+	0x60, 0x84, 0x24, 0x30, 0xa3, 0xe8, 0xa5, 0x1f, 0xf6, 0xf4, 0x4a, 0x29, 0x89, 0x80, 0xa1, 0x7b,
+	0x80, 0x2c, 0xc7, 0x26, 0x09, 0x4b, 0x2b, 0xc4, 0x3d, 0xec, 0xc5, 0xb6, 0xe9, 0x88, 0xd8, 0xa5,
+	0x80, 0x78, 0x89, 0x11, 0xdc, 0x4d, 0xf1, 0x84, 0x4b, 0x76, 0x87, 0x2c, 0x91, 0x8e, 0x2c, 0xe6,
+	0x92, 0x21, 0xb8, 0x4b, 0x72, 0x09, 0x53, 0x5e, 0xcf, 0xc7, 0xd0, 0xd8, 0xde, 0xf9, 0x1a, 0x5b,
+	0xf1, 0x34, 0x13, 0x49, 0xdf, 0xd5, 0xa1, 0xbd, 0x2d, 0x0e, 0x19, 0x44, 0xd4, 0x69, 0xbe, 0x9a,
+	0xf0, 0x69, 0xf3, 0xf4, 0xb4, 0x94, 0x96, 0xac, 0x94, 0xa6, 0x25, 0xab, 0xf9, 0x13, 0x41, 0x2e,
+	0xb5, 0x5d, 0x1b, 0x2b, 0xb5, 0x3d, 0x7a, 0x28, 0xaa, 0xe7, 0x0f, 0x45, 0xff, 0x91, 0x39, 0x14,
+	0x35, 0xd6, 0xaa, 0x87, 0x72, 0x3f, 0x12, 0x0d, 0xda, 0x1c, 0x39, 0x17, 0x35, 0x0f, 0xed, 0x06,
+	0x32, 0xc7, 0xa3, 0x5b, 0xf2, 0x65, 0x66, 0x4b, 0x75, 0x8c, 0xe0, 0x8d, 0xf2, 0x05, 0xe6, 0x91,
+	0x2f, 0xcb, 0xb2, 0xa9, 0x32, 0xc8, 0xa5, 0xca, 0xe4, 0xdb, 0x8b, 0xce, 0xb8, 0xb7, 0x17, 0xd2,
+	0x4d, 0xcc, 0xec, 0xc1, 0x37, 0x31, 0x25, 0xd2, 0x3e, 0x37, 0x81, 0xb4, 0xff, 0xae, 0x06, 0xed,
+	0x64, 0xef, 0x94, 0x36, 0x78, 0x1e, 0x2a, 0xb6, 0x48, 0xa3, 0x54, 0x6c, 0xef, 0x10, 0xe2, 0x28,
+	0x27, 0x1d, 0x6b, 0xa5, 0x49, 0xc7, 0xfa, 0xe1, 0x92, 0x8e, 0x8d, 0x03, 0x92, 0x8e, 0xcd, 0x82,
+	0xa4, 0x63, 0xeb, 0xa0, 0xa4, 0x63, 0xbb, 0x3c, 0xe9, 0x08, 0x13, 0x9e, 0xf7, 0x3a, 0x63, 0x9c,
+	0xf7, 0x66, 0x8f, 0x92, 0x74, 0x9c, 0x3b, 0x72, 0xd2, 0x71, 0x7e, 0x02, 0x99, 0xf9, 0x99, 0x06,
+	0x2b, 0xca, 0x98, 0xf5, 0x36, 0xb4, 0x13, 0x8d, 0x57, 0x39, 0x9f, 0x84, 0x88, 0x9c, 0xf1, 0x13,
+	0xe4, 0x91, 0x53, 0x03, 0x31, 0xac, 0xaa, 0x5f, 0x20, 0xa0, 0x2f, 0xca, 0x2d, 0xf8, 0x45, 0xc5,
+	0x9b, 0x16, 0x85, 0x19, 0x53, 0x9b, 0xf4, 0x7f, 0xd4, 0xa0, 0x95, 0x04, 0xd4, 0x4b, 0x50, 0x93,
+	0x72, 0x4e, 0x55, 0x92, 0x6a, 0x3a, 0x8a, 0x41, 0xbf, 0x04, 0xd5, 0x5d, 0x1c, 0x2b, 0x3d, 0x61,
+	0x92, 0xcd, 0x22, 0x08, 0x02, 0x0c, 0x06, 0xb1, 0x5e, 0x2f, 0x05, 0x06, 0x83, 0x18, 0xbd, 0x06,
+	0xb5, 0xc0, 0x8f, 0x62, 0xbd, 0x51, 0x86, 0xa4, 0x10, 0x74, 0x0d, 0x1a, 0x3d, 0xec, 0xe0, 0x18,
+	0xeb, 0xcd, 0x32, 0x30, 0x07, 0x91, 0xfb, 0x25, 0x9f, 0x8e, 0x5a, 0x69, 0x8f, 0x53, 0xbc, 0x40,
+	0x91, 0xa1, 0x90, 0xcc, 0xb3, 0xde, 0x2e, 0x43, 0x53, 0x08, 0x39, 0x4e, 0x05, 0x66, 0x6c, 0xed,
+	0xe9, 0x50, 0x86, 0x65, 0x18, 0x02, 0x8e, 0x43, 0xd3, 0x12, 0xea, 0x57, 0x04, 0xa6, 0x98, 0x31,
+	0x8d, 0x6e, 0xd6, 0xfd, 0xcd, 0x4d, 0xe0, 0xfe, 0xa6, 0xac, 0x82, 0xdf, 0x6a, 0x50, 0xa7, 0xf7,
+	0xee, 0xff, 0xe2, 0x57, 0x5a, 0x18, 0x20, 0x55, 0x89, 0xf1, 0x53, 0xfe, 0x79, 0x6b, 0xaa, 0xd6,
+	0xb5, 0x33, 0xd0, 0x4e, 0xad, 0x4c, 0x5e, 0xd7, 0xd6, 0x87, 0x70, 0xa2, 0xf0, 0xf5, 0x0f, 0xfa,
+	0xaf, 0xf2, 0x51, 0x5d, 0xca, 0x8d, 0xaa, 0x20, 0x0e, 0x51, 0x0f, 0xed, 0x0f, 0x1a, 0x74, 0x8c,
+	0xe2, 0xfc, 0xad, 0xe2, 0x4e, 0x4e, 0xb2, 0xdf, 0x95, 0xc3, 0xd9, 0x6f, 0xd9, 0xa1, 0x56, 0x47,
+	0x1c, 0xea, 0x94, 0x1f, 0xcc, 0x7c, 0xa7, 0xc1, 0x6a, 0xc1, 0xa9, 0xfe, 0xfd, 0x91, 0x08, 0x4e,
+	0xcb, 0x9f, 0xc9, 0x24, 0xca, 0xad, 0x99, 0x6c, 0xe0, 0x76, 0x54, 0x23, 0xff, 0xeb, 0x0a, 0xb4,
+	0x44, 0x70, 0x77, 0x88, 0x45, 0x3e, 0xc2, 0x7b, 0x09, 0x69, 0x7f, 0xaa, 0x87, 0xdb, 0x9f, 0xe4,
+	0x05, 0x57, 0x6d, 0x8c, 0x17, 0x5c, 0x53, 0x3e, 0xb6, 0x90, 0xf7, 0x92, 0xaa, 0x0c, 0xc9, 0x4d,
+	0x22, 0x3b, 0xac, 0x5a, 0xf5, 0x5e, 0x52, 0x90, 0x90, 0xf7, 0x92, 0x02, 0x77, 0xe4, 0xbd, 0xfa,
+	0x9b, 0x46, 0xf4, 0x55, 0x44, 0xdf, 0xef, 0x42, 0xb3, 0xc7, 0xde, 0x60, 0xa9, 0x9e, 0x2e, 0x2a,
+	0xc6, 0x6c, 0x08, 0x3c, 0x79, 0x32, 0x20, 0x06, 0x95, 0xbd, 0xad, 0xaa, 0x14, 0xbc, 0xaf, 0x54,
+	0x71, 0x5b, 0x0e, 0x15, 0xcb, 0x32, 0xe5, 0x5b, 0xa8, 0x10, 0x8e, 0x29, 0x9f, 0x51, 0x8e, 0xff,
+	0x42, 0x54, 0x35, 0x83, 0x82, 0x7b, 0xd0, 0x0e, 0x34, 0x98, 0x01, 0x25, 0x06, 0xc2, 0x1b, 0x38,
+	0x0e, 0xc9, 0xcd, 0xd0, 0x35, 0x6e, 0x19, 0x49, 0x19, 0x7d, 0x08, 0x73, 0x3d, 0xf9, 0x69, 0x1a,
+	0xdf, 0xd0, 0x8c, 0xa7, 0xc8, 0xbc, 0x5d, 0x33, 0xb2, 0x78, 0x92, 0x1a, 0x09, 0xb1, 0xd9, 0xeb,
+	0xfa, 0x9e, 0x33, 0x4c, 0xcd, 0x8f, 0xd9, 0xdb, 0xf6, 0x9c, 0x21, 0x3a, 0x0d, 0xf0, 0x32, 0xb4,
+	0x63, 0xcc, 0x5a, 0x59, 0xb4, 0xdf, 0xa6, 0x35, 0xb4, 0xf9, 0x1c, 0x54, 0xf7, 0x5d, 0x47, 0xaf,
+	0xe7, 0x03, 0xe2, 0xcf, 0x5d, 0xc7, 0x20, 0x6d, 0xf9, 0xc3, 0x6b, 0x63, 0xac, 0xc3, 0xab, 0x14,
+	0x76, 0x37, 0x0f, 0x08, 0xbb, 0xb3, 0x67, 0x8f, 0x56, 0xee, 0xec, 0x91, 0xdc, 0x27, 0xb7, 0xe5,
+	0xfb, 0xe4, 0xb3, 0xd0, 0x71, 0x07, 0x4e, 0x6c, 0x07, 0x0e, 0xee, 0xfa, 0x7d, 0x1a, 0x89, 0x68,
+	0x06, 0x88, 0xaa, 0x6d, 0x1a, 0xe8, 0xb9, 0xe6, 0xbe, 0xed, 0x0e, 0x5c, 0x1a, 0x79, 0x68, 0x86,
+	0x28, 0x92, 0x0c, 0x14, 0xde, 0xb7, 0x9c, 0x41, 0x64, 0xbf, 0xc0, 0x5d, 0x81, 0x99, 0xa5, 0xfd,
+	0x2e, 0x26, 0x0d, 0x0f, 0x39, 0x98, 0xb0, 0xb1, 0x3d, 0x0a, 0x99, 0xe3, 0x6c, 0x6c, 0x4f, 0xc1,
+	0x86, 0x63, 0xe6, 0x47, 0xd9, 0x70, 0xf0, 0x69, 0x00, 0xd7, 0xdc, 0xef, 0x3a, 0xd8, 0xdb, 0x8d,
+	0xf7, 0xf4, 0x85, 0x35, 0xed, 0x72, 0xd5, 0x68, 0xbb, 0xe6, 0xfe, 0x03, 0x5a, 0x41, 0x9b, 0x6d,
+	0x4f, 0x34, 0x2f, 0xf2, 0x66, 0xdb, 0xe3, 0xcd, 0x3a, 0x34, 0x03, 0x33, 0x26, 0xcb, 0xab, 0x2f,
+	0xb1, 0xa0, 0x95, 0x17, 0x89, 0x14, 0x10, 0xbe, 0x36, 0xb9, 0x47, 0xd6, 0x11, 0xa5, 0x6b, 0xb9,
+	0xe6, 0x3e, 0xbd, 0x57, 0xa6, 0x8d, 0xb6, 0xc7, 0x1b, 0x97, 0x79, 0xa3, 0xed, 0xb1, 0xc6, 0x73,
+	0x30, 0x3b, 0xf0, 0xec, 0xe7, 0x03, 0xcc, 0xdb, 0x57, 0xe8, 0xc8, 0x3b, 0xac, 0x8e, 0x41, 0x2e,
+	0xc2, 0x3c, 0x61, 0x2e, 0xa9, 0xc7, 0x31, 0xca, 0x64, 0xce, 0x35, 0xf7, 0xa5, 0xf8, 0x82, 0xc0,
+	0x6c, 0x4f, 0x86, 0xad, 0x72, 0x98, 0xed, 0x49, 0x30, 0xd9, 0x5d, 0x1e, 0xa7, 0x29, 0x96, 0xa4,
+	0x4c, 0x9e, 0x0a, 0x62, 0x6f, 0xe0, 0xea, 0xfa, 0x5a, 0x55, 0x25, 0x2b, 0xb4, 0x91, 0xe6, 0x67,
+	0xc8, 0x3b, 0xbc, 0x13, 0xec, 0x18, 0x4c, 0xfe, 0xa3, 0xb7, 0xa0, 0x61, 0x3a, 0x0e, 0x91, 0x80,
+	0x93, 0x87, 0xb9, 0x29, 0xaf, 0x9b, 0x8e, 0xb3, 0xdd, 0x27, 0x54, 0xbe, 0x47, 0xe5, 0xe6, 0xd4,
+	0xa1, 0xa8, 0x7c, 0x0f, 0x33, 0x2a, 0xd3, 0x1b, 0x12, 0xaa, 0x57, 0x0e, 0xd7, 0x97, 0x37, 0xdc,
+	0xee, 0xa3, 0x0b, 0x50, 0xf5, 0xfc, 0x58, 0x3f, 0xad, 0xb8, 0x14, 0xa6, 0x24, 0x06, 0x69, 0x26,
+	0x51, 0x32, 0xdb, 0x86, 0x33, 0x79, 0xbb, 0x9e, 0x3c, 0x12, 0x30, 0x18, 0x86, 0xbc, 0xe6, 0x94,
+	0x16, 0xfb, 0x6c, 0xde, 0x1b, 0xa6, 0xab, 0x6e, 0x48, 0xc8, 0x62, 0xab, 0xb7, 0xb6, 0xa6, 0x8d,
+	0x5a, 0xbd, 0xa2, 0x0f, 0x46, 0xd4, 0x56, 0x0f, 0xbd, 0x99, 0x7a, 0x93, 0x73, 0xf9, 0x00, 0x44,
+	0x7a, 0xec, 0x9b, 0x7a, 0x91, 0x91, 0x68, 0x61, 0x3d, 0x1f, 0x2d, 0xac, 0x42, 0xa3, 0xef, 0x87,
+	0xae, 0x19, 0xeb, 0xe7, 0x69, 0x23, 0x2f, 0x95, 0x79, 0x89, 0x0b, 0x13, 0xbe, 0xd6, 0xc9, 0x5f,
+	0x2f, 0x5c, 0x1d, 0xb9, 0x5f, 0x56, 0x6c, 0x1d, 0x7d, 0x72, 0x4c, 0xff, 0x4d, 0xe3, 0xb5, 0x8e,
+	0xe2, 0x6b, 0x81, 0x9f, 0x2e, 0x74, 0xdf, 0x83, 0x65, 0x45, 0xfa, 0x0b, 0x7d, 0x52, 0xde, 0xdf,
+	0x2b, 0xf9, 0xfe, 0xa4, 0x74, 0xbf, 0xba, 0xa7, 0x3f, 0x55, 0x60, 0x3e, 0x7b, 0x1f, 0x92, 0x28,
+	0xb2, 0x26, 0x29, 0xf2, 0xc1, 0xef, 0x83, 0x44, 0x16, 0xac, 0x9a, 0xcb, 0x82, 0xd5, 0x92, 0x2c,
+	0xd8, 0x2a, 0xdf, 0x34, 0xf1, 0xe2, 0x8d, 0x97, 0xc8, 0x2b, 0x94, 0x1d, 0x6c, 0x86, 0x38, 0xec,
+	0x72, 0x81, 0x62, 0x2f, 0x7b, 0x66, 0x59, 0xe5, 0x47, 0x4c, 0xac, 0xae, 0x42, 0xbd, 0x4f, 0x92,
+	0xf5, 0x7a, 0x33, 0xaf, 0x51, 0x69, 0x2a, 0xdf, 0x60, 0x20, 0x74, 0x0d, 0x96, 0x49, 0x7b, 0xd7,
+	0xee, 0x75, 0x2d, 0xdf, 0xf3, 0xb0, 0x15, 0xd3, 0x5b, 0x88, 0x16, 0xbb, 0xc9, 0x20, 0x4d, 0xf7,
+	0x7b, 0x77, 0x59, 0xc3, 0xd3, 0xd0, 0x99, 0xf6, 0x43, 0xe5, 0xef, 0x35, 0x38, 0x51, 0x7c, 0x5f,
+	0xb5, 0x09, 0x0b, 0x23, 0xdf, 0x43, 0xe8, 0x9a, 0xea, 0xf6, 0x48, 0xa6, 0xdf, 0x9a, 0x31, 0xe6,
+	0xb3, 0xdf, 0x40, 0x1c, 0x59, 0xa8, 0xbf, 0x81, 0x53, 0x25, 0x9f, 0x5c, 0xa0, 0x6e, 0xb9, 0xb0,
+	0x5d, 0xc9, 0x0b, 0x5b, 0xd1, 0x84, 0x0b, 0x44, 0xef, 0xf7, 0x1a, 0x34, 0x58, 0xce, 0x40, 0x3c,
+	0x78, 0xd2, 0xd2, 0x07, 0x4f, 0x07, 0x0b, 0xdc, 0xbb, 0xd0, 0x7e, 0xc1, 0xaf, 0xd6, 0xc4, 0x25,
+	0xca, 0xa9, 0xe2, 0xdb, 0xb7, 0xc8, 0x48, 0xd1, 0xd3, 0x3e, 0xfe, 0xfd, 0xa0, 0xc1, 0x7c, 0xb6,
+	0x37, 0xa2, 0x0d, 0xd4, 0x63, 0xf2, 0xcb, 0x0a, 0xf2, 0x9f, 0x84, 0x09, 0xc2, 0x08, 0xf3, 0xdc,
+	0x56, 0x81, 0xad, 0x55, 0xe4, 0xb6, 0xa6, 0x3c, 0xe2, 0x5d, 0x58, 0x18, 0x59, 0x1e, 0xf4, 0xa4,
+	0x7c, 0xbb, 0xcf, 0x2a, 0xb6, 0x5b, 0x66, 0x50, 0xb0, 0xc7, 0xcf, 0x61, 0xf5, 0xb1, 0x72, 0x08,
+	0x3f, 0xdd, 0x57, 0x25, 0xe7, 0xa1, 0x23, 0xdf, 0x90, 0xae, 0xa4, 0x1f, 0x31, 0x56, 0xd3, 0xbb,
+	0xd4, 0xcf, 0xa0, 0xc9, 0x40, 0xe4, 0x33, 0xa9, 0xd2, 0x89, 0x1f, 0x2f, 0x30, 0xaa, 0x05, 0x13,
+	0xfe, 0x51, 0x83, 0xea, 0x13, 0x53, 0x7d, 0xad, 0x7b, 0xb0, 0x4c, 0xe7, 0xc2, 0xfa, 0xea, 0xb4,
+	0x3e, 0xb7, 0x98, 0x44, 0x4a, 0xfe, 0xac, 0x41, 0xf5, 0x73, 0xd7, 0x51, 0xce, 0xe5, 0x15, 0x68,
+	0x93, 0xdf, 0x28, 0x30, 0x2d, 0x71, 0x4d, 0x9d, 0x56, 0x10, 0x43, 0x1f, 0x84, 0xb8, 0x6f, 0xef,
+	0x73, 0x59, 0xe6, 0x25, 0x42, 0x65, 0xc6, 0x71, 0x68, 0xef, 0x0c, 0x62, 0xf1, 0x2a, 0x3a, 0xad,
+	0x20, 0x0a, 0xf2, 0x32, 0x34, 0x83, 0x20, 0xb9, 0xe3, 0x10, 0xc5, 0x29, 0x7f, 0x36, 0x71, 0xe7,
+	0x55, 0x98, 0xf7, 0xc3, 0x5d, 0x41, 0xd2, 0x7d, 0x71, 0xeb, 0xce, 0x2c, 0xff, 0x78, 0xf7, 0x11,
+	0xf9, 0xb4, 0xf5, 0x91, 0xf6, 0x7d, 0xa5, 0xba, 0xbd, 0xf1, 0x78, 0xa7, 0x41, 0xbf, 0x74, 0xbd,
+	0xf5, 0xcf, 0x01, 0x00, 0x02, 0xd9, 0x57, 0x23, 0xe5, 0x3b, 0x00, 0x00,
 }
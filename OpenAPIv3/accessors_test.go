@@ -0,0 +1,80 @@
+package openapi_v3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAccessorsKeepOrder(t *testing.T) {
+	paths := &Paths{}
+	pets := &PathItem{Summary: "pets"}
+	owners := &PathItem{Summary: "owners"}
+	toys := &PathItem{Summary: "toys"}
+	paths.Set("/pets", pets)
+	paths.Set("/owners", owners)
+	paths.Set("/toys", toys)
+	if names := paths.Names(); !reflect.DeepEqual(names, []string{"/pets", "/owners", "/toys"}) {
+		t.Errorf("Names returned %q", names)
+	}
+	if paths.Get("/owners") != owners {
+		t.Errorf("Get returned the wrong value")
+	}
+	if paths.Get("/stores") != nil {
+		t.Errorf("Get returned a value for a missing name")
+	}
+}
+
+func TestSetReplaces(t *testing.T) {
+	paths := &Paths{}
+	paths.Set("/pets", &PathItem{Summary: "pets"})
+	paths.Set("/owners", &PathItem{Summary: "owners"})
+	replacement := &PathItem{Summary: "all pets"}
+	paths.Set("/pets", replacement)
+	if len(paths.Path) != 2 {
+		t.Fatalf("Set added a value for an existing name: %v", paths.Path)
+	}
+	if paths.Get("/pets") != replacement {
+		t.Errorf("Set did not replace the value")
+	}
+	if names := paths.Names(); !reflect.DeepEqual(names, []string{"/pets", "/owners"}) {
+		t.Errorf("Set moved a replaced value: %q", names)
+	}
+}
+
+func TestDeleteRemoves(t *testing.T) {
+	paths := &Paths{}
+	for _, name := range []string{"/pets", "/owners", "/toys"} {
+		paths.Set(name, &PathItem{Summary: name})
+	}
+	paths.Delete("/owners")
+	paths.Delete("/stores")
+	if names := paths.Names(); !reflect.DeepEqual(names, []string{"/pets", "/toys"}) {
+		t.Errorf("Names returned %q after Delete", names)
+	}
+	if paths.Get("/owners") != nil {
+		t.Errorf("Get returned a deleted value")
+	}
+}
+
+func TestScalarAccessors(t *testing.T) {
+	strings := &Strings{}
+	strings.Set("a", "1")
+	strings.Set("b", "2")
+	strings.Set("a", "3")
+	if value := strings.Get("a"); value != "3" {
+		t.Errorf("Get returned %q", value)
+	}
+	if value := strings.Get("c"); value != "" {
+		t.Errorf("Get returned %q for a missing name", value)
+	}
+	if names := strings.Names(); !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("Names returned %q", names)
+	}
+}
+
+func TestAccessorsOnNil(t *testing.T) {
+	var paths *Paths
+	if paths.Get("/pets") != nil || len(paths.Names()) != 0 {
+		t.Errorf("accessors of a nil Paths returned values")
+	}
+}
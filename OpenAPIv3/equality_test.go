@@ -0,0 +1,213 @@
+package openapi_v3
+
+import (
+	"testing"
+
+	"github.com/googleapis/gnostic/compiler"
+)
+
+// parseDocument compiles a document from YAML text. The text has no filename,
+// so the reader doesn't cache it.
+func parseDocument(t *testing.T, text string) *Document {
+	info, err := compiler.ReadInfoFromBytes("", []byte(text))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	document, err := NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return document
+}
+
+const petDocument = `
+openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: pets
+  /owners:
+    get:
+      operationId: listOwners
+      responses:
+        "200":
+          description: owners
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+        tag:
+          type: string
+`
+
+const reorderedPetDocument = `
+openapi: 3.0.0
+info:
+  version: 1.0.0
+  title: Pets
+paths:
+  /owners:
+    get:
+      operationId: listOwners
+      responses:
+        "200":
+          description: owners
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: pets
+components:
+  schemas:
+    Pet:
+      properties:
+        tag:
+          type: string
+        name:
+          type: string
+      type: object
+`
+
+func TestEqualIgnoresOrderOfNamedValues(t *testing.T) {
+	document := parseDocument(t, petDocument)
+	reordered := parseDocument(t, reorderedPetDocument)
+	if !document.Equal(reordered) {
+		t.Errorf("documents that differ in the order of named values are not equal")
+	}
+	if !reordered.Equal(document) {
+		t.Errorf("Equal is not symmetric")
+	}
+}
+
+func TestEqualComparesValues(t *testing.T) {
+	document := parseDocument(t, petDocument)
+	changed := parseDocument(t, petDocument)
+	changed.Components.Schemas.AdditionalProperties[0].Value.GetSchema().Properties.AdditionalProperties[1].Name = "kind"
+	if document.Equal(changed) {
+		t.Errorf("documents with different property names are equal")
+	}
+	changed = parseDocument(t, petDocument)
+	changed.Paths.Path = changed.Paths.Path[:1]
+	if document.Equal(changed) {
+		t.Errorf("documents with different numbers of paths are equal")
+	}
+	if document.Equal(nil) || !(*Document)(nil).Equal(nil) {
+		t.Errorf("Equal does not handle nil documents")
+	}
+}
+
+func TestEqualMatchesRepeatedNames(t *testing.T) {
+	document := parseDocument(t, petDocument)
+	repeated := parseDocument(t, petDocument)
+	// the properties of the repeated document are name and name instead of name and tag
+	properties := repeated.Components.Schemas.AdditionalProperties[0].Value.GetSchema().Properties
+	properties.AdditionalProperties[1] = properties.AdditionalProperties[0]
+	if document.Equal(repeated) || repeated.Equal(document) {
+		t.Errorf("documents with repeated property names are equal to documents without them")
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	document := parseDocument(t, petDocument)
+	clone := document.Clone()
+	if !clone.Equal(document) {
+		t.Fatalf("a clone is not equal to its original")
+	}
+	clone.Info.Title = "Cloned"
+	if document.Info.Title != "Pets" {
+		t.Errorf("changing a clone changed its original")
+	}
+}
+
+func TestMergeByName(t *testing.T) {
+	document := parseDocument(t, petDocument)
+	other := parseDocument(t, `
+openapi: 3.0.0
+info:
+  title: More Pets
+  version: 2.0.0
+paths:
+  /pets/{petId}:
+    get:
+      operationId: showPetById
+      responses:
+        "200":
+          description: a pet
+components:
+  schemas:
+    Pet:
+      properties:
+        age:
+          type: integer
+        name:
+          type: string
+          description: the name of the pet
+    Owner:
+      type: object
+`)
+	original := other.Clone()
+	document.Merge(other)
+
+	if document.Info.Title != "More Pets" || document.Info.Version != "2.0.0" {
+		t.Errorf("info was not replaced: %s %s", document.Info.Title, document.Info.Version)
+	}
+	if names := pathNames(document.Paths); !equalStrings(names, []string{"/pets", "/owners", "/pets/{petId}"}) {
+		t.Errorf("merged paths are %q", names)
+	}
+	schemas := document.Components.Schemas.AdditionalProperties
+	if len(schemas) != 2 || schemas[0].Name != "Pet" || schemas[1].Name != "Owner" {
+		t.Fatalf("merged schemas are %v", schemas)
+	}
+	pet := schemas[0].Value.GetSchema()
+	if pet.Type != "object" {
+		t.Errorf("merging removed the type of Pet")
+	}
+	properties := pet.Properties.AdditionalProperties
+	names := make([]string, 0)
+	for _, property := range properties {
+		names = append(names, property.Name)
+	}
+	if !equalStrings(names, []string{"name", "tag", "age"}) {
+		t.Errorf("merged properties are %q", names)
+	}
+	if description := properties[0].Value.GetSchema().Description; description != "the name of the pet" {
+		t.Errorf("merged name has description %q", description)
+	}
+	if !other.Equal(original) {
+		t.Errorf("Merge changed its argument")
+	}
+	other.Components.Schemas.AdditionalProperties[1].Value.GetSchema().Type = "string"
+	if schemas[1].Value.GetSchema().Type != "object" {
+		t.Errorf("merged values share memory with their source")
+	}
+}
+
+func pathNames(paths *Paths) []string {
+	names := make([]string, 0)
+	for _, item := range paths.Path {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
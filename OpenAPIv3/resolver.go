@@ -0,0 +1,393 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi_v3
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/googleapis/gnostic/compiler"
+	"gopkg.in/yaml.v2"
+)
+
+// Resolver finds the objects that $ref values refer to.
+// References are relative to the document that the Resolver was created for,
+// and references found in other files are relative to those files.
+// Resolved objects are cached, so callers share the objects that are returned,
+// and references to objects in the document return the objects of the document.
+type Resolver struct {
+	document  *Document
+	filename  string
+	info      interface{}
+	index     map[string]interface{}
+	objects   map[string]interface{}
+	resolving map[string]bool
+}
+
+// NewResolver creates a Resolver for a document that was read from filename.
+// The filename may be empty if the document only contains local references.
+func NewResolver(document *Document, filename string) *Resolver {
+	return &Resolver{
+		document:  document,
+		filename:  filename,
+		objects:   make(map[string]interface{}),
+		resolving: make(map[string]bool),
+	}
+}
+
+// ResolveSchema returns the Schema that a reference refers to.
+func (r *Resolver) ResolveSchema(ref string) (*Schema, error) {
+	object, err := r.resolve(r.filename, ref, "Schema",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewSchema(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Schema), nil
+}
+
+// ResolveResponse returns the Response that a reference refers to.
+func (r *Resolver) ResolveResponse(ref string) (*Response, error) {
+	object, err := r.resolve(r.filename, ref, "Response",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewResponse(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Response), nil
+}
+
+// ResolveParameter returns the Parameter that a reference refers to.
+func (r *Resolver) ResolveParameter(ref string) (*Parameter, error) {
+	object, err := r.resolve(r.filename, ref, "Parameter",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewParameter(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Parameter), nil
+}
+
+// ResolveExample returns the Example that a reference refers to.
+func (r *Resolver) ResolveExample(ref string) (*Example, error) {
+	object, err := r.resolve(r.filename, ref, "Example",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewExample(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Example), nil
+}
+
+// ResolveRequestBody returns the RequestBody that a reference refers to.
+func (r *Resolver) ResolveRequestBody(ref string) (*RequestBody, error) {
+	object, err := r.resolve(r.filename, ref, "RequestBody",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewRequestBody(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*RequestBody), nil
+}
+
+// ResolveHeader returns the Header that a reference refers to.
+func (r *Resolver) ResolveHeader(ref string) (*Header, error) {
+	object, err := r.resolve(r.filename, ref, "Header",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewHeader(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Header), nil
+}
+
+// ResolveSecurityScheme returns the SecurityScheme that a reference refers to.
+func (r *Resolver) ResolveSecurityScheme(ref string) (*SecurityScheme, error) {
+	object, err := r.resolve(r.filename, ref, "SecurityScheme",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewSecurityScheme(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*SecurityScheme), nil
+}
+
+// ResolveLink returns the Link that a reference refers to.
+func (r *Resolver) ResolveLink(ref string) (*Link, error) {
+	object, err := r.resolve(r.filename, ref, "Link",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewLink(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Link), nil
+}
+
+// ResolveCallback returns the Callback that a reference refers to.
+func (r *Resolver) ResolveCallback(ref string) (*Callback, error) {
+	object, err := r.resolve(r.filename, ref, "Callback",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewCallback(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*Callback), nil
+}
+
+// ResolvePathItem returns the PathItem that a reference refers to.
+func (r *Resolver) ResolvePathItem(ref string) (*PathItem, error) {
+	object, err := r.resolve(r.filename, ref, "PathItem",
+		func(in interface{}, context *compiler.Context) (interface{}, error) {
+			return NewPathItem(in, context)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return object.(*PathItem), nil
+}
+
+// resolve builds the object that a reference refers to, following references to other references.
+func (r *Resolver) resolve(base string, ref string, kind string,
+	build func(in interface{}, context *compiler.Context) (interface{}, error)) (interface{}, error) {
+	parts := strings.SplitN(ref, "#", 2)
+	filename := resolveLocation(base, parts[0])
+	pointer := ""
+	if len(parts) > 1 {
+		pointer = parts[1]
+	}
+	key := kind + " " + filename + "#" + pointer
+	if object, ok := r.objects[key]; ok {
+		return object, nil
+	}
+	if r.resolving[key] {
+		return nil, fmt.Errorf("circular reference %s", ref)
+	}
+	r.resolving[key] = true
+	defer delete(r.resolving, key)
+
+	if filename == r.filename {
+		if object := r.documentObject(kind, pointer); object != nil {
+			r.objects[key] = object
+			return object, nil
+		}
+	}
+	info, err := r.infoForFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	info, err = infoForPointer(info, pointer)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve %s: %v", ref, err)
+	}
+	var object interface{}
+	if m, ok := compiler.UnpackMap(info); ok && compiler.MapHasKey(m, "$ref") {
+		next, ok := compiler.MapValueForKey(m, "$ref").(string)
+		if !ok {
+			return nil, fmt.Errorf("could not resolve %s: $ref is not a string", ref)
+		}
+		object, err = r.resolve(filename, next, kind, build)
+	} else {
+		object, err = build(info, compiler.NewContext("$ref", nil))
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.objects[key] = object
+	return object, nil
+}
+
+// documentObject returns the object of a kind that a JSON pointer selects in the document,
+// or nil if there is none, such as when the pointer selects another reference.
+func (r *Resolver) documentObject(kind string, pointer string) interface{} {
+	if r.index == nil {
+		visitor := &indexVisitor{index: make(map[string]interface{})}
+		Walk(r.document, visitor)
+		r.index = visitor.index
+	}
+	tokens := make([]string, 0)
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if token != "" {
+			token, err := pointerToken(token)
+			if err != nil {
+				return nil
+			}
+			tokens = append(tokens, token)
+		}
+	}
+	return r.index[indexKey(kind, tokens)]
+}
+
+// indexKey returns the key of an object of a kind at a key path in the index of a document.
+func indexKey(kind string, path []string) string {
+	tokens := make([]string, 0, len(path))
+	for _, key := range path {
+		tokens = append(tokens, strings.Replace(strings.Replace(key, "~", "~0", -1), "/", "~1", -1))
+	}
+	return kind + " /" + strings.Join(tokens, "/")
+}
+
+// indexVisitor indexes the objects of a document that references can refer to by their kinds and paths.
+type indexVisitor struct {
+	BaseVisitor
+	index map[string]interface{}
+}
+
+func (v *indexVisitor) add(kind string, node interface{}, path []string) error {
+	v.index[indexKey(kind, path)] = node
+	return nil
+}
+
+func (v *indexVisitor) VisitSchema(node *Schema, path []string) error {
+	return v.add("Schema", node, path)
+}
+
+func (v *indexVisitor) VisitResponse(node *Response, path []string) error {
+	return v.add("Response", node, path)
+}
+
+func (v *indexVisitor) VisitParameter(node *Parameter, path []string) error {
+	return v.add("Parameter", node, path)
+}
+
+func (v *indexVisitor) VisitExample(node *Example, path []string) error {
+	return v.add("Example", node, path)
+}
+
+func (v *indexVisitor) VisitRequestBody(node *RequestBody, path []string) error {
+	return v.add("RequestBody", node, path)
+}
+
+func (v *indexVisitor) VisitHeader(node *Header, path []string) error {
+	return v.add("Header", node, path)
+}
+
+func (v *indexVisitor) VisitSecurityScheme(node *SecurityScheme, path []string) error {
+	return v.add("SecurityScheme", node, path)
+}
+
+func (v *indexVisitor) VisitLink(node *Link, path []string) error {
+	return v.add("Link", node, path)
+}
+
+func (v *indexVisitor) VisitCallback(node *Callback, path []string) error {
+	return v.add("Callback", node, path)
+}
+
+func (v *indexVisitor) VisitPathItem(node *PathItem, path []string) error {
+	return v.add("PathItem", node, path)
+}
+
+// infoForFile returns the parsed contents of a file, using the document for its own file.
+func (r *Resolver) infoForFile(filename string) (interface{}, error) {
+	if filename == r.filename {
+		if r.info == nil {
+			// the document is written and read again so that its values have the types that the compiler reads.
+			bytes, err := yaml.Marshal(r.document.ToRawInfo())
+			if err != nil {
+				return nil, err
+			}
+			var info yaml.MapSlice
+			err = yaml.Unmarshal(bytes, &info)
+			if err != nil {
+				return nil, err
+			}
+			r.info = info
+		}
+		return r.info, nil
+	}
+	bytes, err := compiler.ReadBytesForFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return compiler.ReadInfoFromBytes(filename, bytes)
+}
+
+// resolveLocation returns the location of a file named relative to another file.
+func resolveLocation(base string, location string) string {
+	if location == "" {
+		return base
+	}
+	if u, err := url.Parse(location); err == nil && u.IsAbs() {
+		return location
+	}
+	if b, err := url.Parse(base); err == nil && b.IsAbs() {
+		if u, err := url.Parse(location); err == nil {
+			return b.ResolveReference(u).String()
+		}
+	}
+	if filepath.IsAbs(location) {
+		return location
+	}
+	return filepath.Join(filepath.Dir(base), location)
+}
+
+// pointerToken returns the key that a token of a JSON pointer selects. Pointers in URI fragments
+// are percent-encoded, so tokens are decoded before their escaped "/" and "~" characters are.
+func pointerToken(token string) (string, error) {
+	token, err := url.PathUnescape(token)
+	if err != nil {
+		return "", err
+	}
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1), nil
+}
+
+// infoForPointer returns the part of a parsed file that a JSON pointer selects.
+func infoForPointer(info interface{}, pointer string) (interface{}, error) {
+	if pointer == "" || pointer == "/" {
+		return info, nil
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token, err := pointerToken(token)
+		if err != nil {
+			return nil, err
+		}
+		switch in := info.(type) {
+		case yaml.MapSlice:
+			// keys such as response codes may be parsed as numbers, so compare them as strings
+			found := false
+			for _, item := range in {
+				if fmt.Sprintf("%v", item.Key) == token {
+					info = item.Value
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("%s not found", token)
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(in) {
+				return nil, fmt.Errorf("invalid index %s", token)
+			}
+			info = in[index]
+		default:
+			return nil, fmt.Errorf("%s not found", token)
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,170 @@
+package openapi_v3
+
+import (
+	"strings"
+	"testing"
+)
+
+const resolverFile = "testdata/resolver.yaml"
+
+func newTestResolver(t *testing.T) *Resolver {
+	return NewResolver(readDocument(t, resolverFile), resolverFile)
+}
+
+func TestResolveSchema(t *testing.T) {
+	resolver := newTestResolver(t)
+	schema, err := resolver.ResolveSchema("#/components/schemas/Pet")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if schema.Type != "object" || len(schema.Required) != 1 || schema.Required[0] != "name" {
+		t.Errorf("resolved the wrong schema: %v", schema)
+	}
+}
+
+func TestResolverCachesObjects(t *testing.T) {
+	resolver := newTestResolver(t)
+	first, err := resolver.ResolveSchema("#/components/schemas/Pet")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	second, err := resolver.ResolveSchema("#/components/schemas/Pet")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if first != second {
+		t.Errorf("resolving a reference twice returned different objects")
+	}
+}
+
+func TestResolveChainedReferences(t *testing.T) {
+	resolver := newTestResolver(t)
+	creature, err := resolver.ResolveSchema("#/components/schemas/Creature")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	pet, err := resolver.ResolveSchema("#/components/schemas/Pet")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if creature != pet {
+		t.Errorf("a chain of references did not resolve to the schema at its end")
+	}
+}
+
+func TestResolveCircularReference(t *testing.T) {
+	resolver := newTestResolver(t)
+	_, err := resolver.ResolveSchema("#/components/schemas/Chicken")
+	if err == nil || !strings.Contains(err.Error(), "circular reference") {
+		t.Errorf("expected a circular reference error, got %v", err)
+	}
+	// a failed resolution doesn't prevent others.
+	if _, err := resolver.ResolveSchema("#/components/schemas/Pet"); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
+
+func TestResolveEscapedPointers(t *testing.T) {
+	resolver := newTestResolver(t)
+	schema, err := resolver.ResolveSchema("#/components/schemas/a~1b~0c")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if schema.Description != "escaped" {
+		t.Errorf("resolved the wrong schema: %v", schema)
+	}
+	parameter, err := resolver.ResolveParameter("#/paths/~1pets/get/parameters/0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if parameter.Name != "limit" {
+		t.Errorf("resolved the wrong parameter: %v", parameter)
+	}
+	parameter, err = resolver.ResolveParameter("#/paths/~1pets/get/parameters/1")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if parameter.Name != "tag" {
+		t.Errorf("resolved the wrong parameter: %v", parameter)
+	}
+	// pointers in URI fragments are percent-encoded
+	parameter, err = resolver.ResolveParameter("#/paths/~1pets~1%7Bid%7D/get/parameters/0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if parameter.Name != "id" {
+		t.Errorf("resolved the wrong parameter: %v", parameter)
+	}
+	for _, ref := range []string{
+		"#/paths/~1pets/get/parameters/2",
+		"#/paths/~1pets/get/parameters/limit",
+		"#/components/schemas/a/b~c",
+		"#/paths/~1pets~1%7Bid/get/parameters/0",
+	} {
+		if _, err := resolver.ResolveParameter(ref); err == nil {
+			t.Errorf("resolved %s", ref)
+		}
+	}
+}
+
+func TestResolveDocumentObjects(t *testing.T) {
+	document := readDocument(t, resolverFile)
+	resolver := NewResolver(document, resolverFile)
+	pet, err := resolver.ResolveSchema("#/components/schemas/Pet")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if pet != document.Components.Schemas.Get("Pet").GetSchema() {
+		t.Errorf("a reference to a schema in the document did not return the schema of the document")
+	}
+	tag, err := resolver.ResolveParameter("#/components/parameters/Tag")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if tag != document.Components.Parameters.Get("Tag").GetParameter() {
+		t.Errorf("a reference to a parameter in the document did not return the parameter of the document")
+	}
+	escaped, err := resolver.ResolveSchema("#/components/schemas/a~1b~0c")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if escaped != document.Components.Schemas.Get("a/b~c").GetSchema() {
+		t.Errorf("an escaped reference did not return the schema of the document")
+	}
+	id, err := resolver.ResolveParameter("#/paths/~1pets~1%7Bid%7D/get/parameters/0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if id != document.Paths.Get("/pets/{id}").Get.Parameters[0].GetParameter() {
+		t.Errorf("a percent-encoded reference did not return the parameter of the document")
+	}
+}
+
+func TestResolveExternalReferences(t *testing.T) {
+	resolver := newTestResolver(t)
+	// Owner refers to a schema in another file, which refers to a schema in that file.
+	owner, err := resolver.ResolveSchema("#/components/schemas/Owner")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if owner.Type != "object" || owner.Properties.Get("name") == nil {
+		t.Errorf("resolved the wrong schema: %v", owner)
+	}
+	person, err := resolver.ResolveSchema("common.yaml#/components/schemas/Person")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if owner != person {
+		t.Errorf("references to the same schema in another file returned different objects")
+	}
+	response, err := resolver.ResolveResponse("common.yaml#/components/responses/Pets")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if response.Description != "a list of pets" {
+		t.Errorf("resolved the wrong response: %v", response)
+	}
+	if _, err := resolver.ResolveSchema("missing.yaml#/components/schemas/Pet"); err == nil {
+		t.Errorf("resolved a reference to a missing file")
+	}
+}
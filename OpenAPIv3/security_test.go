@@ -0,0 +1,63 @@
+package openapi_v3
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+const securityDocument = `
+openapi: 3.0.0
+info:
+  title: Pets
+  version: 1.0.0
+security:
+- api_key: []
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      security:
+      - petstore_auth:
+        - read:pets
+        - write:pets
+        api_key: []
+      responses:
+        "200":
+          description: the pets
+`
+
+func TestSecurityRequirementSchemes(t *testing.T) {
+	document := parseDocument(t, securityDocument)
+	if len(document.Security) != 1 {
+		t.Fatalf("the document has %d security requirements", len(document.Security))
+	}
+	if names := document.Security[0].Names(); !reflect.DeepEqual(names, []string{"api_key"}) {
+		t.Errorf("the document's security requirement has schemes %q", names)
+	}
+	if scopes := document.Security[0].Get("api_key"); scopes == nil || len(scopes.Value) != 0 {
+		t.Errorf("the api_key scheme has scopes %v", scopes)
+	}
+	security := document.Paths.Get("/pets").Get.Security
+	if len(security) != 1 {
+		t.Fatalf("listPets has %d security requirements", len(security))
+	}
+	if names := security[0].Names(); !reflect.DeepEqual(names, []string{"petstore_auth", "api_key"}) {
+		t.Errorf("the security requirement of listPets has schemes %q", names)
+	}
+	if scopes := security[0].Get("petstore_auth"); scopes == nil || !reflect.DeepEqual(scopes.Value, []string{"read:pets", "write:pets"}) {
+		t.Errorf("the petstore_auth scheme has scopes %v", scopes)
+	}
+}
+
+func TestSecurityRequirementRoundTrip(t *testing.T) {
+	document := parseDocument(t, securityDocument)
+	bytes, err := yaml.Marshal(document.ToRawInfo())
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if !document.Equal(parseDocument(t, string(bytes))) {
+		t.Errorf("security requirements were lost when the document was written:\n%s", bytes)
+	}
+}
@@ -147,6 +147,15 @@ func ReadInfoFromBytes(filename string, bytes []byte) (interface{}, error) {
 	return info, nil
 }
 
+// ReadInfoForFile reads a file and unmarshals it as a yaml.MapSlice.
+func ReadInfoForFile(filename string) (interface{}, error) {
+	bytes, err := ReadBytesForFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ReadInfoFromBytes(filename, bytes)
+}
+
 // ReadInfoForRef reads a file and return the fragment needed to resolve a $ref.
 func ReadInfoForRef(basefile string, ref string) (interface{}, error) {
 	initializeInfoCache()
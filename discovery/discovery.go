@@ -18,6 +18,7 @@ package discovery_v1
 
 import (
 	"fmt"
+	"github.com/golang/protobuf/proto"
 	"github.com/googleapis/gnostic/compiler"
 	"gopkg.in/yaml.v2"
 	"regexp"
@@ -2123,6 +2124,9 @@ func (m *StringArray) ResolveReferences(root string) (interface{}, error) {
 // ToRawInfo returns a description of Annotations suitable for JSON or YAML export.
 func (m *Annotations) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if len(m.Required) != 0 {
 		info = append(info, yaml.MapItem{Key: "required", Value: m.Required})
 	}
@@ -2153,6 +2157,9 @@ func (m *Any) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Auth suitable for JSON or YAML export.
 func (m *Auth) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Oauth2 != nil {
 		info = append(info, yaml.MapItem{Key: "oauth2", Value: m.Oauth2.ToRawInfo()})
 	}
@@ -2163,12 +2170,13 @@ func (m *Auth) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Document suitable for JSON or YAML export.
 func (m *Document) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
-	if m.Kind != "" {
-		info = append(info, yaml.MapItem{Key: "kind", Value: m.Kind})
-	}
-	if m.DiscoveryVersion != "" {
-		info = append(info, yaml.MapItem{Key: "discoveryVersion", Value: m.DiscoveryVersion})
+	if m == nil {
+		return info
 	}
+	// always include this required field.
+	info = append(info, yaml.MapItem{Key: "kind", Value: m.Kind})
+	// always include this required field.
+	info = append(info, yaml.MapItem{Key: "discoveryVersion", Value: m.DiscoveryVersion})
 	if m.Id != "" {
 		info = append(info, yaml.MapItem{Key: "id", Value: m.Id})
 	}
@@ -2265,18 +2273,22 @@ func (m *Document) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Icons suitable for JSON or YAML export.
 func (m *Icons) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
-	if m.X16 != "" {
-		info = append(info, yaml.MapItem{Key: "x16", Value: m.X16})
-	}
-	if m.X32 != "" {
-		info = append(info, yaml.MapItem{Key: "x32", Value: m.X32})
+	if m == nil {
+		return info
 	}
+	// always include this required field.
+	info = append(info, yaml.MapItem{Key: "x16", Value: m.X16})
+	// always include this required field.
+	info = append(info, yaml.MapItem{Key: "x32", Value: m.X32})
 	return info
 }
 
 // ToRawInfo returns a description of MediaUpload suitable for JSON or YAML export.
 func (m *MediaUpload) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if len(m.Accept) != 0 {
 		info = append(info, yaml.MapItem{Key: "accept", Value: m.Accept})
 	}
@@ -2296,6 +2308,9 @@ func (m *MediaUpload) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Method suitable for JSON or YAML export.
 func (m *Method) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Id != "" {
 		info = append(info, yaml.MapItem{Key: "id", Value: m.Id})
 	}
@@ -2354,6 +2369,9 @@ func (m *Method) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Methods suitable for JSON or YAML export.
 func (m *Methods) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.AdditionalProperties != nil {
 		for _, item := range m.AdditionalProperties {
 			info = append(info, yaml.MapItem{Key: item.Name, Value: item.Value.ToRawInfo()})
@@ -2366,6 +2384,9 @@ func (m *Methods) ToRawInfo() interface{} {
 // ToRawInfo returns a description of NamedMethod suitable for JSON or YAML export.
 func (m *NamedMethod) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Name != "" {
 		info = append(info, yaml.MapItem{Key: "name", Value: m.Name})
 	}
@@ -2376,6 +2397,9 @@ func (m *NamedMethod) ToRawInfo() interface{} {
 // ToRawInfo returns a description of NamedParameter suitable for JSON or YAML export.
 func (m *NamedParameter) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Name != "" {
 		info = append(info, yaml.MapItem{Key: "name", Value: m.Name})
 	}
@@ -2386,6 +2410,9 @@ func (m *NamedParameter) ToRawInfo() interface{} {
 // ToRawInfo returns a description of NamedResource suitable for JSON or YAML export.
 func (m *NamedResource) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Name != "" {
 		info = append(info, yaml.MapItem{Key: "name", Value: m.Name})
 	}
@@ -2396,6 +2423,9 @@ func (m *NamedResource) ToRawInfo() interface{} {
 // ToRawInfo returns a description of NamedSchema suitable for JSON or YAML export.
 func (m *NamedSchema) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Name != "" {
 		info = append(info, yaml.MapItem{Key: "name", Value: m.Name})
 	}
@@ -2406,6 +2436,9 @@ func (m *NamedSchema) ToRawInfo() interface{} {
 // ToRawInfo returns a description of NamedScope suitable for JSON or YAML export.
 func (m *NamedScope) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Name != "" {
 		info = append(info, yaml.MapItem{Key: "name", Value: m.Name})
 	}
@@ -2416,6 +2449,9 @@ func (m *NamedScope) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Oauth2 suitable for JSON or YAML export.
 func (m *Oauth2) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Scopes != nil {
 		info = append(info, yaml.MapItem{Key: "scopes", Value: m.Scopes.ToRawInfo()})
 	}
@@ -2426,6 +2462,9 @@ func (m *Oauth2) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Parameter suitable for JSON or YAML export.
 func (m *Parameter) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Id != "" {
 		info = append(info, yaml.MapItem{Key: "id", Value: m.Id})
 	}
@@ -2490,6 +2529,9 @@ func (m *Parameter) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Parameters suitable for JSON or YAML export.
 func (m *Parameters) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.AdditionalProperties != nil {
 		for _, item := range m.AdditionalProperties {
 			info = append(info, yaml.MapItem{Key: item.Name, Value: item.Value.ToRawInfo()})
@@ -2502,6 +2544,9 @@ func (m *Parameters) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Protocols suitable for JSON or YAML export.
 func (m *Protocols) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Simple != nil {
 		info = append(info, yaml.MapItem{Key: "simple", Value: m.Simple.ToRawInfo()})
 	}
@@ -2516,6 +2561,9 @@ func (m *Protocols) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Request suitable for JSON or YAML export.
 func (m *Request) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.XRef != "" {
 		info = append(info, yaml.MapItem{Key: "$ref", Value: m.XRef})
 	}
@@ -2528,6 +2576,9 @@ func (m *Request) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Resource suitable for JSON or YAML export.
 func (m *Resource) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Methods != nil {
 		info = append(info, yaml.MapItem{Key: "methods", Value: m.Methods.ToRawInfo()})
 	}
@@ -2542,6 +2593,9 @@ func (m *Resource) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Resources suitable for JSON or YAML export.
 func (m *Resources) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.AdditionalProperties != nil {
 		for _, item := range m.AdditionalProperties {
 			info = append(info, yaml.MapItem{Key: item.Name, Value: item.Value.ToRawInfo()})
@@ -2554,6 +2608,9 @@ func (m *Resources) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Response suitable for JSON or YAML export.
 func (m *Response) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.XRef != "" {
 		info = append(info, yaml.MapItem{Key: "$ref", Value: m.XRef})
 	}
@@ -2563,6 +2620,9 @@ func (m *Response) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Resumable suitable for JSON or YAML export.
 func (m *Resumable) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Multipart != false {
 		info = append(info, yaml.MapItem{Key: "multipart", Value: m.Multipart})
 	}
@@ -2575,6 +2635,9 @@ func (m *Resumable) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Schema suitable for JSON or YAML export.
 func (m *Schema) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Id != "" {
 		info = append(info, yaml.MapItem{Key: "id", Value: m.Id})
 	}
@@ -2642,6 +2705,9 @@ func (m *Schema) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Schemas suitable for JSON or YAML export.
 func (m *Schemas) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.AdditionalProperties != nil {
 		for _, item := range m.AdditionalProperties {
 			info = append(info, yaml.MapItem{Key: item.Name, Value: item.Value.ToRawInfo()})
@@ -2654,6 +2720,9 @@ func (m *Schemas) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Scope suitable for JSON or YAML export.
 func (m *Scope) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Description != "" {
 		info = append(info, yaml.MapItem{Key: "description", Value: m.Description})
 	}
@@ -2663,6 +2732,9 @@ func (m *Scope) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Scopes suitable for JSON or YAML export.
 func (m *Scopes) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.AdditionalProperties != nil {
 		for _, item := range m.AdditionalProperties {
 			info = append(info, yaml.MapItem{Key: item.Name, Value: item.Value.ToRawInfo()})
@@ -2675,6 +2747,9 @@ func (m *Scopes) ToRawInfo() interface{} {
 // ToRawInfo returns a description of Simple suitable for JSON or YAML export.
 func (m *Simple) ToRawInfo() interface{} {
 	info := yaml.MapSlice{}
+	if m == nil {
+		return info
+	}
 	if m.Multipart != false {
 		info = append(info, yaml.MapItem{Key: "multipart", Value: m.Multipart})
 	}
@@ -2689,4 +2764,2286 @@ func (m *StringArray) ToRawInfo() interface{} {
 	return m.Value
 }
 
+// Visitor is implemented by types that inspect the nodes of a Document.
+// Each method is called with a node and the path of keys that leads to it
+// from the root of the document. Map keys and property names are used as keys,
+// and array elements are identified by their indices. Returning SkipChildren skips
+// the nodes below a node, and returning any other error stops the walk.
+// Implementations can embed BaseVisitor and override only the methods they need.
+type Visitor interface {
+	VisitAnnotations(node *Annotations, path []string) error
+	VisitAny(node *Any, path []string) error
+	VisitAuth(node *Auth, path []string) error
+	VisitDocument(node *Document, path []string) error
+	VisitIcons(node *Icons, path []string) error
+	VisitMediaUpload(node *MediaUpload, path []string) error
+	VisitMethod(node *Method, path []string) error
+	VisitMethods(node *Methods, path []string) error
+	VisitOauth2(node *Oauth2, path []string) error
+	VisitParameter(node *Parameter, path []string) error
+	VisitParameters(node *Parameters, path []string) error
+	VisitProtocols(node *Protocols, path []string) error
+	VisitRequest(node *Request, path []string) error
+	VisitResource(node *Resource, path []string) error
+	VisitResources(node *Resources, path []string) error
+	VisitResponse(node *Response, path []string) error
+	VisitResumable(node *Resumable, path []string) error
+	VisitSchema(node *Schema, path []string) error
+	VisitSchemas(node *Schemas, path []string) error
+	VisitScope(node *Scope, path []string) error
+	VisitScopes(node *Scopes, path []string) error
+	VisitSimple(node *Simple, path []string) error
+	VisitStringArray(node *StringArray, path []string) error
+}
+
+// SkipChildren is returned by Visitor methods to skip the nodes below the node that they were called with.
+var SkipChildren error = skipChildren{}
+
+type skipChildren struct{}
+
+func (skipChildren) Error() string { return "skip children" }
+
+// BaseVisitor is a Visitor whose methods do nothing.
+type BaseVisitor struct{}
+
+// VisitAnnotations does nothing.
+func (BaseVisitor) VisitAnnotations(node *Annotations, path []string) error { return nil }
+
+// VisitAny does nothing.
+func (BaseVisitor) VisitAny(node *Any, path []string) error { return nil }
+
+// VisitAuth does nothing.
+func (BaseVisitor) VisitAuth(node *Auth, path []string) error { return nil }
+
+// VisitDocument does nothing.
+func (BaseVisitor) VisitDocument(node *Document, path []string) error { return nil }
+
+// VisitIcons does nothing.
+func (BaseVisitor) VisitIcons(node *Icons, path []string) error { return nil }
+
+// VisitMediaUpload does nothing.
+func (BaseVisitor) VisitMediaUpload(node *MediaUpload, path []string) error { return nil }
+
+// VisitMethod does nothing.
+func (BaseVisitor) VisitMethod(node *Method, path []string) error { return nil }
+
+// VisitMethods does nothing.
+func (BaseVisitor) VisitMethods(node *Methods, path []string) error { return nil }
+
+// VisitOauth2 does nothing.
+func (BaseVisitor) VisitOauth2(node *Oauth2, path []string) error { return nil }
+
+// VisitParameter does nothing.
+func (BaseVisitor) VisitParameter(node *Parameter, path []string) error { return nil }
+
+// VisitParameters does nothing.
+func (BaseVisitor) VisitParameters(node *Parameters, path []string) error { return nil }
+
+// VisitProtocols does nothing.
+func (BaseVisitor) VisitProtocols(node *Protocols, path []string) error { return nil }
+
+// VisitRequest does nothing.
+func (BaseVisitor) VisitRequest(node *Request, path []string) error { return nil }
+
+// VisitResource does nothing.
+func (BaseVisitor) VisitResource(node *Resource, path []string) error { return nil }
+
+// VisitResources does nothing.
+func (BaseVisitor) VisitResources(node *Resources, path []string) error { return nil }
+
+// VisitResponse does nothing.
+func (BaseVisitor) VisitResponse(node *Response, path []string) error { return nil }
+
+// VisitResumable does nothing.
+func (BaseVisitor) VisitResumable(node *Resumable, path []string) error { return nil }
+
+// VisitSchema does nothing.
+func (BaseVisitor) VisitSchema(node *Schema, path []string) error { return nil }
+
+// VisitSchemas does nothing.
+func (BaseVisitor) VisitSchemas(node *Schemas, path []string) error { return nil }
+
+// VisitScope does nothing.
+func (BaseVisitor) VisitScope(node *Scope, path []string) error { return nil }
+
+// VisitScopes does nothing.
+func (BaseVisitor) VisitScopes(node *Scopes, path []string) error { return nil }
+
+// VisitSimple does nothing.
+func (BaseVisitor) VisitSimple(node *Simple, path []string) error { return nil }
+
+// VisitStringArray does nothing.
+func (BaseVisitor) VisitStringArray(node *StringArray, path []string) error { return nil }
+
+// Walk calls the methods of a visitor for a Document and for each node below it, in document order.
+// Nodes that wrap alternative types are visited before the node that they contain, at the same path.
+func Walk(document *Document, visitor Visitor) error {
+	return document.walk(visitor, []string{})
+}
+
+// keyPath returns a copy of path with keys appended.
+func keyPath(path []string, keys ...string) []string {
+	result := make([]string, 0, len(path)+len(keys))
+	result = append(result, path...)
+	return append(result, keys...)
+}
+
+// walk visits a Annotations and the nodes below it.
+func (m *Annotations) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAnnotations(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Any and the nodes below it.
+func (m *Any) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAny(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Auth and the nodes below it.
+func (m *Auth) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitAuth(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Oauth2.walk(visitor, keyPath(path, "oauth2")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Document and the nodes below it.
+func (m *Document) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitDocument(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Icons.walk(visitor, keyPath(path, "icons")); err != nil {
+		return err
+	}
+	if err := m.Parameters.walk(visitor, keyPath(path, "parameters")); err != nil {
+		return err
+	}
+	if err := m.Auth.walk(visitor, keyPath(path, "auth")); err != nil {
+		return err
+	}
+	if err := m.Schemas.walk(visitor, keyPath(path, "schemas")); err != nil {
+		return err
+	}
+	if err := m.Methods.walk(visitor, keyPath(path, "methods")); err != nil {
+		return err
+	}
+	if err := m.Resources.walk(visitor, keyPath(path, "resources")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Icons and the nodes below it.
+func (m *Icons) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitIcons(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a MediaUpload and the nodes below it.
+func (m *MediaUpload) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitMediaUpload(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Protocols.walk(visitor, keyPath(path, "protocols")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Method and the nodes below it.
+func (m *Method) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitMethod(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Parameters.walk(visitor, keyPath(path, "parameters")); err != nil {
+		return err
+	}
+	if err := m.Request.walk(visitor, keyPath(path, "request")); err != nil {
+		return err
+	}
+	if err := m.Response.walk(visitor, keyPath(path, "response")); err != nil {
+		return err
+	}
+	if err := m.MediaUpload.walk(visitor, keyPath(path, "mediaUpload")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Methods and the nodes below it.
+func (m *Methods) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitMethods(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Oauth2 and the nodes below it.
+func (m *Oauth2) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitOauth2(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Scopes.walk(visitor, keyPath(path, "scopes")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Parameter and the nodes below it.
+func (m *Parameter) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParameter(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Properties.walk(visitor, keyPath(path, "properties")); err != nil {
+		return err
+	}
+	if err := m.AdditionalProperties.walk(visitor, keyPath(path, "additionalProperties")); err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Annotations.walk(visitor, keyPath(path, "annotations")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Parameters and the nodes below it.
+func (m *Parameters) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitParameters(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Protocols and the nodes below it.
+func (m *Protocols) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitProtocols(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Simple.walk(visitor, keyPath(path, "simple")); err != nil {
+		return err
+	}
+	if err := m.Resumable.walk(visitor, keyPath(path, "resumable")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Request and the nodes below it.
+func (m *Request) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitRequest(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Resource and the nodes below it.
+func (m *Resource) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResource(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Methods.walk(visitor, keyPath(path, "methods")); err != nil {
+		return err
+	}
+	if err := m.Resources.walk(visitor, keyPath(path, "resources")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Resources and the nodes below it.
+func (m *Resources) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResources(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Response and the nodes below it.
+func (m *Response) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResponse(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Resumable and the nodes below it.
+func (m *Resumable) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitResumable(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Schema and the nodes below it.
+func (m *Schema) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchema(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := m.Properties.walk(visitor, keyPath(path, "properties")); err != nil {
+		return err
+	}
+	if err := m.AdditionalProperties.walk(visitor, keyPath(path, "additionalProperties")); err != nil {
+		return err
+	}
+	if err := m.Items.walk(visitor, keyPath(path, "items")); err != nil {
+		return err
+	}
+	if err := m.Annotations.walk(visitor, keyPath(path, "annotations")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Schemas and the nodes below it.
+func (m *Schemas) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSchemas(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Scope and the nodes below it.
+func (m *Scope) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitScope(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a Scopes and the nodes below it.
+func (m *Scopes) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitScopes(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, item := range m.AdditionalProperties {
+		if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk visits a Simple and the nodes below it.
+func (m *Simple) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitSimple(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// walk visits a StringArray and the nodes below it.
+func (m *StringArray) walk(visitor Visitor, path []string) error {
+	if m == nil {
+		return nil
+	}
+	if err := visitor.VisitStringArray(m, path); err == SkipChildren {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Equal returns true if two Annotations objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Annotations) Equal(other *Annotations) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Required) != len(other.Required) {
+		return false
+	}
+	for i := range m.Required {
+		if m.Required[i] != other.Required[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Annotations.
+func (m *Annotations) Clone() *Annotations {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Annotations)
+}
+
+// Merge overlays the contents of another Annotations onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Annotations) Merge(other *Annotations) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Required) != 0 {
+		m.Required = append([]string(nil), other.Required...)
+	}
+}
+
+// Equal returns true if two Any objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Any) Equal(other *Any) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return proto.Equal(m, other)
+}
+
+// Clone returns a deep copy of a Any.
+func (m *Any) Clone() *Any {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Any)
+}
+
+// Merge overlays the contents of another Any onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Any) Merge(other *Any) {
+	if m == nil || other == nil {
+		return
+	}
+	*m = *other.Clone()
+}
+
+// Equal returns true if two Auth objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Auth) Equal(other *Auth) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Oauth2.Equal(other.Oauth2) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Auth.
+func (m *Auth) Clone() *Auth {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Auth)
+}
+
+// Merge overlays the contents of another Auth onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Auth) Merge(other *Auth) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Oauth2 != nil {
+		if m.Oauth2 == nil {
+			m.Oauth2 = other.Oauth2.Clone()
+		} else {
+			m.Oauth2.Merge(other.Oauth2)
+		}
+	}
+}
+
+// Equal returns true if two Document objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Document) Equal(other *Document) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Kind != other.Kind {
+		return false
+	}
+	if m.DiscoveryVersion != other.DiscoveryVersion {
+		return false
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Version != other.Version {
+		return false
+	}
+	if m.Revision != other.Revision {
+		return false
+	}
+	if m.Title != other.Title {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Icons.Equal(other.Icons) {
+		return false
+	}
+	if m.DocumentationLink != other.DocumentationLink {
+		return false
+	}
+	if len(m.Labels) != len(other.Labels) {
+		return false
+	}
+	for i := range m.Labels {
+		if m.Labels[i] != other.Labels[i] {
+			return false
+		}
+	}
+	if m.Protocol != other.Protocol {
+		return false
+	}
+	if m.BaseUrl != other.BaseUrl {
+		return false
+	}
+	if m.BasePath != other.BasePath {
+		return false
+	}
+	if m.RootUrl != other.RootUrl {
+		return false
+	}
+	if m.ServicePath != other.ServicePath {
+		return false
+	}
+	if m.BatchPath != other.BatchPath {
+		return false
+	}
+	if !m.Parameters.Equal(other.Parameters) {
+		return false
+	}
+	if !m.Auth.Equal(other.Auth) {
+		return false
+	}
+	if len(m.Features) != len(other.Features) {
+		return false
+	}
+	for i := range m.Features {
+		if m.Features[i] != other.Features[i] {
+			return false
+		}
+	}
+	if !m.Schemas.Equal(other.Schemas) {
+		return false
+	}
+	if !m.Methods.Equal(other.Methods) {
+		return false
+	}
+	if !m.Resources.Equal(other.Resources) {
+		return false
+	}
+	if m.Etag != other.Etag {
+		return false
+	}
+	if m.OwnerDomain != other.OwnerDomain {
+		return false
+	}
+	if m.OwnerName != other.OwnerName {
+		return false
+	}
+	if m.VersionModule != other.VersionModule {
+		return false
+	}
+	if m.CanonicalName != other.CanonicalName {
+		return false
+	}
+	if m.FullyEncodeReservedExpansion != other.FullyEncodeReservedExpansion {
+		return false
+	}
+	if m.PackagePath != other.PackagePath {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Document.
+func (m *Document) Clone() *Document {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Document)
+}
+
+// Merge overlays the contents of another Document onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Document) Merge(other *Document) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Kind != "" {
+		m.Kind = other.Kind
+	}
+	if other.DiscoveryVersion != "" {
+		m.DiscoveryVersion = other.DiscoveryVersion
+	}
+	if other.Id != "" {
+		m.Id = other.Id
+	}
+	if other.Name != "" {
+		m.Name = other.Name
+	}
+	if other.Version != "" {
+		m.Version = other.Version
+	}
+	if other.Revision != "" {
+		m.Revision = other.Revision
+	}
+	if other.Title != "" {
+		m.Title = other.Title
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Icons != nil {
+		if m.Icons == nil {
+			m.Icons = other.Icons.Clone()
+		} else {
+			m.Icons.Merge(other.Icons)
+		}
+	}
+	if other.DocumentationLink != "" {
+		m.DocumentationLink = other.DocumentationLink
+	}
+	if len(other.Labels) != 0 {
+		m.Labels = append([]string(nil), other.Labels...)
+	}
+	if other.Protocol != "" {
+		m.Protocol = other.Protocol
+	}
+	if other.BaseUrl != "" {
+		m.BaseUrl = other.BaseUrl
+	}
+	if other.BasePath != "" {
+		m.BasePath = other.BasePath
+	}
+	if other.RootUrl != "" {
+		m.RootUrl = other.RootUrl
+	}
+	if other.ServicePath != "" {
+		m.ServicePath = other.ServicePath
+	}
+	if other.BatchPath != "" {
+		m.BatchPath = other.BatchPath
+	}
+	if other.Parameters != nil {
+		if m.Parameters == nil {
+			m.Parameters = other.Parameters.Clone()
+		} else {
+			m.Parameters.Merge(other.Parameters)
+		}
+	}
+	if other.Auth != nil {
+		if m.Auth == nil {
+			m.Auth = other.Auth.Clone()
+		} else {
+			m.Auth.Merge(other.Auth)
+		}
+	}
+	if len(other.Features) != 0 {
+		m.Features = append([]string(nil), other.Features...)
+	}
+	if other.Schemas != nil {
+		if m.Schemas == nil {
+			m.Schemas = other.Schemas.Clone()
+		} else {
+			m.Schemas.Merge(other.Schemas)
+		}
+	}
+	if other.Methods != nil {
+		if m.Methods == nil {
+			m.Methods = other.Methods.Clone()
+		} else {
+			m.Methods.Merge(other.Methods)
+		}
+	}
+	if other.Resources != nil {
+		if m.Resources == nil {
+			m.Resources = other.Resources.Clone()
+		} else {
+			m.Resources.Merge(other.Resources)
+		}
+	}
+	if other.Etag != "" {
+		m.Etag = other.Etag
+	}
+	if other.OwnerDomain != "" {
+		m.OwnerDomain = other.OwnerDomain
+	}
+	if other.OwnerName != "" {
+		m.OwnerName = other.OwnerName
+	}
+	if other.VersionModule {
+		m.VersionModule = true
+	}
+	if other.CanonicalName != "" {
+		m.CanonicalName = other.CanonicalName
+	}
+	if other.FullyEncodeReservedExpansion {
+		m.FullyEncodeReservedExpansion = true
+	}
+	if other.PackagePath != "" {
+		m.PackagePath = other.PackagePath
+	}
+}
+
+// Equal returns true if two Icons objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Icons) Equal(other *Icons) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.X16 != other.X16 {
+		return false
+	}
+	if m.X32 != other.X32 {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Icons.
+func (m *Icons) Clone() *Icons {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Icons)
+}
+
+// Merge overlays the contents of another Icons onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Icons) Merge(other *Icons) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.X16 != "" {
+		m.X16 = other.X16
+	}
+	if other.X32 != "" {
+		m.X32 = other.X32
+	}
+}
+
+// Equal returns true if two MediaUpload objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *MediaUpload) Equal(other *MediaUpload) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Accept) != len(other.Accept) {
+		return false
+	}
+	for i := range m.Accept {
+		if m.Accept[i] != other.Accept[i] {
+			return false
+		}
+	}
+	if m.MaxSize != other.MaxSize {
+		return false
+	}
+	if !m.Protocols.Equal(other.Protocols) {
+		return false
+	}
+	if m.SupportsSubscription != other.SupportsSubscription {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a MediaUpload.
+func (m *MediaUpload) Clone() *MediaUpload {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*MediaUpload)
+}
+
+// Merge overlays the contents of another MediaUpload onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *MediaUpload) Merge(other *MediaUpload) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Accept) != 0 {
+		m.Accept = append([]string(nil), other.Accept...)
+	}
+	if other.MaxSize != "" {
+		m.MaxSize = other.MaxSize
+	}
+	if other.Protocols != nil {
+		if m.Protocols == nil {
+			m.Protocols = other.Protocols.Clone()
+		} else {
+			m.Protocols.Merge(other.Protocols)
+		}
+	}
+	if other.SupportsSubscription {
+		m.SupportsSubscription = true
+	}
+}
+
+// Equal returns true if two Method objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Method) Equal(other *Method) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Path != other.Path {
+		return false
+	}
+	if m.HttpMethod != other.HttpMethod {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if !m.Parameters.Equal(other.Parameters) {
+		return false
+	}
+	if len(m.ParameterOrder) != len(other.ParameterOrder) {
+		return false
+	}
+	for i := range m.ParameterOrder {
+		if m.ParameterOrder[i] != other.ParameterOrder[i] {
+			return false
+		}
+	}
+	if !m.Request.Equal(other.Request) {
+		return false
+	}
+	if !m.Response.Equal(other.Response) {
+		return false
+	}
+	if len(m.Scopes) != len(other.Scopes) {
+		return false
+	}
+	for i := range m.Scopes {
+		if m.Scopes[i] != other.Scopes[i] {
+			return false
+		}
+	}
+	if m.SupportsMediaDownload != other.SupportsMediaDownload {
+		return false
+	}
+	if m.SupportsMediaUpload != other.SupportsMediaUpload {
+		return false
+	}
+	if m.UseMediaDownloadService != other.UseMediaDownloadService {
+		return false
+	}
+	if !m.MediaUpload.Equal(other.MediaUpload) {
+		return false
+	}
+	if m.SupportsSubscription != other.SupportsSubscription {
+		return false
+	}
+	if m.FlatPath != other.FlatPath {
+		return false
+	}
+	if m.EtagRequired != other.EtagRequired {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Method.
+func (m *Method) Clone() *Method {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Method)
+}
+
+// Merge overlays the contents of another Method onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Method) Merge(other *Method) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Id != "" {
+		m.Id = other.Id
+	}
+	if other.Path != "" {
+		m.Path = other.Path
+	}
+	if other.HttpMethod != "" {
+		m.HttpMethod = other.HttpMethod
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Parameters != nil {
+		if m.Parameters == nil {
+			m.Parameters = other.Parameters.Clone()
+		} else {
+			m.Parameters.Merge(other.Parameters)
+		}
+	}
+	if len(other.ParameterOrder) != 0 {
+		m.ParameterOrder = append([]string(nil), other.ParameterOrder...)
+	}
+	if other.Request != nil {
+		if m.Request == nil {
+			m.Request = other.Request.Clone()
+		} else {
+			m.Request.Merge(other.Request)
+		}
+	}
+	if other.Response != nil {
+		if m.Response == nil {
+			m.Response = other.Response.Clone()
+		} else {
+			m.Response.Merge(other.Response)
+		}
+	}
+	if len(other.Scopes) != 0 {
+		m.Scopes = append([]string(nil), other.Scopes...)
+	}
+	if other.SupportsMediaDownload {
+		m.SupportsMediaDownload = true
+	}
+	if other.SupportsMediaUpload {
+		m.SupportsMediaUpload = true
+	}
+	if other.UseMediaDownloadService {
+		m.UseMediaDownloadService = true
+	}
+	if other.MediaUpload != nil {
+		if m.MediaUpload == nil {
+			m.MediaUpload = other.MediaUpload.Clone()
+		} else {
+			m.MediaUpload.Merge(other.MediaUpload)
+		}
+	}
+	if other.SupportsSubscription {
+		m.SupportsSubscription = true
+	}
+	if other.FlatPath != "" {
+		m.FlatPath = other.FlatPath
+	}
+	if other.EtagRequired {
+		m.EtagRequired = true
+	}
+}
+
+// Equal returns true if two Methods objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Methods) Equal(other *Methods) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Methods.
+func (m *Methods) Clone() *Methods {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Methods)
+}
+
+// Merge overlays the contents of another Methods onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Methods) Merge(other *Methods) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedMethod))
+		}
+	}
+}
+
+// Equal returns true if two Oauth2 objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Oauth2) Equal(other *Oauth2) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Scopes.Equal(other.Scopes) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Oauth2.
+func (m *Oauth2) Clone() *Oauth2 {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Oauth2)
+}
+
+// Merge overlays the contents of another Oauth2 onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Oauth2) Merge(other *Oauth2) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Scopes != nil {
+		if m.Scopes == nil {
+			m.Scopes = other.Scopes.Clone()
+		} else {
+			m.Scopes.Merge(other.Scopes)
+		}
+	}
+}
+
+// Equal returns true if two Parameter objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Parameter) Equal(other *Parameter) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Default != other.Default {
+		return false
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if m.Enum[i] != other.Enum[i] {
+			return false
+		}
+	}
+	if len(m.EnumDescriptions) != len(other.EnumDescriptions) {
+		return false
+	}
+	for i := range m.EnumDescriptions {
+		if m.EnumDescriptions[i] != other.EnumDescriptions[i] {
+			return false
+		}
+	}
+	if m.Repeated != other.Repeated {
+		return false
+	}
+	if m.Location != other.Location {
+		return false
+	}
+	if !m.Properties.Equal(other.Properties) {
+		return false
+	}
+	if !m.AdditionalProperties.Equal(other.AdditionalProperties) {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if !m.Annotations.Equal(other.Annotations) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Parameter.
+func (m *Parameter) Clone() *Parameter {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Parameter)
+}
+
+// Merge overlays the contents of another Parameter onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Parameter) Merge(other *Parameter) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Id != "" {
+		m.Id = other.Id
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Default != "" {
+		m.Default = other.Default
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.Minimum != "" {
+		m.Minimum = other.Minimum
+	}
+	if other.Maximum != "" {
+		m.Maximum = other.Maximum
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = append([]string(nil), other.Enum...)
+	}
+	if len(other.EnumDescriptions) != 0 {
+		m.EnumDescriptions = append([]string(nil), other.EnumDescriptions...)
+	}
+	if other.Repeated {
+		m.Repeated = true
+	}
+	if other.Location != "" {
+		m.Location = other.Location
+	}
+	if other.Properties != nil {
+		if m.Properties == nil {
+			m.Properties = other.Properties.Clone()
+		} else {
+			m.Properties.Merge(other.Properties)
+		}
+	}
+	if other.AdditionalProperties != nil {
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = other.AdditionalProperties.Clone()
+		} else {
+			m.AdditionalProperties.Merge(other.AdditionalProperties)
+		}
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.Annotations != nil {
+		if m.Annotations == nil {
+			m.Annotations = other.Annotations.Clone()
+		} else {
+			m.Annotations.Merge(other.Annotations)
+		}
+	}
+}
+
+// Equal returns true if two Parameters objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Parameters) Equal(other *Parameters) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Parameters.
+func (m *Parameters) Clone() *Parameters {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Parameters)
+}
+
+// Merge overlays the contents of another Parameters onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Parameters) Merge(other *Parameters) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedParameter))
+		}
+	}
+}
+
+// Equal returns true if two Protocols objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Protocols) Equal(other *Protocols) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Simple.Equal(other.Simple) {
+		return false
+	}
+	if !m.Resumable.Equal(other.Resumable) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Protocols.
+func (m *Protocols) Clone() *Protocols {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Protocols)
+}
+
+// Merge overlays the contents of another Protocols onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Protocols) Merge(other *Protocols) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Simple != nil {
+		if m.Simple == nil {
+			m.Simple = other.Simple.Clone()
+		} else {
+			m.Simple.Merge(other.Simple)
+		}
+	}
+	if other.Resumable != nil {
+		if m.Resumable == nil {
+			m.Resumable = other.Resumable.Clone()
+		} else {
+			m.Resumable.Merge(other.Resumable)
+		}
+	}
+}
+
+// Equal returns true if two Request objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Request) Equal(other *Request) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if m.ParameterName != other.ParameterName {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Request.
+func (m *Request) Clone() *Request {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Request)
+}
+
+// Merge overlays the contents of another Request onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Request) Merge(other *Request) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.ParameterName != "" {
+		m.ParameterName = other.ParameterName
+	}
+}
+
+// Equal returns true if two Resource objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Resource) Equal(other *Resource) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if !m.Methods.Equal(other.Methods) {
+		return false
+	}
+	if !m.Resources.Equal(other.Resources) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Resource.
+func (m *Resource) Clone() *Resource {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Resource)
+}
+
+// Merge overlays the contents of another Resource onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Resource) Merge(other *Resource) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Methods != nil {
+		if m.Methods == nil {
+			m.Methods = other.Methods.Clone()
+		} else {
+			m.Methods.Merge(other.Methods)
+		}
+	}
+	if other.Resources != nil {
+		if m.Resources == nil {
+			m.Resources = other.Resources.Clone()
+		} else {
+			m.Resources.Merge(other.Resources)
+		}
+	}
+}
+
+// Equal returns true if two Resources objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Resources) Equal(other *Resources) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Resources.
+func (m *Resources) Clone() *Resources {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Resources)
+}
+
+// Merge overlays the contents of another Resources onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Resources) Merge(other *Resources) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedResource))
+		}
+	}
+}
+
+// Equal returns true if two Response objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Response) Equal(other *Response) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Response.
+func (m *Response) Clone() *Response {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Response)
+}
+
+// Merge overlays the contents of another Response onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Response) Merge(other *Response) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+}
+
+// Equal returns true if two Resumable objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Resumable) Equal(other *Resumable) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Multipart != other.Multipart {
+		return false
+	}
+	if m.Path != other.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Resumable.
+func (m *Resumable) Clone() *Resumable {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Resumable)
+}
+
+// Merge overlays the contents of another Resumable onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Resumable) Merge(other *Resumable) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Multipart {
+		m.Multipart = true
+	}
+	if other.Path != "" {
+		m.Path = other.Path
+	}
+}
+
+// Equal returns true if two Schema objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Schema) Equal(other *Schema) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Type != other.Type {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.Default != other.Default {
+		return false
+	}
+	if m.Required != other.Required {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if m.Pattern != other.Pattern {
+		return false
+	}
+	if m.Minimum != other.Minimum {
+		return false
+	}
+	if m.Maximum != other.Maximum {
+		return false
+	}
+	if len(m.Enum) != len(other.Enum) {
+		return false
+	}
+	for i := range m.Enum {
+		if m.Enum[i] != other.Enum[i] {
+			return false
+		}
+	}
+	if len(m.EnumDescriptions) != len(other.EnumDescriptions) {
+		return false
+	}
+	for i := range m.EnumDescriptions {
+		if m.EnumDescriptions[i] != other.EnumDescriptions[i] {
+			return false
+		}
+	}
+	if m.Repeated != other.Repeated {
+		return false
+	}
+	if m.Location != other.Location {
+		return false
+	}
+	if !m.Properties.Equal(other.Properties) {
+		return false
+	}
+	if !m.AdditionalProperties.Equal(other.AdditionalProperties) {
+		return false
+	}
+	if !m.Items.Equal(other.Items) {
+		return false
+	}
+	if m.XRef != other.XRef {
+		return false
+	}
+	if !m.Annotations.Equal(other.Annotations) {
+		return false
+	}
+	if m.ReadOnly != other.ReadOnly {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Schema.
+func (m *Schema) Clone() *Schema {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Schema)
+}
+
+// Merge overlays the contents of another Schema onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Schema) Merge(other *Schema) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Id != "" {
+		m.Id = other.Id
+	}
+	if other.Type != "" {
+		m.Type = other.Type
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+	if other.Default != "" {
+		m.Default = other.Default
+	}
+	if other.Required {
+		m.Required = true
+	}
+	if other.Format != "" {
+		m.Format = other.Format
+	}
+	if other.Pattern != "" {
+		m.Pattern = other.Pattern
+	}
+	if other.Minimum != "" {
+		m.Minimum = other.Minimum
+	}
+	if other.Maximum != "" {
+		m.Maximum = other.Maximum
+	}
+	if len(other.Enum) != 0 {
+		m.Enum = append([]string(nil), other.Enum...)
+	}
+	if len(other.EnumDescriptions) != 0 {
+		m.EnumDescriptions = append([]string(nil), other.EnumDescriptions...)
+	}
+	if other.Repeated {
+		m.Repeated = true
+	}
+	if other.Location != "" {
+		m.Location = other.Location
+	}
+	if other.Properties != nil {
+		if m.Properties == nil {
+			m.Properties = other.Properties.Clone()
+		} else {
+			m.Properties.Merge(other.Properties)
+		}
+	}
+	if other.AdditionalProperties != nil {
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = other.AdditionalProperties.Clone()
+		} else {
+			m.AdditionalProperties.Merge(other.AdditionalProperties)
+		}
+	}
+	if other.Items != nil {
+		if m.Items == nil {
+			m.Items = other.Items.Clone()
+		} else {
+			m.Items.Merge(other.Items)
+		}
+	}
+	if other.XRef != "" {
+		m.XRef = other.XRef
+	}
+	if other.Annotations != nil {
+		if m.Annotations == nil {
+			m.Annotations = other.Annotations.Clone()
+		} else {
+			m.Annotations.Merge(other.Annotations)
+		}
+	}
+	if other.ReadOnly {
+		m.ReadOnly = true
+	}
+}
+
+// Equal returns true if two Schemas objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Schemas) Equal(other *Schemas) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Schemas.
+func (m *Schemas) Clone() *Schemas {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Schemas)
+}
+
+// Merge overlays the contents of another Schemas onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Schemas) Merge(other *Schemas) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedSchema))
+		}
+	}
+}
+
+// Equal returns true if two Scope objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Scope) Equal(other *Scope) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Scope.
+func (m *Scope) Clone() *Scope {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Scope)
+}
+
+// Merge overlays the contents of another Scope onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Scope) Merge(other *Scope) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Description != "" {
+		m.Description = other.Description
+	}
+}
+
+// Equal returns true if two Scopes objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Scopes) Equal(other *Scopes) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	matchedAdditionalProperties := make([]bool, len(other.AdditionalProperties))
+	for _, item := range m.AdditionalProperties {
+		found := false
+		for j, otherItem := range other.AdditionalProperties {
+			if !matchedAdditionalProperties[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {
+				matchedAdditionalProperties[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Scopes.
+func (m *Scopes) Clone() *Scopes {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Scopes)
+}
+
+// Merge overlays the contents of another Scopes onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Scopes) Merge(other *Scopes) {
+	if m == nil || other == nil {
+		return
+	}
+	for _, item := range other.AdditionalProperties {
+		found := false
+		for _, existing := range m.AdditionalProperties {
+			if existing.Name == item.Name {
+				if existing.Value == nil {
+					existing.Value = item.Value.Clone()
+				} else {
+					existing.Value.Merge(item.Value)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.AdditionalProperties = append(m.AdditionalProperties, proto.Clone(item).(*NamedScope))
+		}
+	}
+}
+
+// Equal returns true if two Simple objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *Simple) Equal(other *Simple) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Multipart != other.Multipart {
+		return false
+	}
+	if m.Path != other.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of a Simple.
+func (m *Simple) Clone() *Simple {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*Simple)
+}
+
+// Merge overlays the contents of another Simple onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *Simple) Merge(other *Simple) {
+	if m == nil || other == nil {
+		return
+	}
+	if other.Multipart {
+		m.Multipart = true
+	}
+	if other.Path != "" {
+		m.Path = other.Path
+	}
+}
+
+// Equal returns true if two StringArray objects have the same contents.
+// Named values are compared by name, so their order is ignored.
+func (m *StringArray) Equal(other *StringArray) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if len(m.Value) != len(other.Value) {
+		return false
+	}
+	for i := range m.Value {
+		if m.Value[i] != other.Value[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of a StringArray.
+func (m *StringArray) Clone() *StringArray {
+	if m == nil {
+		return nil
+	}
+	return proto.Clone(m).(*StringArray)
+}
+
+// Merge overlays the contents of another StringArray onto this one.
+// Named values are merged by name, and values that are not named replace existing ones.
+// Empty values in other are ignored.
+func (m *StringArray) Merge(other *StringArray) {
+	if m == nil || other == nil {
+		return
+	}
+	if len(other.Value) != 0 {
+		m.Value = append([]string(nil), other.Value...)
+	}
+}
+
+// Get returns the value in a Methods with the specified name, or nil if there is none.
+func (m *Methods) Get(name string) *Method {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Methods with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Methods) Set(name string, value *Method) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedMethod{Name: name, Value: value})
+}
+
+// Delete removes the value in a Methods with the specified name.
+func (m *Methods) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Methods in the order that they appear.
+func (m *Methods) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Parameters with the specified name, or nil if there is none.
+func (m *Parameters) Get(name string) *Parameter {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Parameters with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Parameters) Set(name string, value *Parameter) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedParameter{Name: name, Value: value})
+}
+
+// Delete removes the value in a Parameters with the specified name.
+func (m *Parameters) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Parameters in the order that they appear.
+func (m *Parameters) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Resources with the specified name, or nil if there is none.
+func (m *Resources) Get(name string) *Resource {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Resources with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Resources) Set(name string, value *Resource) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedResource{Name: name, Value: value})
+}
+
+// Delete removes the value in a Resources with the specified name.
+func (m *Resources) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Resources in the order that they appear.
+func (m *Resources) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Schemas with the specified name, or nil if there is none.
+func (m *Schemas) Get(name string) *Schema {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Schemas with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Schemas) Set(name string, value *Schema) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedSchema{Name: name, Value: value})
+}
+
+// Delete removes the value in a Schemas with the specified name.
+func (m *Schemas) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Schemas in the order that they appear.
+func (m *Schemas) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// Get returns the value in a Scopes with the specified name, or nil if there is none.
+func (m *Scopes) Get(name string) *Scope {
+	for _, item := range m.GetAdditionalProperties() {
+		if item.Name == name {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Set replaces the value in a Scopes with the specified name,
+// or adds it after the existing values if there is none.
+func (m *Scopes) Set(name string, value *Scope) {
+	for _, item := range m.AdditionalProperties {
+		if item.Name == name {
+			item.Value = value
+			return
+		}
+	}
+	m.AdditionalProperties = append(m.AdditionalProperties, &NamedScope{Name: name, Value: value})
+}
+
+// Delete removes the value in a Scopes with the specified name.
+func (m *Scopes) Delete(name string) {
+	for i, item := range m.AdditionalProperties {
+		if item.Name == name {
+			m.AdditionalProperties = append(m.AdditionalProperties[:i], m.AdditionalProperties[i+1:]...)
+			return
+		}
+	}
+}
+
+// Names returns the names of the values in a Scopes in the order that they appear.
+func (m *Scopes) Names() []string {
+	names := make([]string, 0, len(m.GetAdditionalProperties()))
+	for _, item := range m.GetAdditionalProperties() {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
 var ()
@@ -271,6 +271,16 @@ func (domain *Domain) buildPatternPropertyAccessors(typeModel *TypeModel, schema
 				property.Repeated = true
 				domain.MapTypeRequests[property.MapType] = property.MapType
 				typeModel.addProperty(property)
+			} else if propertySchema.TypeIs("array") && propertySchema.Items != nil &&
+				propertySchema.Items.Schema != nil && propertySchema.Items.Schema.TypeIs("string") {
+				// values that are arrays of strings are held in named string arrays,
+				// as they are when they are specified with additionalProperties
+				property := NewTypePropertyWithNameTypeAndPattern("additionalProperties", "NamedStringArray", propertyPattern)
+				property.Implicit = true
+				property.MapType = "StringArray"
+				property.Repeated = true
+				domain.MapTypeRequests[property.MapType] = property.MapType
+				typeModel.addProperty(property)
 			}
 		}
 	}
@@ -540,9 +550,25 @@ func (domain *Domain) Build() (err error) {
 		}
 	}
 	// iterate over anonymous object types to be instantiated and generate a type for each
-	for typeName, typeRequest := range domain.ObjectTypeRequests {
-		domain.TypeModels[typeRequest.Name] =
-			domain.buildTypeForDefinitionObject(typeName, typeRequest.PropertyName, typeRequest.Schema)
+	// building these types can request more types, so repeat until every request is handled
+	builtTypeRequests := make(map[string]bool, 0)
+	for {
+		typeRequestNames := make([]string, 0)
+		for typeName := range domain.ObjectTypeRequests {
+			if !builtTypeRequests[typeName] {
+				typeRequestNames = append(typeRequestNames, typeName)
+			}
+		}
+		if len(typeRequestNames) == 0 {
+			break
+		}
+		sort.Strings(typeRequestNames)
+		for _, typeName := range typeRequestNames {
+			typeRequest := domain.ObjectTypeRequests[typeName]
+			domain.TypeModels[typeRequest.Name] =
+				domain.buildTypeForDefinitionObject(typeName, typeRequest.PropertyName, typeRequest.Schema)
+			builtTypeRequests[typeName] = true
+		}
 	}
 
 	// iterate over map item types to be instantiated and generate a type for each
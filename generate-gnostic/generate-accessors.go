@@ -0,0 +1,115 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+
+	"github.com/googleapis/gnostic/printer"
+)
+
+// goZeroValues maps the Go types of scalar values to their zero values.
+var goZeroValues = map[string]string{
+	"string":  "\"\"",
+	"bool":    "false",
+	"int64":   "0",
+	"float64": "0",
+}
+
+// collectionProperties returns the properties that hold the named values of a type.
+// Extensions are not included because every extensible type has them.
+func (domain *Domain) collectionProperties(typeModel *TypeModel) []*TypeProperty {
+	result := make([]*TypeProperty, 0)
+	for _, propertyModel := range typeModel.Properties {
+		if !propertyModel.Repeated || propertyModel.MapType == "" || propertyModel.Pattern == "^x-" {
+			continue
+		}
+		result = append(result, propertyModel)
+	}
+	return result
+}
+
+// generateCollectionAccessors generates Get(), Set(), Delete() and Names() methods
+// for the types that hold named values. Types that hold more than one set of named values
+// get no accessors, because a name wouldn't identify the set that it belongs to.
+func (domain *Domain) generateCollectionAccessors(code *printer.Code) {
+	if _, ok := domain.TypeModels[domain.Prefix+"Document"]; !ok {
+		return
+	}
+	for _, typeName := range domain.visitedTypeNames() {
+		typeModel := domain.TypeModels[typeName]
+		if typeModel.OneOfWrapper {
+			continue
+		}
+		propertyModels := domain.collectionProperties(typeModel)
+		if len(propertyModels) == 1 {
+			domain.generateCollectionAccessorsForType(code, typeName, propertyModels[0])
+		} else if len(propertyModels) > 1 {
+			log.Printf("Skipping accessors for %s, which holds more than one set of named values", typeName)
+		}
+	}
+}
+
+func (domain *Domain) generateCollectionAccessorsForType(code *printer.Code, typeName string, propertyModel *TypeProperty) {
+	fieldName := propertyModel.FieldName()
+	pairTypeName := propertyModel.Type
+	valueTypeName := goScalarTypes[propertyModel.MapType]
+	zeroValue := goZeroValues[valueTypeName]
+	if valueTypeName == "" {
+		valueTypeName = "*" + propertyModel.MapType
+		zeroValue = "nil"
+	}
+
+	code.Print("// Get returns the value in a %s with the specified name, or %s if there is none.", typeName, zeroValue)
+	code.Print("func (m *%s) Get(name string) %s {", typeName, valueTypeName)
+	code.Print("for _, item := range m.Get%s() {", fieldName)
+	code.Print("if item.Name == name {")
+	code.Print("  return item.Value")
+	code.Print("}")
+	code.Print("}")
+	code.Print("return %s", zeroValue)
+	code.Print("}\n")
+
+	code.Print("// Set replaces the value in a %s with the specified name,", typeName)
+	code.Print("// or adds it after the existing values if there is none.")
+	code.Print("func (m *%s) Set(name string, value %s) {", typeName, valueTypeName)
+	code.Print("for _, item := range m.%s {", fieldName)
+	code.Print("if item.Name == name {")
+	code.Print("  item.Value = value")
+	code.Print("  return")
+	code.Print("}")
+	code.Print("}")
+	code.Print("m.%s = append(m.%s, &%s{Name: name, Value: value})", fieldName, fieldName, pairTypeName)
+	code.Print("}\n")
+
+	code.Print("// Delete removes the value in a %s with the specified name.", typeName)
+	code.Print("func (m *%s) Delete(name string) {", typeName)
+	code.Print("for i, item := range m.%s {", fieldName)
+	code.Print("if item.Name == name {")
+	code.Print("  m.%s = append(m.%s[:i], m.%s[i+1:]...)", fieldName, fieldName, fieldName)
+	code.Print("  return")
+	code.Print("}")
+	code.Print("}")
+	code.Print("}\n")
+
+	code.Print("// Names returns the names of the values in a %s in the order that they appear.", typeName)
+	code.Print("func (m *%s) Names() []string {", typeName)
+	code.Print("names := make([]string, 0, len(m.Get%s()))", fieldName)
+	code.Print("for _, item := range m.Get%s() {", fieldName)
+	code.Print("  names = append(names, item.Name)")
+	code.Print("}")
+	code.Print("return names")
+	code.Print("}\n")
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/googleapis/gnostic/printer"
+)
+
+func TestCollectionAccessorZeroValues(t *testing.T) {
+	for _, test := range []struct {
+		mapType   string
+		valueType string
+		zeroValue string
+	}{
+		{"string", "string", `""`},
+		{"bool", "bool", "false"},
+		{"int", "int64", "0"},
+		{"float", "float64", "0"},
+		{"Schema", "*Schema", "nil"},
+	} {
+		domain := &Domain{TypeModels: make(map[string]*TypeModel)}
+		code := &printer.Code{}
+		propertyModel := &TypeProperty{Name: "additionalProperties", Type: "NamedValue", MapType: test.mapType, Repeated: true}
+		domain.generateCollectionAccessorsForType(code, "Values", propertyModel)
+		get := code.String()
+		get = get[:strings.Index(get, "// Set")]
+		if !strings.Contains(get, "Get(name string) "+test.valueType+" {") {
+			t.Errorf("the Get accessor of %s values doesn't return %s:\n%s", test.mapType, test.valueType, get)
+		}
+		if !strings.Contains(get, "return "+test.zeroValue+"\n") {
+			t.Errorf("the Get accessor of %s values doesn't return %s for missing names:\n%s", test.mapType, test.zeroValue, get)
+		}
+	}
+}
@@ -111,6 +111,15 @@ func (domain *Domain) GenerateCompiler(packageName string, license string, impor
 		domain.generateToRawInfoMethodForType(code, typeName)
 	}
 
+	// generate a Visitor interface and Walk() function for documents
+	domain.generateVisitor(code)
+
+	// generate Equal(), Clone() and Merge() methods for each type
+	domain.generateEqualityMethods(code)
+
+	// generate Get(), Set(), Delete() and Names() methods for types that hold named values
+	domain.generateCollectionAccessors(code)
+
 	domain.generateConstantVariables(code, regexPatterns)
 
 	return code.String()
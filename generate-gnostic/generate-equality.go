@@ -0,0 +1,246 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/googleapis/gnostic/printer"
+)
+
+// goScalarTypes maps scalar property types to the Go types used for them in generated messages.
+var goScalarTypes = map[string]string{
+	"string": "string",
+	"bool":   "bool",
+	"int":    "int64",
+	"float":  "float64",
+}
+
+// oneofFieldName returns the name of the field that holds a oneof alternative.
+func oneofFieldName(propertyModel *TypeProperty) string {
+	switch propertyModel.Type {
+	case "float":
+		return "Number"
+	case "bool":
+		return "Boolean"
+	case "string":
+		return "String_"
+	default:
+		return propertyModel.Type
+	}
+}
+
+// generateEqualityMethods generates Equal(), Clone() and Merge() methods for the types of a document.
+// Name-value pairs are handled by the types that contain them, which treat them as maps.
+func (domain *Domain) generateEqualityMethods(code *printer.Code) {
+	if _, ok := domain.TypeModels[domain.Prefix+"Document"]; !ok {
+		return
+	}
+	for _, typeName := range domain.visitedTypeNames() {
+		domain.generateEqualMethodForType(code, typeName)
+		domain.generateCloneMethodForType(code, typeName)
+		domain.generateMergeMethodForType(code, typeName)
+	}
+}
+
+func (domain *Domain) generateEqualMethodForType(code *printer.Code, typeName string) {
+	typeModel := domain.TypeModels[typeName]
+	code.Print("// Equal returns true if two %s objects have the same contents.", typeName)
+	code.Print("// Named values are compared by name, so their order is ignored.")
+	code.Print("func (m *%s) Equal(other *%s) bool {", typeName, typeName)
+	code.Print("if m == nil || other == nil {")
+	code.Print("  return m == other")
+	code.Print("}")
+	if typeName == "Any" {
+		code.Print("return proto.Equal(m, other)")
+		code.Print("}\n")
+		return
+	}
+	if typeModel.OneOfWrapper {
+		code.Print("switch v := m.Oneof.(type) {")
+		code.Print("case nil:")
+		code.Print("  return other.Oneof == nil")
+		for _, propertyModel := range typeModel.Properties {
+			fieldName := oneofFieldName(propertyModel)
+			code.Print("case *%s_%s:", typeName, fieldName)
+			code.Print("  o, ok := other.Oneof.(*%s_%s)", typeName, fieldName)
+			if _, ok := domain.TypeModels[propertyModel.Type]; ok {
+				code.Print("  return ok && v.%s.Equal(o.%s)", fieldName, fieldName)
+			} else {
+				code.Print("  return ok && v.%s == o.%s", fieldName, fieldName)
+			}
+		}
+		code.Print("}")
+		code.Print("return false")
+		code.Print("}\n")
+		return
+	}
+	for _, propertyModel := range typeModel.Properties {
+		fieldName := propertyModel.FieldName()
+		_, isMessage := domain.TypeModels[propertyModel.Type]
+		if propertyModel.Repeated && propertyModel.MapType != "" {
+			_, isMessageMap := domain.TypeModels[propertyModel.MapType]
+			code.Print("if len(m.%s) != len(other.%s) {", fieldName, fieldName)
+			code.Print("  return false")
+			code.Print("}")
+			// each item is matched with a different item of the other collection,
+			// so collections that repeat names are only equal if they repeat them equally
+			code.Print("matched%s := make([]bool, len(other.%s))", fieldName, fieldName)
+			code.Print("for _, item := range m.%s {", fieldName)
+			code.Print("found := false")
+			code.Print("for j, otherItem := range other.%s {", fieldName)
+			if isMessageMap {
+				code.Print("if !matched%s[j] && item.Name == otherItem.Name && item.Value.Equal(otherItem.Value) {", fieldName)
+			} else {
+				code.Print("if !matched%s[j] && item.Name == otherItem.Name && item.Value == otherItem.Value {", fieldName)
+			}
+			code.Print("matched%s[j] = true", fieldName)
+			code.Print("found = true")
+			code.Print("break")
+			code.Print("}")
+			code.Print("}")
+			code.Print("if !found {")
+			code.Print("  return false")
+			code.Print("}")
+			code.Print("}")
+		} else if propertyModel.Repeated {
+			code.Print("if len(m.%s) != len(other.%s) {", fieldName, fieldName)
+			code.Print("  return false")
+			code.Print("}")
+			code.Print("for i := range m.%s {", fieldName)
+			if isMessage {
+				code.Print("if !m.%s[i].Equal(other.%s[i]) {", fieldName, fieldName)
+			} else {
+				code.Print("if m.%s[i] != other.%s[i] {", fieldName, fieldName)
+			}
+			code.Print("  return false")
+			code.Print("}")
+			code.Print("}")
+		} else if isMessage {
+			code.Print("if !m.%s.Equal(other.%s) {", fieldName, fieldName)
+			code.Print("  return false")
+			code.Print("}")
+		} else {
+			code.Print("if m.%s != other.%s {", fieldName, fieldName)
+			code.Print("  return false")
+			code.Print("}")
+		}
+	}
+	code.Print("return true")
+	code.Print("}\n")
+}
+
+func (domain *Domain) generateCloneMethodForType(code *printer.Code, typeName string) {
+	code.Print("// Clone returns a deep copy of a %s.", typeName)
+	code.Print("func (m *%s) Clone() *%s {", typeName, typeName)
+	code.Print("if m == nil {")
+	code.Print("  return nil")
+	code.Print("}")
+	code.Print("return proto.Clone(m).(*%s)", typeName)
+	code.Print("}\n")
+}
+
+func (domain *Domain) generateMergeMethodForType(code *printer.Code, typeName string) {
+	typeModel := domain.TypeModels[typeName]
+	code.Print("// Merge overlays the contents of another %s onto this one.", typeName)
+	code.Print("// Named values are merged by name, and values that are not named replace existing ones.")
+	code.Print("// Empty values in other are ignored.")
+	code.Print("func (m *%s) Merge(other *%s) {", typeName, typeName)
+	code.Print("if m == nil || other == nil {")
+	code.Print("  return")
+	code.Print("}")
+	if typeName == "Any" {
+		code.Print("*m = *other.Clone()")
+		code.Print("}\n")
+		return
+	}
+	if typeModel.OneOfWrapper {
+		code.Print("if other.Oneof == nil {")
+		code.Print("  return")
+		code.Print("}")
+		for _, propertyModel := range typeModel.Properties {
+			if _, ok := domain.TypeModels[propertyModel.Type]; ok {
+				fieldName := oneofFieldName(propertyModel)
+				code.Print("if v, ok := m.Oneof.(*%s_%s); ok && v.%s != nil {", typeName, fieldName, fieldName)
+				code.Print("if o, ok := other.Oneof.(*%s_%s); ok {", typeName, fieldName)
+				code.Print("  v.%s.Merge(o.%s)", fieldName, fieldName)
+				code.Print("  return")
+				code.Print("}")
+				code.Print("}")
+			}
+		}
+		code.Print("m.Oneof = other.Clone().Oneof")
+		code.Print("}\n")
+		return
+	}
+	for _, propertyModel := range typeModel.Properties {
+		fieldName := propertyModel.FieldName()
+		_, isMessage := domain.TypeModels[propertyModel.Type]
+		if propertyModel.Repeated && propertyModel.MapType != "" {
+			_, isMessageMap := domain.TypeModels[propertyModel.MapType]
+			code.Print("for _, item := range other.%s {", fieldName)
+			code.Print("found := false")
+			code.Print("for _, existing := range m.%s {", fieldName)
+			code.Print("if existing.Name == item.Name {")
+			if isMessageMap {
+				code.Print("if existing.Value == nil {")
+				code.Print("  existing.Value = item.Value.Clone()")
+				code.Print("} else {")
+				code.Print("  existing.Value.Merge(item.Value)")
+				code.Print("}")
+			} else {
+				code.Print("existing.Value = item.Value")
+			}
+			code.Print("found = true")
+			code.Print("break")
+			code.Print("}")
+			code.Print("}")
+			code.Print("if !found {")
+			code.Print("  m.%s = append(m.%s, proto.Clone(item).(*%s))", fieldName, fieldName, propertyModel.Type)
+			code.Print("}")
+			code.Print("}")
+		} else if propertyModel.Repeated {
+			code.Print("if len(other.%s) != 0 {", fieldName)
+			if isMessage {
+				code.Print("m.%s = make([]*%s, len(other.%s))", fieldName, propertyModel.Type, fieldName)
+				code.Print("for i, item := range other.%s {", fieldName)
+				code.Print("  m.%s[i] = item.Clone()", fieldName)
+				code.Print("}")
+			} else {
+				code.Print("m.%s = append([]%s(nil), other.%s...)", fieldName, goScalarTypes[propertyModel.Type], fieldName)
+			}
+			code.Print("}")
+		} else if isMessage {
+			code.Print("if other.%s != nil {", fieldName)
+			code.Print("if m.%s == nil {", fieldName)
+			code.Print("  m.%s = other.%s.Clone()", fieldName, fieldName)
+			code.Print("} else {")
+			code.Print("  m.%s.Merge(other.%s)", fieldName, fieldName)
+			code.Print("}")
+			code.Print("}")
+		} else if propertyModel.Type == "bool" {
+			code.Print("if other.%s {", fieldName)
+			code.Print("  m.%s = true", fieldName)
+			code.Print("}")
+		} else if propertyModel.Type == "string" {
+			code.Print("if other.%s != \"\" {", fieldName)
+			code.Print("  m.%s = other.%s", fieldName, fieldName)
+			code.Print("}")
+		} else {
+			code.Print("if other.%s != 0 {", fieldName)
+			code.Print("  m.%s = other.%s", fieldName, fieldName)
+			code.Print("}")
+		}
+	}
+	code.Print("}\n")
+}
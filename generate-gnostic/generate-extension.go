@@ -148,7 +148,8 @@ type generatedTypeInfo struct {
 }
 
 // GenerateExtension generates the implementation of an extension.
-func GenerateExtension(schemaFile string, outDir string) error {
+// baseSchemaFile is the location of the JSON Schema meta-schema.
+func GenerateExtension(schemaFile string, outDir string, baseSchemaFile string) error {
 	outFileBaseName := getBaseFileNameWithoutExt(schemaFile)
 	extensionNameWithoutXDashPrefix := outFileBaseName[len("x-"):]
 	outDir = path.Join(outDir, "gnostic-x-"+extensionNameWithoutXDashPrefix)
@@ -159,8 +160,7 @@ func GenerateExtension(schemaFile string, outDir string) error {
 	protoOutDirectory := outDir + "/" + "proto"
 	var err error
 
-	projectRoot := os.Getenv("GOPATH") + "/src/github.com/googleapis/gnostic/"
-	baseSchema, err := jsonschema.NewSchemaFromFile(projectRoot + "jsonschema/schema.json")
+	baseSchema, err := jsonschema.NewSchemaFromFile(baseSchemaFile)
 	if err != nil {
 		return err
 	}
@@ -273,7 +273,10 @@ func GenerateExtension(schemaFile string, outDir string) error {
 	err = exec.Command(runtime.GOROOT()+"/bin/gofmt", "-w", goFilename).Run()
 
 	// generate the main file.
-	outDirRelativeToGoPathSrc := strings.Replace(outDir, path.Join(os.Getenv("GOPATH"), "src")+"/", "", 1)
+	outDirImportPath, err := importPathForDirectory(outDir)
+	if err != nil {
+		return err
+	}
 
 	var extensionNameKeys []string
 	for k := range extensionNameToMessageName {
@@ -298,7 +301,7 @@ func GenerateExtension(schemaFile string, outDir string) error {
 		"github.com/googleapis/gnostic/extensions",
 		"github.com/googleapis/gnostic/compiler",
 		"gopkg.in/yaml.v2",
-		outDirRelativeToGoPathSrc + "/" + "proto",
+		outDirImportPath + "/" + "proto",
 	}
 	if wrapperTypeIncluded {
 		imports = append(imports, "github.com/golang/protobuf/ptypes/wrappers")
@@ -318,6 +321,7 @@ func processExtensionGenCommandline(usage string) error {
 
 	outDir := ""
 	schameFile := ""
+	baseSchemaFile := projectPath(defaultBaseSchema)
 
 	extParamRegex, _ := regexp.Compile("--(.+)=(.+)")
 
@@ -330,8 +334,10 @@ func processExtensionGenCommandline(usage string) error {
 			flagName := string(m[1])
 			flagValue := string(m[2])
 			switch flagName {
-			case "out_dir":
+			case "out_dir", "out-dir":
 				outDir = flagValue
+			case "base-schema":
+				baseSchemaFile = flagValue
 			default:
 				fmt.Printf("Unknown option: %s.\n%s\n", arg, usage)
 				os.Exit(-1)
@@ -359,5 +365,5 @@ func processExtensionGenCommandline(usage string) error {
 		os.Exit(-1)
 	}
 
-	return GenerateExtension(schameFile, outDir)
+	return GenerateExtension(schameFile, outDir, baseSchemaFile)
 }
@@ -0,0 +1,135 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/googleapis/gnostic/printer"
+)
+
+// visitedTypeNames returns the names of the types that are passed to visitors.
+// Name-value pairs are not visited; their values are visited with the pair's name as a key.
+func (domain *Domain) visitedTypeNames() []string {
+	typeNames := make([]string, 0)
+	for _, typeName := range domain.sortedTypeNames() {
+		if !domain.TypeModels[typeName].IsPair {
+			typeNames = append(typeNames, typeName)
+		}
+	}
+	return typeNames
+}
+
+// generateVisitor generates a Visitor interface and a Walk() function for the document type.
+func (domain *Domain) generateVisitor(code *printer.Code) {
+	documentTypeName := domain.Prefix + "Document"
+	if _, ok := domain.TypeModels[documentTypeName]; !ok {
+		return
+	}
+	typeNames := domain.visitedTypeNames()
+
+	code.Print("// Visitor is implemented by types that inspect the nodes of a %s.", documentTypeName)
+	code.Print("// Each method is called with a node and the path of keys that leads to it")
+	code.Print("// from the root of the document. Map keys and property names are used as keys,")
+	code.Print("// and array elements are identified by their indices. Returning SkipChildren skips")
+	code.Print("// the nodes below a node, and returning any other error stops the walk.")
+	code.Print("// Implementations can embed BaseVisitor and override only the methods they need.")
+	code.Print("type Visitor interface {")
+	for _, typeName := range typeNames {
+		code.Print("Visit%s(node *%s, path []string) error", typeName, typeName)
+	}
+	code.Print("}\n")
+
+	code.Print("// SkipChildren is returned by Visitor methods to skip the nodes below the node that they were called with.")
+	code.Print("var SkipChildren error = skipChildren{}\n")
+	code.Print("type skipChildren struct{}\n")
+	code.Print("func (skipChildren) Error() string { return \"skip children\" }\n")
+
+	code.Print("// BaseVisitor is a Visitor whose methods do nothing.")
+	code.Print("type BaseVisitor struct{}\n")
+	for _, typeName := range typeNames {
+		code.Print("// Visit%s does nothing.", typeName)
+		code.Print("func (BaseVisitor) Visit%s(node *%s, path []string) error { return nil }\n", typeName, typeName)
+	}
+
+	code.Print("// Walk calls the methods of a visitor for a %s and for each node below it, in document order.", documentTypeName)
+	code.Print("// Nodes that wrap alternative types are visited before the node that they contain, at the same path.")
+	code.Print("func Walk(document *%s, visitor Visitor) error {", documentTypeName)
+	code.Print("return document.walk(visitor, []string{})")
+	code.Print("}\n")
+
+	code.Print("// keyPath returns a copy of path with keys appended.")
+	code.Print("func keyPath(path []string, keys ...string) []string {")
+	code.Print("result := make([]string, 0, len(path)+len(keys))")
+	code.Print("result = append(result, path...)")
+	code.Print("return append(result, keys...)")
+	code.Print("}\n")
+
+	for _, typeName := range typeNames {
+		domain.generateWalkMethodForType(code, typeName)
+	}
+}
+
+func (domain *Domain) generateWalkMethodForType(code *printer.Code, typeName string) {
+	typeModel := domain.TypeModels[typeName]
+	code.Print("// walk visits a %s and the nodes below it.", typeName)
+	code.Print("func (m *%s) walk(visitor Visitor, path []string) error {", typeName)
+	code.Print("if m == nil {")
+	code.Print("  return nil")
+	code.Print("}")
+	code.Print("if err := visitor.Visit%s(m, path); err == SkipChildren {", typeName)
+	code.Print("  return nil")
+	code.Print("} else if err != nil {")
+	code.Print("  return err")
+	code.Print("}")
+	for _, propertyModel := range typeModel.Properties {
+		if typeModel.OneOfWrapper {
+			// scalar alternatives have no nodes to visit.
+			if _, ok := domain.TypeModels[propertyModel.Type]; ok {
+				code.Print("if err := m.Get%s().walk(visitor, path); err != nil {", propertyModel.Type)
+				code.Print("  return err")
+				code.Print("}")
+			}
+		} else if propertyModel.Repeated && propertyModel.MapType != "" {
+			if _, ok := domain.TypeModels[propertyModel.MapType]; ok {
+				code.Print("for _, item := range m.%s {", propertyModel.FieldName())
+				code.Print("if err := item.Value.walk(visitor, keyPath(path, item.Name)); err != nil {")
+				code.Print("  return err")
+				code.Print("}")
+				code.Print("}")
+			}
+		} else if propertyModel.Repeated {
+			if _, ok := domain.TypeModels[propertyModel.Type]; ok {
+				if typeName == "ItemsItem" {
+					// items are written as a single value, so they share the path of their container.
+					code.Print("for _, item := range m.%s {", propertyModel.FieldName())
+					code.Print("if err := item.walk(visitor, path); err != nil {")
+				} else {
+					code.Print("for i, item := range m.%s {", propertyModel.FieldName())
+					code.Print("if err := item.walk(visitor, keyPath(path, \"%s\", fmt.Sprintf(\"%%d\", i))); err != nil {", propertyModel.Name)
+				}
+				code.Print("  return err")
+				code.Print("}")
+				code.Print("}")
+			}
+		} else {
+			if _, ok := domain.TypeModels[propertyModel.Type]; ok {
+				code.Print("if err := m.%s.walk(visitor, keyPath(path, \"%s\")); err != nil {", propertyModel.FieldName(), propertyModel.Name)
+				code.Print("  return err")
+				code.Print("}")
+			}
+		}
+	}
+	code.Print("return nil")
+	code.Print("}\n")
+}
@@ -15,6 +15,11 @@
 package main
 
 import (
+	"bufio"
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
 	"strings"
 	"unicode"
 )
@@ -53,3 +58,53 @@ func snakeCaseToCamelCase(input string) string {
 
 	return out
 }
+
+// importPathForDirectory returns the Go import path of a directory.
+// The path is taken from the nearest enclosing go.mod file, and if there
+// is none, from the directory's location in a GOPATH workspace.
+func importPathForDirectory(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := dir; ; root = filepath.Dir(root) {
+		if modulePath := modulePathForFile(filepath.Join(root, "go.mod")); modulePath != "" {
+			rel, err := filepath.Rel(root, dir)
+			if err != nil {
+				return "", err
+			}
+			if rel == "." {
+				return modulePath, nil
+			}
+			return modulePath + "/" + filepath.ToSlash(rel), nil
+		}
+		if filepath.Dir(root) == root {
+			break
+		}
+	}
+	for _, gopath := range filepath.SplitList(build.Default.GOPATH) {
+		src := filepath.Join(gopath, "src") + string(filepath.Separator)
+		if strings.HasPrefix(dir, src) {
+			return filepath.ToSlash(strings.TrimPrefix(dir, src)), nil
+		}
+	}
+	return "", fmt.Errorf("unable to determine the Go import path of %s: it is not in a Go module or GOPATH workspace", dir)
+}
+
+// modulePathForFile returns the module path declared in a go.mod file,
+// or an empty string if the file can't be read or declares no module.
+func modulePathForFile(filename string) string {
+	file, err := os.Open(filename)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"`")
+		}
+	}
+	return ""
+}
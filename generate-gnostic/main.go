@@ -19,12 +19,15 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
 
@@ -84,40 +87,101 @@ func protoOptions(packageName string) []ProtoOption {
 	}
 }
 
-func generateOpenAPIModel(version string) error {
-	var input string
-	var filename string
-	var protoPackageName string
+// ModelOptions describe the inputs and outputs used to generate a model and compiler.
+type ModelOptions struct {
+	Version      string // OpenAPI version ("v2", "v3", "discovery") or empty for other schemas
+	BaseSchema   string // path to the JSON Schema meta-schema
+	Schema       string // path to the JSON Schema that describes the model
+	OutDir       string // directory that receives the generated files
+	ProtoPackage string // package name used in the generated .proto file
+	GoPackage    string // package name used in the generated Go code
+}
+
+// defaultBaseSchema is the meta-schema path, relative to the gnostic project directory.
+const defaultBaseSchema = "jsonschema/schema.json"
+
+// projectDirectory returns the gnostic project directory, which is the current directory
+// or the closest directory above it that contains the JSON Schema meta-schema. It returns ""
+// if there is no such directory, and then default paths are relative to the current directory.
+func projectDirectory() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, defaultBaseSchema)); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// projectPath returns the location of a path in the gnostic project directory.
+func projectPath(name string) string {
+	return filepath.Join(projectDirectory(), name)
+}
 
+// modelOptionsForVersion returns the default options for one of the built-in models.
+// Paths are in the gnostic project directory.
+func modelOptionsForVersion(version string) (*ModelOptions, error) {
+	options := &ModelOptions{Version: version, BaseSchema: projectPath(defaultBaseSchema)}
 	switch version {
 	case "v2":
-		input = "openapi-2.0.json"
-		filename = "OpenAPIv2"
-		protoPackageName = "openapi.v2"
+		options.Schema = projectPath("OpenAPIv2/openapi-2.0.json")
+		options.OutDir = projectPath("OpenAPIv2")
+		options.ProtoPackage = "openapi.v2"
 	case "v3":
-		input = "openapi-3.0.json"
-		filename = "OpenAPIv3"
-		protoPackageName = "openapi.v3"
+		options.Schema = projectPath("OpenAPIv3/openapi-3.0.json")
+		options.OutDir = projectPath("OpenAPIv3")
+		options.ProtoPackage = "openapi.v3"
 	case "discovery":
-		input = "discovery.json"
-		filename = "discovery"
-		protoPackageName = "discovery.v1"
+		options.Schema = projectPath("discovery/discovery.json")
+		options.OutDir = projectPath("discovery")
+		options.ProtoPackage = "discovery.v1"
 	default:
-		return fmt.Errorf("Unknown OpenAPI version %s", version)
+		return nil, fmt.Errorf("Unknown OpenAPI version %s", version)
 	}
+	return options, nil
+}
 
-	goPackageName := strings.Replace(protoPackageName, ".", "_", -1)
+func generateModel(options *ModelOptions) error {
+	if options.Schema == "" {
+		return errors.New("No input json schema specified")
+	}
+	if options.OutDir == "" {
+		return errors.New("Missing output directory")
+	}
+	if options.ProtoPackage == "" {
+		return errors.New("Missing protocol buffer package name")
+	}
+	if options.BaseSchema == "" {
+		options.BaseSchema = projectPath(defaultBaseSchema)
+	}
 
-	projectRoot := os.Getenv("GOPATH") + "/src/github.com/googleapis/gnostic/"
+	goPackageName := options.GoPackage
+	if goPackageName == "" {
+		goPackageName = strings.Replace(options.ProtoPackage, ".", "_", -1)
+	}
 
-	baseSchema, err := jsonschema.NewSchemaFromFile(projectRoot + "jsonschema/schema.json")
+	outDir, err := filepath.Abs(options.OutDir)
+	if err != nil {
+		return err
+	}
+	// generated files are named after the directory that contains them
+	filename := filepath.Base(outDir)
+
+	baseSchema, err := jsonschema.NewSchemaFromFile(options.BaseSchema)
 	if err != nil {
 		return err
 	}
 	baseSchema.ResolveRefs()
 	baseSchema.ResolveAllOfs()
 
-	openapiSchema, err := jsonschema.NewSchemaFromFile(projectRoot + filename + "/" + input)
+	openapiSchema, err := jsonschema.NewSchemaFromFile(options.Schema)
 	if err != nil {
 		return err
 	}
@@ -125,11 +189,11 @@ func generateOpenAPIModel(version string) error {
 	openapiSchema.ResolveAllOfs()
 
 	// build a simplified model of the types described by the schema
-	cc := NewDomain(openapiSchema, version)
+	cc := NewDomain(openapiSchema, options.Version)
 	// generators will map these patterns to the associated property names
 	// these pattern names are a bit of a hack until we find a more automated way to obtain them
 
-	switch version {
+	switch options.Version {
 	case "v2":
 		cc.TypeNameOverrides = map[string]string{
 			"VendorExtension": "Any",
@@ -146,11 +210,6 @@ func generateOpenAPIModel(version string) error {
 			"PathItem":      "Path",
 			"ResponseValue": "ResponseCode",
 		}
-	case "discovery":
-		cc.TypeNameOverrides = map[string]string{}
-		cc.PropertyNameOverrides = map[string]string{}
-	default:
-		return fmt.Errorf("Unknown OpenAPI version %s", version)
 	}
 
 	err = cc.Build()
@@ -163,16 +222,16 @@ func generateOpenAPIModel(version string) error {
 	}
 
 	// ensure that the target directory exists
-	err = os.MkdirAll(projectRoot+filename, 0755)
+	err = os.MkdirAll(outDir, 0755)
 	if err != nil {
 		return err
 	}
 
 	// generate the protocol buffer description
 	log.Printf("Generating protocol buffer description")
-	proto := cc.generateProto(protoPackageName, License,
+	proto := cc.generateProto(options.ProtoPackage, License,
 		protoOptions(goPackageName), []string{"google/protobuf/any.proto"})
-	protoFileName := projectRoot + filename + "/" + filename + ".proto"
+	protoFileName := filepath.Join(outDir, filename+".proto")
 	err = ioutil.WriteFile(protoFileName, []byte(proto), 0644)
 	if err != nil {
 		return err
@@ -185,9 +244,10 @@ func generateOpenAPIModel(version string) error {
 		"gopkg.in/yaml.v2",
 		"strings",
 		"regexp",
+		"github.com/golang/protobuf/proto",
 		"github.com/googleapis/gnostic/compiler",
 	})
-	goFileName := projectRoot + filename + "/" + filename + ".go"
+	goFileName := filepath.Join(outDir, filename+".go")
 	err = ioutil.WriteFile(goFileName, []byte(compiler), 0644)
 	if err != nil {
 		return err
@@ -209,24 +269,52 @@ Options:
     Generate Protocol Buffer representation and support code for OpenAPI v3
     Files are read from and written to appropriate locations in the gnostic
     project directory.
+  --discovery
+    Generate Protocol Buffer representation and support code for the
+    Google API Discovery Format. Files are read from and written to
+    appropriate locations in the gnostic project directory.
+  --schema=PATH
+    Generate Protocol Buffer representation and support code for the
+    JSON schema at PATH. Requires --out-dir and --proto-package.
+    MODEL_OPTIONS (these also override the defaults of --v2, --v3 and --discovery)
+      --out-dir=PATH: Location for writing the model and support code.
+      --proto-package=NAME: Protocol Buffer package name, e.g. "asyncapi.v2".
+      --go-package=NAME: Go package name. Defaults to the Protocol Buffer
+        package name with "." replaced by "_".
+      --base-schema=PATH: Location of the JSON Schema meta-schema.
+        Defaults to "jsonschema/schema.json" in the gnostic project directory.
   --extension EXTENSION_SCHEMA [EXTENSIONOPTIONS]
     Generate a gnostic extension that reads a set of OpenAPI extensions.
     EXTENSION_SCHEMA is the json schema for the OpenAPI extensions to be
     supported.
     EXTENSION_OPTIONS
       --out_dir=PATH: Location for writing extension models and support code.
+      --base-schema=PATH: Location of the JSON Schema meta-schema.
+        Defaults to "jsonschema/schema.json" in the gnostic project directory.
 `, path.Base(os.Args[0]))
 }
 
 func main() {
+	var options *ModelOptions
 	var openapiVersion = ""
 	var generateExtensions = false
+	var flags = make(map[string]string)
+
+	paramRegex := regexp.MustCompile("^--(.+)=(.+)$")
 
 	for i, arg := range os.Args {
 		if i == 0 {
 			continue // skip the tool name
 		}
-		if arg == "--v2" {
+		if m := paramRegex.FindStringSubmatch(arg); m != nil {
+			switch m[1] {
+			case "schema", "out-dir", "proto-package", "go-package", "base-schema":
+				flags[m[1]] = m[2]
+			default:
+				fmt.Printf("Unknown option: %s.\n%s\n", arg, usage())
+				os.Exit(-1)
+			}
+		} else if arg == "--v2" {
 			openapiVersion = "v2"
 		} else if arg == "--v3" {
 			openapiVersion = "v3"
@@ -241,17 +329,44 @@ func main() {
 		}
 	}
 
-	if openapiVersion != "" {
-		err := generateOpenAPIModel(openapiVersion)
+	if generateExtensions {
+		err := processExtensionGenCommandline(usage())
 		if err != nil {
 			fmt.Printf("%+v\n", err)
 		}
-	} else if generateExtensions {
-		err := processExtensionGenCommandline(usage())
+		return
+	}
+
+	if openapiVersion != "" {
+		var err error
+		options, err = modelOptionsForVersion(openapiVersion)
 		if err != nil {
 			fmt.Printf("%+v\n", err)
+			os.Exit(-1)
 		}
+	} else if len(flags) > 0 {
+		options = &ModelOptions{}
 	} else {
 		fmt.Printf("%s\n", usage())
+		return
+	}
+	if value, ok := flags["schema"]; ok {
+		options.Schema = value
+	}
+	if value, ok := flags["out-dir"]; ok {
+		options.OutDir = value
+	}
+	if value, ok := flags["proto-package"]; ok {
+		options.ProtoPackage = value
+	}
+	if value, ok := flags["go-package"]; ok {
+		options.GoPackage = value
+	}
+	if value, ok := flags["base-schema"]; ok {
+		options.BaseSchema = value
+	}
+	err := generateModel(options)
+	if err != nil {
+		fmt.Printf("%+v\n%s\n", err, usage())
 	}
 }
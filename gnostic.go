@@ -92,76 +92,83 @@ type pluginCall struct {
 	Invocation string
 }
 
+// request returns the request that is sent to a plugin and the location that its output is written to.
+// Requests include the surface model and the messages that were reported while building it.
+func (p *pluginCall) request(document proto.Message, sourceFormat int, sourceName string,
+	surfaceModel *surface.Model, surfaceMessages []*plugins.Message) (*plugins.Request, string, error) {
+	request := &plugins.Request{}
+
+	// Validate invocation string with regular expression.
+	invocation := p.Invocation
+
+	//
+	// Plugin invocations must consist of
+	// zero or more comma-separated key=value pairs followed by a path.
+	// If pairs are present, a colon separates them from the path.
+	// Keys and values must be alphanumeric strings and may contain
+	// dashes, underscores, periods, or forward slashes.
+	// A path can contain any characters other than the separators ',', ':', and '='.
+	//
+	invocationRegex := regexp.MustCompile(`^([\w-_\/\.]+=[\w-_\/\.]+(,[\w-_\/\.]+=[\w-_\/\.]+)*:)?[^,:=]+$`)
+	if !invocationRegex.Match([]byte(p.Invocation)) {
+		return nil, "", fmt.Errorf("Invalid invocation of %s: %s", pluginPrefix+p.Name, invocation)
+	}
+
+	invocationParts := strings.Split(p.Invocation, ":")
+	var outputLocation string
+	switch len(invocationParts) {
+	case 1:
+		outputLocation = invocationParts[0]
+	case 2:
+		parameters := strings.Split(invocationParts[0], ",")
+		for _, keyvalue := range parameters {
+			pair := strings.Split(keyvalue, "=")
+			if len(pair) == 2 {
+				request.Parameters = append(request.Parameters, &plugins.Parameter{Name: pair[0], Value: pair[1]})
+			}
+		}
+		outputLocation = invocationParts[1]
+	default:
+		// badly-formed request
+		outputLocation = invocationParts[len(invocationParts)-1]
+	}
+
+	version := &plugins.Version{}
+	version.Major = 0
+	version.Minor = 1
+	version.Patch = 0
+	request.CompilerVersion = version
+
+	request.OutputPath = outputLocation
+
+	request.SourceName = sourceName
+	switch sourceFormat {
+	case SourceFormatOpenAPI2:
+		request.AddModel("openapi.v2.Document", document)
+	case SourceFormatOpenAPI3:
+		request.AddModel("openapi.v3.Document", document)
+	case SourceFormatDiscovery:
+		request.AddModel("discovery.v1.Document", document)
+	default:
+	}
+	// include experimental API surface model
+	if surfaceModel != nil {
+		request.AddModel("surface.v1.Model", surfaceModel)
+	}
+	request.Messages = surfaceMessages
+	return request, outputLocation, nil
+}
+
 // Invokes a plugin.
-func (p *pluginCall) perform(document proto.Message, sourceFormat int, sourceName string, timePlugins bool) ([]*plugins.Message, error) {
+func (p *pluginCall) perform(document proto.Message, sourceFormat int, sourceName string, timePlugins bool,
+	surfaceModel *surface.Model, surfaceMessages []*plugins.Message) ([]*plugins.Message, error) {
 	if p.Name != "" {
-		request := &plugins.Request{}
-
 		// Infer the name of the executable by adding the prefix.
 		executableName := pluginPrefix + p.Name
 
-		// Validate invocation string with regular expression.
-		invocation := p.Invocation
-
-		//
-		// Plugin invocations must consist of
-		// zero or more comma-separated key=value pairs followed by a path.
-		// If pairs are present, a colon separates them from the path.
-		// Keys and values must be alphanumeric strings and may contain
-		// dashes, underscores, periods, or forward slashes.
-		// A path can contain any characters other than the separators ',', ':', and '='.
-		//
-		invocationRegex := regexp.MustCompile(`^([\w-_\/\.]+=[\w-_\/\.]+(,[\w-_\/\.]+=[\w-_\/\.]+)*:)?[^,:=]+$`)
-		if !invocationRegex.Match([]byte(p.Invocation)) {
-			return nil, fmt.Errorf("Invalid invocation of %s: %s", executableName, invocation)
-		}
-
-		invocationParts := strings.Split(p.Invocation, ":")
-		var outputLocation string
-		switch len(invocationParts) {
-		case 1:
-			outputLocation = invocationParts[0]
-		case 2:
-			parameters := strings.Split(invocationParts[0], ",")
-			for _, keyvalue := range parameters {
-				pair := strings.Split(keyvalue, "=")
-				if len(pair) == 2 {
-					request.Parameters = append(request.Parameters, &plugins.Parameter{Name: pair[0], Value: pair[1]})
-				}
-			}
-			outputLocation = invocationParts[1]
-		default:
-			// badly-formed request
-			outputLocation = invocationParts[len(invocationParts)-1]
-		}
-
-		version := &plugins.Version{}
-		version.Major = 0
-		version.Minor = 1
-		version.Patch = 0
-		request.CompilerVersion = version
-
-		request.OutputPath = outputLocation
-
-		request.SourceName = sourceName
-		switch sourceFormat {
-		case SourceFormatOpenAPI2:
-			request.AddModel("openapi.v2.Document", document)
-			// include experimental API surface model
-			surfaceModel, err := surface.NewModelFromOpenAPI2(document.(*openapi_v2.Document))
-			if err == nil {
-				request.AddModel("surface.v1.Model", surfaceModel)
-			}
-		case SourceFormatOpenAPI3:
-			request.AddModel("openapi.v3.Document", document)
-			// include experimental API surface model
-			surfaceModel, err := surface.NewModelFromOpenAPI3(document.(*openapi_v3.Document))
-			if err == nil {
-				request.AddModel("surface.v1.Model", surfaceModel)
-			}
-		case SourceFormatDiscovery:
-			request.AddModel("discovery.v1.Document", document)
-		default:
+		request, outputLocation, err := p.request(document, sourceFormat, sourceName, surfaceModel, surfaceMessages)
+		if err != nil {
+			return nil, err
 		}
 
 		requestBytes, _ := proto.Marshal(request)
@@ -194,6 +201,27 @@ func (p *pluginCall) perform(document proto.Message, sourceFormat int, sourceNam
 	return nil, nil
 }
 
+// buildSurfaceModel builds the experimental API surface model that is passed to plugins.
+// References to other files are relative to the source name, and the source info is the text that
+// the document was compiled from, or nil if it was read from a binary.
+// It also returns messages that describe the problems that were found while building it.
+func buildSurfaceModel(document proto.Message, sourceFormat int, sourceName string, sourceInfo interface{}) (*surface.Model, []*plugins.Message) {
+	var model *surface.Model
+	var warnings []*surface.Warning
+	var err error
+	switch sourceFormat {
+	case SourceFormatOpenAPI2:
+		model, warnings, err = surface.NewModelFromOpenAPI2WithInfo(document.(*openapi_v2.Document), sourceInfo)
+	case SourceFormatOpenAPI3:
+		model, warnings, err = surface.NewModelFromOpenAPI3WithInfo(document.(*openapi_v3.Document), sourceName, sourceInfo)
+	case SourceFormatDiscovery:
+		model, warnings, err = surface.NewModelFromDiscoveryWithWarnings(document.(*discovery_v1.Document))
+	default:
+		return nil, nil
+	}
+	return model, plugins.SurfaceModelMessages(warnings, err)
+}
+
 func isFile(path string) bool {
 	fileInfo, err := os.Stat(path)
 	if err != nil {
@@ -259,6 +287,7 @@ type Gnostic struct {
 	pluginCalls       []*pluginCall
 	extensionHandlers []compiler.ExtensionHandler
 	sourceFormat      int
+	sourceInfo        interface{}
 	timePlugins       bool
 }
 
@@ -382,6 +411,7 @@ func (g *Gnostic) readOpenAPIText(bytes []byte) (message proto.Message, err erro
 	if err != nil {
 		return nil, err
 	}
+	g.sourceInfo = info
 	// Determine the OpenAPI version.
 	g.sourceFormat = getOpenAPIVersionFromInfo(info)
 	if g.sourceFormat == SourceFormatUnknown {
@@ -546,8 +576,15 @@ func (g *Gnostic) performActions(message proto.Message) (err error) {
 	}
 	// Call all specified plugins.
 	messages := make([]*plugins.Message, 0)
+	var surfaceModel *surface.Model
+	var surfaceMessages []*plugins.Message
+	if len(g.pluginCalls) > 0 {
+		// The surface model is built once for all plugins, and its problems are reported once.
+		surfaceModel, surfaceMessages = buildSurfaceModel(message, g.sourceFormat, g.sourceName, g.sourceInfo)
+		messages = append(messages, surfaceMessages...)
+	}
 	for _, p := range g.pluginCalls {
-		pluginMessages, err := p.perform(message, g.sourceFormat, g.sourceName, g.timePlugins)
+		pluginMessages, err := p.perform(message, g.sourceFormat, g.sourceName, g.timePlugins, surfaceModel, surfaceMessages)
 		if err != nil {
 			writeFile(g.errorOutputPath, g.errorBytes(err), g.sourceName, "errors")
 			defer os.Exit(-1) // run all plugins, even when some have errors
@@ -5,8 +5,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
+
+	"github.com/googleapis/gnostic/compiler"
+	plugins "github.com/googleapis/gnostic/plugins"
 )
 
 func testCompiler(t *testing.T, inputFile string, referenceFile string, expectErrors bool) {
@@ -466,3 +470,60 @@ func TestEmptyRequiredFields_v3(t *testing.T) {
 		"examples/v3.0/yaml/empty-v3.yaml",
 		"test/v3.0/json/empty-v3.json")
 }
+
+// Test that the problems found while building surface models are sent to plugins.
+
+func TestSurfaceModelMessages(t *testing.T) {
+	g := newGnostic()
+	g.sourceName = "test/v3.0/yaml/surface-warnings.yaml"
+	bytes, err := compiler.ReadBytesForFile(g.sourceName)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	document, err := g.readOpenAPIText(bytes)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	model, messages := buildSurfaceModel(document, g.sourceFormat, g.sourceName, g.sourceInfo)
+	if model == nil {
+		t.Fatalf("no surface model was built")
+	}
+	expected := []string{
+		"NAMECOLLISION paths./animals.get",
+		"OMITTED components.schemas.Animal",
+		"UNRESOLVEDREFERENCE paths./pets.get.responses.200",
+		"UNSUPPORTED components.securitySchemes.certificate.type",
+	}
+	codes := make([]string, 0)
+	for _, message := range messages {
+		if message.Level != plugins.Message_WARNING {
+			t.Errorf("%s has level %s, expected WARNING", message.Code, message.Level)
+		}
+		codes = append(codes, message.Code+" "+strings.Join(message.Keys, "."))
+	}
+	sort.Strings(codes)
+	if strings.Join(codes, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("surface model messages are\n%s\nexpected\n%s", strings.Join(codes, "\n"), strings.Join(expected, "\n"))
+	}
+
+	call := &pluginCall{Name: "summary", Invocation: "!"}
+	request, _, err := call.request(document, g.sourceFormat, g.sourceName, model, messages)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(request.Messages) != len(messages) {
+		t.Fatalf("the plugin request has %d messages, expected %d", len(request.Messages), len(messages))
+	}
+	for i, message := range request.Messages {
+		if message != messages[i] {
+			t.Errorf("message %d of the plugin request is %v, expected %v", i, message, messages[i])
+		}
+	}
+	hasModel := false
+	for _, m := range request.Models {
+		hasModel = hasModel || m.TypeUrl == "surface.v1.Model"
+	}
+	if !hasModel {
+		t.Errorf("the plugin request has no surface model")
+	}
+}
@@ -104,11 +104,12 @@ When the -plugin option is specified, these flags are ignored.`)
 		if err == nil {
 			env.Request.AddModel("openapi.v2.Document", documentv2)
 			// include experimental API surface model
-			surfaceModel, err := surface.NewModelFromOpenAPI2(documentv2)
+			surfaceModel, warnings, err := surface.NewModelFromOpenAPI2WithInfo(documentv2, nil)
 			if err == nil {
 				env.Request.AddModel("surface.v1.Model", surfaceModel)
 			}
-			return env, err
+			env.Request.Messages = SurfaceModelMessages(warnings, err)
+			return env, nil
 		}
 		// If that failed, ignore deserialization errors and try to unmarshal OpenAPI v3.
 		documentv3 := &openapiv3.Document{}
@@ -116,18 +117,25 @@ When the -plugin option is specified, these flags are ignored.`)
 		if err == nil {
 			env.Request.AddModel("openapi.v3.Document", documentv3)
 			// include experimental API surface model
-			surfaceModel, err := surface.NewModelFromOpenAPI3(documentv3)
+			surfaceModel, warnings, err := surface.NewModelFromOpenAPI3WithInfo(documentv3, *input, nil)
 			if err == nil {
 				env.Request.AddModel("surface.v1.Model", surfaceModel)
 			}
-			return env, err
+			env.Request.Messages = SurfaceModelMessages(warnings, err)
+			return env, nil
 		}
 		// If that failed, ignore deserialization errors and try to unmarshal a Discovery document.
 		discoveryDocument := &discovery.Document{}
 		err = proto.Unmarshal(apiData, discoveryDocument)
 		if err == nil {
 			env.Request.AddModel("discovery.v1.Document", discoveryDocument)
-			return env, err
+			// include experimental API surface model
+			surfaceModel, warnings, err := surface.NewModelFromDiscoveryWithWarnings(discoveryDocument)
+			if err == nil {
+				env.Request.AddModel("surface.v1.Model", surfaceModel)
+			}
+			env.Request.Messages = SurfaceModelMessages(warnings, err)
+			return env, nil
 		}
 		// If we get here, we don't know what we got
 		err = errors.New("Unrecognized format for input")
@@ -192,6 +200,28 @@ func HandleResponse(response *Response, outputLocation string) error {
 	return nil
 }
 
+// SurfaceModelMessages returns messages that describe the problems that were found while building a surface model.
+// If the model couldn't be built, the error that prevented it is returned as an error message.
+func SurfaceModelMessages(warnings []*surface.Warning, err error) []*Message {
+	messages := make([]*Message, 0)
+	for _, warning := range warnings {
+		messages = append(messages, &Message{
+			Level: Message_WARNING,
+			Code:  warning.Code,
+			Text:  warning.Text,
+			Keys:  warning.Keys,
+		})
+	}
+	if err != nil {
+		messages = append(messages, &Message{
+			Level: Message_ERROR,
+			Code:  "SURFACEMODEL",
+			Text:  "unable to build surface model: " + err.Error(),
+		})
+	}
+	return messages
+}
+
 func (request *Request) AddModel(modelType string, model proto.Message) error {
 	modelBytes, err := proto.Marshal(model)
 	request.Models = append(request.Models, &any.Any{TypeUrl: modelType, Value: modelBytes})
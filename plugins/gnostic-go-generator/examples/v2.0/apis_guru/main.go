@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -11,15 +12,16 @@ import (
 )
 
 func main() {
-	c := apis_guru.NewClient("http://api.apis.guru/v2")
+	c := apis_guru.NewClient(apis_guru.WithBaseURL("http://api.apis.guru/v2"))
+	ctx := context.Background()
 
-	metrics, err := c.GetMetrics()
+	metrics, err := c.GetMetrics(ctx)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf("%+v\n", metrics)
 
-	apis, err := c.ListAPIs()
+	apis, err := c.ListAPIs(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -17,6 +17,8 @@
 package test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -31,10 +33,11 @@ const service = "http://localhost:8080"
 
 func TestBookstore(t *testing.T) {
 	// create a client
-	b := bookstore.NewClient(service, nil)
+	b := bookstore.NewClient(bookstore.WithBaseURL(service), bookstore.WithAPIKey("api_key", "key"))
+	ctx := context.Background()
 	// reset the service by deleting all shelves
 	{
-		err := b.DeleteShelves()
+		err := b.DeleteShelves(ctx)
 		if err != nil {
 			t.Log("delete shelves failed")
 			t.Fail()
@@ -42,7 +45,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// verify that the service has no shelves
 	{
-		response, err := b.ListShelves()
+		response, err := b.ListShelves(ctx)
 		if err != nil {
 			t.Log("list shelves failed")
 			t.Fail()
@@ -55,15 +58,19 @@ func TestBookstore(t *testing.T) {
 	}
 	// attempting to get a shelf should return an error
 	{
-		_, err := b.GetShelf(1)
-		if err == nil {
-			t.Log("get shelf failed to return an error")
+		_, err := b.GetShelf(ctx, 1)
+		var apiErr *bookstore.APIError
+		if !errors.As(err, &apiErr) {
+			t.Log("get shelf failed to return an API error")
+			t.Fail()
+		} else if apiErr.StatusCode != http.StatusNotFound {
+			t.Logf("get shelf returned an unexpected status: %s", apiErr.Status)
 			t.Fail()
 		}
 	}
 	// attempting to get a book should return an error
 	{
-		_, err := b.GetBook(1, 2)
+		_, err := b.GetBook(ctx, 1, 2)
 		if err == nil {
 			t.Log("get book failed to return an error")
 			t.Fail()
@@ -73,7 +80,7 @@ func TestBookstore(t *testing.T) {
 	{
 		var shelf bookstore.Shelf
 		shelf.Theme = "mysteries"
-		response, err := b.CreateShelf(shelf)
+		response, err := b.CreateShelf(ctx, shelf)
 		if err != nil {
 			t.Log("create shelf mysteries failed")
 			t.Fail()
@@ -88,7 +95,7 @@ func TestBookstore(t *testing.T) {
 	{
 		var shelf bookstore.Shelf
 		shelf.Theme = "comedies"
-		response, err := b.CreateShelf(shelf)
+		response, err := b.CreateShelf(ctx, shelf)
 		if err != nil {
 			t.Log("create shelf comedies failed")
 			t.Fail()
@@ -101,7 +108,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// get the first shelf that was added
 	{
-		response, err := b.GetShelf(1)
+		response, err := b.GetShelf(ctx, 1)
 		if err != nil {
 			t.Log("get shelf mysteries failed")
 			t.Fail()
@@ -114,7 +121,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// list shelves and verify that there are 2
 	{
-		response, err := b.ListShelves()
+		response, err := b.ListShelves(ctx)
 		if err != nil {
 			t.Log("list shelves failed")
 			t.Fail()
@@ -126,7 +133,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// delete a shelf
 	{
-		err := b.DeleteShelf(2)
+		err := b.DeleteShelf(ctx, 2)
 		if err != nil {
 			t.Log("delete shelf failed")
 			t.Fail()
@@ -134,7 +141,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// list shelves and verify that there is only 1
 	{
-		response, err := b.ListShelves()
+		response, err := b.ListShelves(ctx)
 		if err != nil {
 			t.Log("list shelves failed")
 			t.Fail()
@@ -146,7 +153,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// list books on a shelf, verify that there are none
 	{
-		response, err := b.ListBooks(1)
+		response, err := b.ListBooks(ctx, 1)
 		if err != nil {
 			t.Log("list books failed")
 			t.Fail()
@@ -161,7 +168,7 @@ func TestBookstore(t *testing.T) {
 		var book bookstore.Book
 		book.Author = "Agatha Christie"
 		book.Title = "And Then There Were None"
-		_, err := b.CreateBook(1, book)
+		_, err := b.CreateBook(ctx, 1, book)
 		if err != nil {
 			t.Log("create book failed")
 			t.Fail()
@@ -172,7 +179,7 @@ func TestBookstore(t *testing.T) {
 		var book bookstore.Book
 		book.Author = "Agatha Christie"
 		book.Title = "Murder on the Orient Express"
-		_, err := b.CreateBook(1, book)
+		_, err := b.CreateBook(ctx, 1, book)
 		if err != nil {
 			t.Log("create book failed")
 			t.Fail()
@@ -180,7 +187,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// get the first book that was added
 	{
-		_, err := b.GetBook(1, 1)
+		_, err := b.GetBook(ctx, 1, 1)
 		if err != nil {
 			t.Log("get book failed")
 			t.Fail()
@@ -188,7 +195,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// list the books on a shelf and verify that there are 2
 	{
-		response, err := b.ListBooks(1)
+		response, err := b.ListBooks(ctx, 1)
 		if err != nil {
 			t.Log("list books failed")
 			t.Fail()
@@ -200,7 +207,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// delete a book
 	{
-		err := b.DeleteBook(1, 2)
+		err := b.DeleteBook(ctx, 1, 2)
 		if err != nil {
 			t.Log("delete book failed")
 			t.Fail()
@@ -208,7 +215,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// list the books on a shelf and verify that is only 1
 	{
-		response, err := b.ListBooks(1)
+		response, err := b.ListBooks(ctx, 1)
 		if err != nil {
 			t.Log("list books failed")
 			t.Fail()
@@ -220,7 +227,7 @@ func TestBookstore(t *testing.T) {
 	}
 	// verify the handling of a badly-formed request
 	{
-		req, err := http.NewRequest("POST", service+"/shelves", strings.NewReader(""))
+		req, err := http.NewRequest("POST", service+"/shelves?key=key", strings.NewReader(""))
 		if err != nil {
 			t.Log("bad request failed")
 			return
@@ -22,12 +22,11 @@ package main
 
 import (
 	"log"
-
-	"github.com/googleapis/gnostic/plugins/gnostic-go-generator/examples/v2.0/bookstore/bookstore"
+	"net/http"
 )
 
 func main() {
-	err := bookstore.ServeHTTP(":8080")
+	err := http.ListenAndServe(":8080", nil)
 	if err != nil {
 		log.Printf("%v", err)
 	}
@@ -17,6 +17,7 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -29,11 +30,12 @@ const service = "http://localhost:8080"
 
 func TestSample(t *testing.T) {
 	// create a client
-	s := sample.NewClient(service, nil)
+	s := sample.NewClient(sample.WithBaseURL(service), sample.WithAPIKey("api_key", "key"))
+	ctx := context.Background()
 	// verify a sample request
 	{
 		message := "hello world"
-		response, err := s.GetSample(message)
+		response, err := s.GetSample(ctx, message)
 		if err != nil {
 			t.Log("get sample failed")
 			t.Fail()
@@ -17,11 +17,13 @@
 package main
 
 import (
+	"net/http"
+
 	"github.com/googleapis/gnostic/plugins/gnostic-go-generator/examples/v2.0/sample/sample"
 )
 
 // init() is called when the package is loaded
 // this allows this app to be trivially deployed to Google App Engine, which does not call main()
 func init() {
-	sample.Initialize(NewService())
+	http.Handle("/", sample.NewHandler(NewService()))
 }
@@ -1,21 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/googleapis/gnostic/plugins/gnostic-go-generator/examples/v2.0/xkcd/xkcd"
 )
 
 func main() {
-	c := xkcd.NewClient("http://xkcd.com")
+	c := xkcd.NewClient(xkcd.WithBaseURL("http://xkcd.com"))
+	ctx := context.Background()
 
-	comic, err := c.Get_info_0_json()
+	comic, err := c.Get_info_0_json(ctx)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf("%+v\n", comic)
 
-	comic, err = c.Get_comicId_info_0_json(1800)
+	comic, err = c.Get_comicId_info_0_json(ctx, 1800)
 	if err != nil {
 		panic(err)
 	}
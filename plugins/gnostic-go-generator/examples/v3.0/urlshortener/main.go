@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -27,11 +28,12 @@ Usage:
 	if err != nil {
 		log.Fatalf("Error building OAuth client: %v", err)
 	}
-	c := urlshortener.NewClient(path, client)
+	c := urlshortener.NewClient(urlshortener.WithBaseURL(path), urlshortener.WithHTTPClient(client))
+	ctx := context.Background()
 
 	// get
 	if arguments["get"].(bool) {
-		response, err := c.Urlshortener_Url_Get("FULL", arguments["<url>"].(string))
+		response, err := c.Urlshortener_Url_Get(ctx, "FULL", arguments["<url>"].(string))
 		if err != nil {
 			log.Fatalf("%+v", err)
 		}
@@ -40,7 +42,7 @@ Usage:
 
 	// list
 	if arguments["list"].(bool) {
-		response, err := c.Urlshortener_Url_List("", "")
+		response, err := c.Urlshortener_Url_List(ctx, "", "")
 		if err != nil {
 			log.Fatalf("%+v", err)
 		}
@@ -53,7 +55,7 @@ Usage:
 	if arguments["insert"].(bool) {
 		var url urlshortener.Url
 		url.LongUrl = arguments["<url>"].(string)
-		response, err := c.Urlshortener_Url_Insert(url)
+		response, err := c.Urlshortener_Url_Insert(ctx, url)
 		if err != nil {
 			log.Fatalf("%+v", err)
 		}
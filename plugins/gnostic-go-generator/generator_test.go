@@ -0,0 +1,279 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	openapiv2 "github.com/googleapis/gnostic/OpenAPIv2"
+	openapiv3 "github.com/googleapis/gnostic/OpenAPIv3"
+	"github.com/googleapis/gnostic/compiler"
+	plugins "github.com/googleapis/gnostic/plugins"
+	surface "github.com/googleapis/gnostic/surface"
+)
+
+// The generated code of each test is written to a module with this name,
+// in a package with the name of the generated package.
+const (
+	testModuleName  = "gentest"
+	testPackageName = "api"
+)
+
+// readTestModel builds a surface model from an OpenAPI description.
+func readTestModel(t *testing.T, filename string) *surface.Model {
+	bytes, err := compiler.ReadBytesForFile(filename)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	info, err := compiler.ReadInfoFromBytes(filename, bytes)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var model *surface.Model
+	if m, ok := compiler.UnpackMap(info); ok && compiler.MapHasKey(m, "swagger") {
+		document, err := openapiv2.NewDocument(info, compiler.NewContext("$root", nil))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		model, _, err = surface.NewModelFromOpenAPI2WithInfo(document, info)
+	} else {
+		document, err := openapiv3.NewDocument(info, compiler.NewContext("$root", nil))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		model, _, err = surface.NewModelFromOpenAPI3WithInfo(document, filename, info)
+	}
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return model
+}
+
+// generateTestCode generates code for the API in testdata/name/openapi.yaml with the
+// specified plugin parameters and returns the files that the plugin would write.
+func generateTestCode(t *testing.T, name string, parameters ...*plugins.Parameter) []*plugins.File {
+	model := readTestModel(t, filepath.Join("testdata", name, "openapi.yaml"))
+	providerStyle, err := resolveProviderStyle(parameters)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	mock, err := resolveMock(parameters)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	files := []string{"client.go", "server.go", "provider.go", "types.go", "constants.go"}
+	if mock {
+		files = append(files, "mock.go")
+	}
+	language := NewGoLanguageModel()
+	language.ProviderStyle = providerStyle
+	language.Prepare(model)
+	renderer, err := NewServiceRenderer(model)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	renderer.Package = testPackageName
+	renderer.ProviderStyle = providerStyle
+	renderer.ImportPath = testModuleName + "/" + testPackageName
+	response := &plugins.Response{}
+	err = renderer.Render(response, files)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(response.Errors) > 0 {
+		t.Fatalf("%s", strings.Join(response.Errors, "\n"))
+	}
+	return response.Files
+}
+
+// testGeneratedCode generates code for the API in testdata/name/openapi.yaml, adds the
+// other files in testdata/name to it, and runs their tests with the go tool.
+func testGeneratedCode(t *testing.T, name string, parameters ...*plugins.Parameter) {
+	if testing.Short() {
+		t.Skip("generated code is not tested in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go tool is not available")
+	}
+	moduleDir, err := ioutil.TempDir("", "gnostic-go-generator")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(moduleDir)
+	packageDir := filepath.Join(moduleDir, testPackageName)
+
+	writeTestFile(t, filepath.Join(moduleDir, "go.mod"), []byte("module "+testModuleName+"\n\ngo 1.22\n"))
+	for _, file := range generateTestCode(t, name, parameters...) {
+		writeTestFile(t, filepath.Join(packageDir, file.Name), file.Data)
+	}
+	testDir := filepath.Join("testdata", name)
+	err = filepath.Walk(testDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".go" {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(testDir, path)
+		if err != nil {
+			return err
+		}
+		writeTestFile(t, filepath.Join(packageDir, relativePath), data)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// go mod tidy adds the modules that generated code imports, such as golang.org/x/oauth2
+	for _, arguments := range [][]string{{"mod", "tidy"}, {"vet", "./..."}, {"test", "-count=1", "./..."}} {
+		cmd := exec.Command(goTool, arguments...)
+		cmd.Dir = moduleDir
+		cmd.Env = append(os.Environ(), "GOFLAGS=", "GOWORK=off", "GO111MODULE=on")
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("go %s failed: %v\n%s", strings.Join(arguments, " "), err, output)
+		}
+	}
+}
+
+// writeTestFile writes a file and the directories that contain it.
+func writeTestFile(t *testing.T, filename string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err := ioutil.WriteFile(filename, data, 0644); err != nil {
+		t.Fatalf("%+v", err)
+	}
+}
+
+// generatedCodeTests are the APIs in testdata whose generated code is tested
+// and the plugin parameters that their code is generated with.
+var generatedCodeTests = []struct {
+	name       string
+	parameters []*plugins.Parameter
+}{
+	{name: "client"},
+	{name: "enums"},
+	{name: "errors"},
+	{name: "mock", parameters: []*plugins.Parameter{{Name: "mock", Value: "true"}}},
+	{name: "parameters"},
+	{name: "providers", parameters: []*plugins.Parameter{{Name: "style", Value: "v2"}}},
+	{name: "responses"},
+	{name: "security"},
+	{name: "validation"},
+}
+
+func TestGeneratedCode(t *testing.T) {
+	for _, test := range generatedCodeTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testGeneratedCode(t, test.name, test.parameters...)
+		})
+	}
+}
+
+func TestRenderReportsErrorsOfEveryFile(t *testing.T) {
+	// names that aren't identifiers can't be written in Go
+	model := &surface.Model{
+		Name: "Invalid",
+		Types: []*surface.Type{
+			{Name: "Invalid Type", Kind: surface.TypeKind_STRUCT},
+		},
+		Methods: []*surface.Method{
+			{Name: "Invalid Method", Path: "/invalid", Method: "GET"},
+		},
+	}
+	NewGoLanguageModel().Prepare(model)
+	renderer, err := NewServiceRenderer(model)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	renderer.Package = testPackageName
+	response := &plugins.Response{}
+	files := []string{"client.go", "provider.go", "types.go"}
+	if err = renderer.Render(response, files); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(response.Files) != len(files) {
+		t.Errorf("Render returned %d files, expected %d", len(response.Files), len(files))
+	}
+	for _, filename := range files {
+		reported := false
+		for _, message := range response.Errors {
+			reported = reported || strings.HasPrefix(message, "ERROR "+filename+":")
+		}
+		if !reported {
+			t.Errorf("the errors of %s were not reported: %v", filename, response.Errors)
+		}
+	}
+}
+
+func TestMockPackage(t *testing.T) {
+	// programs that use an API shouldn't import the packages of tests with it
+	for _, file := range generateTestCode(t, "mock", &plugins.Parameter{Name: "mock", Value: "true"}) {
+		f, err := parser.ParseFile(token.NewFileSet(), file.Name, file.Data, parser.ImportsOnly)
+		if err != nil {
+			t.Fatalf("%s: %+v", file.Name, err)
+		}
+		inMockPackage := filepath.Dir(file.Name) == testPackageName+"mock"
+		if f.Name.Name != testPackageName && !inMockPackage {
+			t.Errorf("%s is in package %s, expected %s", file.Name, f.Name.Name, testPackageName)
+		}
+		for _, spec := range f.Imports {
+			path := strings.Trim(spec.Path.Value, `"`)
+			if !inMockPackage && (path == "testing" || path == "net/http/httptest") {
+				t.Errorf("%s imports %s", file.Name, path)
+			}
+		}
+	}
+}
+
+func TestResolveImportPath(t *testing.T) {
+	moduleDir, err := ioutil.TempDir("", "gnostic-go-generator")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	defer os.RemoveAll(moduleDir)
+	writeTestFile(t, filepath.Join(moduleDir, "go.mod"), []byte("module example.com/pets\n\ngo 1.22\n"))
+	outputPath := filepath.Join(moduleDir, "apis", "bookstore")
+
+	for _, test := range []struct {
+		description string
+		outputPath  string
+		parameters  []*plugins.Parameter
+		importPath  string
+	}{
+		{"a package in a module", outputPath, nil, "example.com/pets/apis/bookstore"},
+		{"a module root", moduleDir, nil, "example.com/pets"},
+		{"an import parameter", outputPath, []*plugins.Parameter{{Name: "import", Value: "example.com/bookstore"}}, "example.com/bookstore"},
+	} {
+		importPath, err := resolveImportPath(test.outputPath, test.parameters)
+		if err != nil {
+			t.Errorf("%s: %+v", test.description, err)
+		} else if importPath != test.importPath {
+			t.Errorf("%s: got import path %q, expected %q", test.description, importPath, test.importPath)
+		}
+	}
+}
@@ -21,43 +21,34 @@ import (
 	surface "github.com/googleapis/gnostic/surface"
 )
 
-type GoLanguageModel struct{}
+type GoLanguageModel struct {
+	ProviderStyle string // the style of the Provider interface, providerStyleV1 or providerStyleV2
+}
 
 func NewGoLanguageModel() *GoLanguageModel {
-	return &GoLanguageModel{}
+	return &GoLanguageModel{ProviderStyle: providerStyleV1}
 }
 
 // Prepare sets language-specific properties for all types and methods.
 func (language *GoLanguageModel) Prepare(model *surface.Model) {
+	// v2 providers return responses with types and constructors that are named for their methods
+	if language.ProviderStyle == providerStyleV2 {
+		for _, m := range model.Methods {
+			goGeneratedNames[goResponseTypeName(m.Name)] = true
+			for _, r := range m.Responses {
+				goGeneratedNames[goResponseConstructorName(m.Name, r.Code)] = true
+			}
+		}
+	}
 
 	for _, t := range model.Types {
 		// determine the type used for Go language implementation of the type
-		t.TypeName = strings.Title(filteredTypeName(t.Name))
+		t.TypeName = goTypeName(t.Name)
 
 		for _, f := range t.Fields {
 			f.FieldName = goFieldName(f.Name)
 			f.ParameterName = goParameterName(f.Name)
-			switch f.Type {
-			case "boolean":
-				f.NativeType = "bool"
-			case "number":
-				f.NativeType = "int"
-			case "integer":
-				switch f.Format {
-				case "int32":
-					f.NativeType = "int32"
-				case "int64":
-					f.NativeType = "int64"
-				default:
-					f.NativeType = "int64"
-				}
-			case "object":
-				f.NativeType = "interface{}"
-			case "string":
-				f.NativeType = "string"
-			default:
-				f.NativeType = strings.Title(filteredTypeName(f.Type))
-			}
+			f.NativeType = goNativeType(f.Type, f.Format)
 		}
 	}
 
@@ -68,6 +59,113 @@ func (language *GoLanguageModel) Prepare(model *surface.Model) {
 	}
 }
 
+// goNativeType returns the Go type used to represent a type in the surface model.
+func goNativeType(typeName, format string) string {
+	switch typeName {
+	case "boolean":
+		return "bool"
+	case "number":
+		switch format {
+		case "float":
+			return "float32"
+		default:
+			return "float64"
+		}
+	case "integer":
+		switch format {
+		case "int32":
+			return "int32"
+		case "int64":
+			return "int64"
+		default:
+			return "int64"
+		}
+	case "object":
+		return "interface{}"
+	case "string":
+		switch format {
+		case "binary":
+			return "[]byte"
+		default:
+			return "string"
+		}
+	default:
+		return goTypeName(typeName)
+	}
+}
+
+// goGeneratedNames are the exported names that generated packages declare for themselves.
+var goGeneratedNames = map[string]bool{
+	"APIError":            true,
+	"CallOption":          true,
+	"Client":              true,
+	"ClientOption":        true,
+	"Credentials":         true,
+	"CredentialsChecker":  true,
+	"DefaultErrorEncoder": true,
+	"ErrorEncoder":        true,
+	"HandlerOption":       true,
+	"Middleware":          true,
+	"NewClient":           true,
+	"NewHandler":          true,
+	"OAuthScopes":         true,
+	"Provider":            true,
+	"RequestEditorFunc":   true,
+	"ServerURL":           true,
+	"ServicePath":         true,
+	"ValidationError":     true,
+	"Violation":           true,
+}
+
+// goResponseTypeName returns the name of the type of the responses that v2 providers return for a method.
+func goResponseTypeName(methodName string) string {
+	return methodName + "Response"
+}
+
+// goResponseConstructorName returns the name of the function that creates a response of a method for a response code.
+func goResponseConstructorName(methodName, code string) string {
+	return methodName + goFieldName(code)
+}
+
+// goTypeName returns the Go name of a type in the surface model. Types that have
+// the names of declarations in generated packages are renamed to avoid them.
+func goTypeName(name string) string {
+	typeName := strings.Title(filteredTypeName(name))
+	if goGeneratedNames[typeName] {
+		typeName += "Type"
+	}
+	return typeName
+}
+
+// goFieldType returns the Go type of a field, which is a pointer, slice or map of its native type for fields of those kinds.
+func goFieldType(field *surface.Field) string {
+	switch field.Kind {
+	case surface.FieldKind_REFERENCE:
+		return "*" + field.NativeType
+	case surface.FieldKind_ARRAY:
+		return "[]" + field.NativeType
+	case surface.FieldKind_MAP:
+		return "map[string]" + field.NativeType
+	default:
+		return field.NativeType
+	}
+}
+
+// goEnumConstantName returns the name of the constant for a value of an enum type.
+func goEnumConstantName(typeName, value string) string {
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	name := typeName
+	for _, word := range words {
+		name += strings.Title(word)
+	}
+	if len(words) == 0 {
+		name += "Empty"
+	}
+	return name
+}
+
 func goParameterName(name string) string {
 	// lowercase first letter
 	a := []rune(name)
@@ -77,9 +175,9 @@ func goParameterName(name string) string {
 	name = strings.Replace(name, ".", "_", -1)
 	// replaces dashes with underscores
 	name = strings.Replace(name, "-", "_", -1)
-	// avoid reserved words
-	if name == "type" {
-		return "myType"
+	// avoid reserved words and the names of the other parameters of client methods
+	if name == "type" || name == "ctx" || name == "options" {
+		return "my" + strings.Title(name)
 	}
 	return name
 }
@@ -97,6 +195,15 @@ func goFieldName(name string) string {
 	return name
 }
 
+// goStatusCode returns the status code that servers write for a response code.
+// Ranges of status codes like "4XX" are written with the first codes of their ranges.
+func goStatusCode(code string) string {
+	if isStatusCodeRange(code) {
+		return code[:1] + "00"
+	}
+	return code
+}
+
 func snakeCaseToCamelCaseWithCapitalizedFirstLetter(snakeCase string) (camelCase string) {
 	isToUpper := false
 	for _, runeValue := range snakeCase {
@@ -20,7 +20,10 @@ import (
 	"encoding/json"
 	"errors"
 	"go/format"
+	"io/ioutil"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -36,6 +39,19 @@ func main() {
 	packageName, err := resolvePackageName(env.Request.OutputPath)
 	env.RespondAndExitIfError(err)
 
+	providerStyle, err := resolveProviderStyle(env.Request.Parameters)
+	env.RespondAndExitIfError(err)
+
+	mock, err := resolveMock(env.Request.Parameters)
+	env.RespondAndExitIfError(err)
+
+	// Mock providers are in a package that imports the generated package.
+	importPath := ""
+	if mock {
+		importPath, err = resolveImportPath(env.Request.OutputPath, env.Request.Parameters)
+		env.RespondAndExitIfError(err)
+	}
+
 	// Use the name used to run the plugin to decide which files to generate.
 	var files []string
 	switch {
@@ -47,6 +63,14 @@ func main() {
 		files = []string{"client.go", "server.go", "provider.go", "types.go", "constants.go"}
 	}
 
+	// Mock providers are generated with servers when the "mock" parameter is true.
+	if mock {
+		if strings.Contains(env.Invocation, "gnostic-go-client") {
+			env.RespondAndExitIfError(errors.New("mock providers can't be generated without server code"))
+		}
+		files = append(files, "mock.go")
+	}
+
 	for _, model := range env.Request.Models {
 		switch model.TypeUrl {
 		case "surface.v1.Model":
@@ -54,7 +78,9 @@ func main() {
 			err = proto.Unmarshal(model.Value, surfaceModel)
 			if err == nil {
 				// Customize the code surface model for Go
-				NewGoLanguageModel().Prepare(surfaceModel)
+				language := NewGoLanguageModel()
+				language.ProviderStyle = providerStyle
+				language.Prepare(surfaceModel)
 
 				modelJSON, _ := json.MarshalIndent(surfaceModel, "", "  ")
 				modelFile := &plugins.File{Name: "model.json", Data: modelJSON}
@@ -63,6 +89,8 @@ func main() {
 				// Create the renderer.
 				renderer, err := NewServiceRenderer(surfaceModel)
 				renderer.Package = packageName
+				renderer.ProviderStyle = providerStyle
+				renderer.ImportPath = importPath
 				env.RespondAndExitIfError(err)
 
 				// Run the renderer to generate files and add them to the response object.
@@ -78,6 +106,82 @@ func main() {
 	env.RespondAndExitIfError(err)
 }
 
+// resolveProviderStyle returns the style of the Provider interface that is selected with the "style" parameter,
+// which is "v1" for methods that fill in response structs and "v2" for methods that take contexts and return
+// responses. The default style is "v1".
+func resolveProviderStyle(parameters []*plugins.Parameter) (string, error) {
+	style := providerStyleV1
+	for _, parameter := range parameters {
+		if parameter.Name == "style" {
+			style = parameter.Value
+		}
+	}
+	if style != providerStyleV1 && style != providerStyleV2 {
+		return "", errors.New("invalid provider style " + style)
+	}
+	return style, nil
+}
+
+// resolveMock returns true if the "mock" parameter selects the generation of a mock provider.
+func resolveMock(parameters []*plugins.Parameter) (bool, error) {
+	for _, parameter := range parameters {
+		if parameter.Name == "mock" {
+			mock, err := strconv.ParseBool(parameter.Value)
+			if err != nil {
+				return false, errors.New("invalid mock parameter " + parameter.Value)
+			}
+			return mock, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveImportPath returns the import path of the package that is generated in a path. It is set
+// with the "import" parameter, or found with the module path in the go.mod file of the module that
+// contains the generated package.
+func resolveImportPath(p string, parameters []*plugins.Parameter) (string, error) {
+	for _, parameter := range parameters {
+		if parameter.Name == "import" {
+			return parameter.Value, nil
+		}
+	}
+	p, err := filepath.Abs(p)
+	if err != nil {
+		return "", err
+	}
+	for dir := p; ; dir = filepath.Dir(dir) {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			modulePath := modulePathForGoMod(data)
+			if modulePath == "" {
+				return "", errors.New("no module path in " + filepath.Join(dir, "go.mod"))
+			}
+			relativePath, err := filepath.Rel(dir, p)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modulePath, filepath.ToSlash(relativePath)), nil
+		}
+		if filepath.Dir(dir) == dir {
+			return "", errors.New("the import path of " + p + " is unknown; set it with the import parameter")
+		}
+	}
+}
+
+// modulePathForGoMod returns the module path that is declared in the contents of a go.mod file.
+func modulePathForGoMod(data []byte) string {
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			if modulePath, err := strconv.Unquote(fields[1]); err == nil {
+				return modulePath
+			}
+			return fields[1]
+		}
+	}
+	return ""
+}
+
 // resolvePackageName converts a path to a valid package name or
 // error if path can't be resolved or resolves to an invalid package name.
 func resolvePackageName(p string) (string, error) {
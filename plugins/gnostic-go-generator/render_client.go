@@ -15,17 +15,18 @@
 package main
 
 import (
+	"strconv"
 	"strings"
 
 	surface "github.com/googleapis/gnostic/surface"
 )
 
 // ParameterList returns a string representation of a method's parameters
-func ParameterList(parametersType *surface.Type) string {
+func (renderer *Renderer) ParameterList(parametersType *surface.Type) string {
 	result := ""
 	if parametersType != nil {
 		for _, field := range parametersType.Fields {
-			result += field.ParameterName + " " + field.NativeType + "," + "\n"
+			result += field.ParameterName + " " + renderer.fieldType(field) + "," + "\n"
 		}
 	}
 	return result
@@ -43,29 +44,75 @@ func (renderer *Renderer) RenderClient() ([]byte, error) {
 	f.WriteLine(`// Client represents an API client.`)
 	f.WriteLine(`type Client struct {`)
 	f.WriteLine(`  service string`)
-	f.WriteLine(`  APIKey string`)
 	f.WriteLine(`  client *http.Client`)
+	f.WriteLine(`  transport http.RoundTripper`)
+	f.WriteLine(`  userAgent string`)
+	f.WriteLine(`  headers http.Header`)
+	renderer.writeClientCredentialFields(f)
 	f.WriteLine(`}`)
 
+	f.WriteLine(`// ClientOption configures a Client.`)
+	f.WriteLine(`type ClientOption func(*Client)`)
+	writeConnectionOptions(f)
+	renderer.writeClientOptions(f)
+
+	if len(renderer.Model.Servers) > 0 {
+		renderer.writeServers(f)
+	}
 	f.WriteLine(`// NewClient creates an API client.`)
-	f.WriteLine(`func NewClient(service string, c *http.Client) *Client {`)
-	f.WriteLine(`	client := &Client{}`)
-	f.WriteLine(`	client.service = service`)
-	f.WriteLine(`  if c != nil {`)
-	f.WriteLine(`    client.client = c`)
-	f.WriteLine(`  } else {`)
-	f.WriteLine(`    client.client = http.DefaultClient`)
+	f.WriteLine(`// Clients that aren't given a base URL use ServicePath, the URL of the preferred server.`)
+	f.WriteLine(`func NewClient(options ...ClientOption) *Client {`)
+	f.WriteLine(`  client := &Client{`)
+	f.WriteLine(`    service: ServicePath,`)
+	f.WriteLine(`    client:  http.DefaultClient,`)
+	f.WriteLine(`    headers: make(http.Header),`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  for _, option := range options {`)
+	f.WriteLine(`    option(client)`)
 	f.WriteLine(`  }`)
-	f.WriteLine(`	return client`)
+	f.WriteLine(`  if client.transport != nil {`)
+	f.WriteLine(`    c := *client.client`)
+	f.WriteLine(`    c.Transport = client.transport`)
+	f.WriteLine(`    client.client = &c`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return client`)
 	f.WriteLine(`}`)
 
+	writeCallOptions(f, len(renderer.Model.SecuritySchemes) > 0)
+	writeAPIError(f)
+
+	if len(renderer.Model.SecuritySchemes) > 0 {
+		renderer.writeClientAuthorization(f)
+	}
+
+	if renderer.hasMultipartForms() {
+		f.WriteLine(`// writePart writes a part of a multipart request body.`)
+		f.WriteLine(`func writePart(writer *multipart.Writer, name, filename, contentType string, data []byte) error {`)
+		f.WriteLine(`  header := make(textproto.MIMEHeader)`)
+		f.WriteLine(`  disposition := fmt.Sprintf("form-data; name=%q", name)`)
+		f.WriteLine(`  if filename != "" {`)
+		f.WriteLine(`    disposition += fmt.Sprintf("; filename=%q", filename)`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`  header.Set("Content-Disposition", disposition)`)
+		f.WriteLine(`  header.Set("Content-Type", contentType)`)
+		f.WriteLine(`  part, err := writer.CreatePart(header)`)
+		f.WriteLine(`  if err != nil {`)
+		f.WriteLine(`    return err`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`  _, err = part.Write(data)`)
+		f.WriteLine(`  return err`)
+		f.WriteLine(`}`)
+	}
+
 	for _, method := range renderer.Model.Methods {
 		parametersType := renderer.Model.TypeWithTypeName(method.ParametersTypeName)
 		responsesType := renderer.Model.TypeWithTypeName(method.ResponsesTypeName)
 
-		f.WriteLine(commentForText(method.Description))
+		f.WriteLine(commentForMethod(method))
 		f.WriteLine(`func (client *Client) ` + method.ClientName + `(`)
-		f.WriteLine(ParameterList(parametersType) + `) (`)
+		f.WriteLine(`ctx context.Context,`)
+		f.WriteLine(renderer.ParameterList(parametersType) + `options ...CallOption,`)
+		f.WriteLine(`) (`)
 		if method.ResponsesTypeName == "" {
 			f.WriteLine(`err error,`)
 		} else {
@@ -79,100 +126,822 @@ func (renderer *Renderer) RenderClient() ([]byte, error) {
 		f.WriteLine(`path := client.service + "` + path + `"`)
 
 		if parametersType != nil {
-			if parametersType.HasFieldWithPosition(surface.Position_PATH) {
-				for _, field := range parametersType.Fields {
-					if field.Position == surface.Position_PATH {
-						f.WriteLine(`path = strings.Replace(path, "{` + field.Name + `}", fmt.Sprintf("%v", ` +
-							field.ParameterName + `), 1)`)
+			for _, field := range parametersType.Fields {
+				if field.Position == surface.Position_PATH {
+					// Values of reserved expansions like {+name} are sent without escaping.
+					escape := "url.PathEscape"
+					if strings.Contains(method.Path, "{+"+field.Name+"}") {
+						escape = ""
+					}
+					prefix := ""
+					switch field.Style {
+					case "label":
+						prefix = "."
+					case "matrix":
+						prefix = ";" + field.Name + "="
 					}
+					renderer.writeParameterValue(f, field, escape, func(name, v string) {
+						if prefix != "" {
+							v = strconv.Quote(prefix) + ` + ` + v
+						}
+						f.WriteLine(`path = strings.Replace(path, "{` + field.Name + `}", ` + v + `, 1)`)
+					})
 				}
 			}
 			if parametersType.HasFieldWithPosition(surface.Position_QUERY) {
 				f.WriteLine(`v := url.Values{}`)
 				for _, field := range parametersType.Fields {
 					if field.Position == surface.Position_QUERY {
-						if field.NativeType == "string" {
-							f.WriteLine(`if (` + field.ParameterName + ` != "") {`)
-							f.WriteLine(`  v.Set("` + field.Name + `", ` + field.ParameterName + `)`)
-							f.WriteLine(`}`)
-						}
+						renderer.writeParameterValue(f, field, "", func(name, v string) {
+							f.WriteLine(`v.Add(` + name + `, ` + v + `)`)
+						})
 					}
 				}
-				f.WriteLine(`if client.APIKey != "" {`)
-				f.WriteLine(`  v.Set("key", client.APIKey)`)
-				f.WriteLine(`}`)
 				f.WriteLine(`if len(v) > 0 {`)
 				f.WriteLine(`  path = path + "?" + v.Encode()`)
 				f.WriteLine(`}`)
 			}
 		}
 
-		if method.Method == "POST" {
-			f.WriteLine(`body := new(bytes.Buffer)`)
-			if parametersType != nil && parametersType.FieldWithPosition(surface.Position_BODY) != nil {
-				f.WriteLine(`json.NewEncoder(body).Encode(` + parametersType.FieldWithPosition(surface.Position_BODY).ParameterName + `)`)
-			}
-			f.WriteLine(`req, err := http.NewRequest("` + method.Method + `", path, body)`)
+		if renderer.writeRequestBody(f, method, parametersType) {
+			f.WriteLine(`req, err := http.NewRequest("` + method.Method + `", path, reqBody)`)
+			f.WriteLine(`if err != nil {return}`)
 			f.WriteLine(`reqHeaders := make(http.Header)`)
-			f.WriteLine(`reqHeaders.Set("Content-Type", "application/json")`)
+			f.WriteLine(`reqHeaders.Set("Content-Type", reqContentType)`)
 			f.WriteLine(`req.Header = reqHeaders`)
 		} else {
 			f.WriteLine(`req, err := http.NewRequest("` + method.Method + `", path, nil)`)
+			f.WriteLine(`if err != nil {return}`)
+		}
+		if parametersType != nil {
+			for _, field := range parametersType.Fields {
+				switch field.Position {
+				case surface.Position_HEADER:
+					renderer.writeParameterValue(f, field, "", func(name, v string) {
+						f.WriteLine(`req.Header.Add(` + name + `, ` + v + `)`)
+					})
+				case surface.Position_COOKIE:
+					renderer.writeParameterValue(f, field, "", func(name, v string) {
+						f.WriteLine(`req.AddCookie(&http.Cookie{Name: ` + name + `, Value: ` + v + `})`)
+					})
+				}
+			}
+		}
+		if len(method.SecurityRequirements) > 0 {
+			f.WriteLine(`resp, err := client.do(ctx, req, ` + securityRequirementsLiteral(method) + `, options)`)
+		} else {
+			f.WriteLine(`resp, err := client.do(ctx, req, nil, options)`)
 		}
-		f.WriteLine(`if err != nil {return}`)
-		f.WriteLine(`resp, err := client.client.Do(req)`)
 		f.WriteLine(`if err != nil {return}`)
 		f.WriteLine(`defer resp.Body.Close()`)
-		f.WriteLine(`if resp.StatusCode != 200 {`)
-
+		f.WriteLine(`respBody, err := ioutil.ReadAll(resp.Body)`)
+		f.WriteLine(`if err != nil {return}`)
+		f.WriteLine(`if resp.StatusCode < 200 || resp.StatusCode > 299 {`)
+		f.WriteLine(`  apiErr := &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Header: resp.Header, Body: respBody}`)
 		if responsesType != nil {
-			f.WriteLine(`	return nil, errors.New(resp.Status)`)
+			writeResponseSwitch(f, responsesType, false, func(responseField *surface.Field) {
+				writeResponseValue(f, responseField, `apiErr.Value`)
+			})
+			f.WriteLine(`  return nil, apiErr`)
 		} else {
-			f.WriteLine(`	return errors.New(resp.Status)`)
+			f.WriteLine(`  return apiErr`)
 		}
 		f.WriteLine(`}`)
 
 		if responsesType != nil {
-			f.WriteLine(`response = &` + responsesType.Name + `{}`)
-
-			f.WriteLine(`switch {`)
-			// first handle everything that isn't "default"
-			for _, responseField := range responsesType.Fields {
-				if responseField.Name != "default" {
-					f.WriteLine(`case resp.StatusCode == ` + responseField.Name + `:`)
-					f.WriteLine(`  body, err := ioutil.ReadAll(resp.Body)`)
-					f.WriteLine(`  if err != nil {return nil, err}`)
-					f.WriteLine(`  result := &` + responseField.NativeType + `{}`)
-					f.WriteLine(`  err = json.Unmarshal(body, result)`)
-					f.WriteLine(`  if err != nil {return nil, err}`)
-					f.WriteLine(`  response.` + responseField.FieldName + ` = result`)
-				}
+			f.WriteLine(`response = &` + responsesType.Name + `{Header: resp.Header}`)
+			writeResponseSwitch(f, responsesType, true, func(responseField *surface.Field) {
+				writeResponseResult(f, responseField)
+				f.WriteLine(`  response.` + responseField.FieldName + ` = result`)
+			})
+		}
+		f.WriteLine("return")
+		f.WriteLine("}")
+	}
+
+	return f.Bytes(), nil
+}
+
+// writeConnectionOptions writes the options that set where and how a client sends requests.
+func writeConnectionOptions(f *LineWriter) {
+	f.WriteLine(`// WithBaseURL sets the URL that a client sends requests to, which is usually the URL of a server of the API.`)
+	f.WriteLine(`func WithBaseURL(baseURL string) ClientOption {`)
+	f.WriteLine(`  return func(client *Client) {`)
+	f.WriteLine(`    client.service = strings.TrimSuffix(baseURL, "/")`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(`// WithHTTPClient sets the HTTP client that a client sends requests with.`)
+	f.WriteLine(`func WithHTTPClient(c *http.Client) ClientOption {`)
+	f.WriteLine(`  return func(client *Client) {`)
+	f.WriteLine(`    client.client = c`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(`// WithTransport sets the transport that a client sends requests with.`)
+	f.WriteLine(`// It replaces the transport of the HTTP client without modifying the HTTP client.`)
+	f.WriteLine(`func WithTransport(transport http.RoundTripper) ClientOption {`)
+	f.WriteLine(`  return func(client *Client) {`)
+	f.WriteLine(`    client.transport = transport`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(`// WithUserAgent sets the User-Agent header of the requests that a client sends.`)
+	f.WriteLine(`func WithUserAgent(userAgent string) ClientOption {`)
+	f.WriteLine(`  return func(client *Client) {`)
+	f.WriteLine(`    client.userAgent = userAgent`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(`// WithDefaultHeader adds a header to the requests that a client sends.`)
+	f.WriteLine(`// Headers that a request already has are not changed.`)
+	f.WriteLine(`func WithDefaultHeader(key, value string) ClientOption {`)
+	f.WriteLine(`  return func(client *Client) {`)
+	f.WriteLine(`    client.headers.Add(key, value)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+}
+
+// writeCallOptions writes the options of calls of client methods and the function that
+// sends requests with them. If authorize is true, requests are sent with the client's credentials.
+func writeCallOptions(f *LineWriter, authorize bool) {
+	f.WriteLine(`// CallOption configures a call of a client method.`)
+	f.WriteLine(`type CallOption func(*callOptions)`)
+	f.WriteLine(``)
+	f.WriteLine(`// callOptions holds the options of a call.`)
+	f.WriteLine(`type callOptions struct {`)
+	f.WriteLine(`  headers http.Header`)
+	f.WriteLine(`  timeout time.Duration`)
+	f.WriteLine(`  editors []RequestEditorFunc`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// RequestEditorFunc modifies a request before it is sent.`)
+	f.WriteLine(`// If it returns an error, the request is not sent and the call returns the error.`)
+	f.WriteLine(`type RequestEditorFunc func(ctx context.Context, req *http.Request) error`)
+	f.WriteLine(``)
+	f.WriteLine(`// WithHeader sets a header of the request of a call.`)
+	f.WriteLine(`func WithHeader(key, value string) CallOption {`)
+	f.WriteLine(`  return func(options *callOptions) {`)
+	f.WriteLine(`    if options.headers == nil {`)
+	f.WriteLine(`      options.headers = make(http.Header)`)
+	f.WriteLine(`    }`)
+	f.WriteLine(`    options.headers.Add(key, value)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(`// WithTimeout limits the time that a call can take, including the time to read its response.`)
+	f.WriteLine(`func WithTimeout(timeout time.Duration) CallOption {`)
+	f.WriteLine(`  return func(options *callOptions) {`)
+	f.WriteLine(`    options.timeout = timeout`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(`// WithRequestEditor adds a function that modifies the request of a call before it is sent.`)
+	f.WriteLine(`// Editors are called in the order that they are added, after all other options are applied.`)
+	f.WriteLine(`func WithRequestEditor(editor RequestEditorFunc) CallOption {`)
+	f.WriteLine(`  return func(options *callOptions) {`)
+	f.WriteLine(`    options.editors = append(options.editors, editor)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// cancelOnClose cancels the context of a call when its response body is closed.`)
+	f.WriteLine(`type cancelOnClose struct {`)
+	f.WriteLine(`  io.ReadCloser`)
+	f.WriteLine(`  cancel context.CancelFunc`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`func (body *cancelOnClose) Close() error {`)
+	f.WriteLine(`  err := body.ReadCloser.Close()`)
+	f.WriteLine(`  body.cancel()`)
+	f.WriteLine(`  return err`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// do sends the request of a call with the client's headers, the client's credentials for`)
+	f.WriteLine(`// one of the sets of security schemes in requirements, and the options of the call.`)
+	f.WriteLine(`func (client *Client) do(ctx context.Context, req *http.Request, requirements [][]string, options []CallOption) (*http.Response, error) {`)
+	f.WriteLine(`  call := &callOptions{}`)
+	f.WriteLine(`  for _, option := range options {`)
+	f.WriteLine(`    option(call)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  cancel := func() {}`)
+	f.WriteLine(`  if call.timeout > 0 {`)
+	f.WriteLine(`    ctx, cancel = context.WithTimeout(ctx, call.timeout)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  req = req.WithContext(ctx)`)
+	f.WriteLine(`  for key, values := range client.headers {`)
+	f.WriteLine(`    if _, ok := req.Header[key]; !ok {`)
+	f.WriteLine(`      req.Header[key] = values`)
+	f.WriteLine(`    }`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  if client.userAgent != "" {`)
+	f.WriteLine(`    req.Header.Set("User-Agent", client.userAgent)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  for key, values := range call.headers {`)
+	f.WriteLine(`    req.Header[key] = values`)
+	f.WriteLine(`  }`)
+	if authorize {
+		f.WriteLine(`  if err := client.authorize(ctx, req, requirements); err != nil {`)
+		f.WriteLine(`    cancel()`)
+		f.WriteLine(`    return nil, err`)
+		f.WriteLine(`  }`)
+	}
+	f.WriteLine(`  for _, editor := range call.editors {`)
+	f.WriteLine(`    if err := editor(ctx, req); err != nil {`)
+	f.WriteLine(`      cancel()`)
+	f.WriteLine(`      return nil, err`)
+	f.WriteLine(`    }`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  resp, err := client.client.Do(req)`)
+	f.WriteLine(`  if err != nil {`)
+	f.WriteLine(`    cancel()`)
+	f.WriteLine(`    return nil, err`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}`)
+	f.WriteLine(`  return resp, nil`)
+	f.WriteLine(`}`)
+}
+
+// writeServers writes a description of the servers of the API and a function that
+// returns their URLs with values substituted for their variables.
+func (renderer *Renderer) writeServers(f *LineWriter) {
+	f.WriteLine(`// serverVariable is a variable that can be substituted in the URL of a server.`)
+	f.WriteLine(`type serverVariable struct {`)
+	f.WriteLine(`	name         string`)
+	f.WriteLine(`	defaultValue string`)
+	f.WriteLine(`	enumValues   []string`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// servers are the servers that provide the API, in order of preference.`)
+	f.WriteLine(`var servers = []struct {`)
+	f.WriteLine(`	url       string`)
+	f.WriteLine(`	variables []serverVariable`)
+	f.WriteLine(`}{`)
+	for _, server := range renderer.Model.Servers {
+		variables := make([]string, 0)
+		for _, variable := range server.Variables {
+			enumValues := make([]string, 0)
+			for _, value := range variable.EnumValues {
+				enumValues = append(enumValues, strconv.Quote(value))
+			}
+			enumValuesLiteral := `nil`
+			if len(enumValues) > 0 {
+				enumValuesLiteral = `[]string{` + strings.Join(enumValues, `, `) + `}`
 			}
+			variables = append(variables,
+				`{`+strconv.Quote(variable.Name)+`, `+strconv.Quote(variable.DefaultValue)+`, `+enumValuesLiteral+`}`)
+		}
+		variablesLiteral := `nil`
+		if len(variables) > 0 {
+			variablesLiteral = `[]serverVariable{` + strings.Join(variables, `, `) + `}`
+		}
+		f.WriteLine(`	{` + strconv.Quote(strings.TrimSuffix(server.Url, "/")) + `, ` + variablesLiteral + `},`)
+	}
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// ServerURL returns the URL of a server of the API, where server 0 is the preferred server.`)
+	f.WriteLine(`// Variables in the URL are replaced with the specified values or with their default values.`)
+	f.WriteLine(`func ServerURL(index int, variables map[string]string) (string, error) {`)
+	f.WriteLine(`	if index < 0 || index >= len(servers) {`)
+	f.WriteLine(`		return "", fmt.Errorf("there is no server %d", index)`)
+	f.WriteLine(`	}`)
+	f.WriteLine(`	serverURL := servers[index].url`)
+	f.WriteLine(`	for name := range variables {`)
+	f.WriteLine(`		if !strings.Contains(serverURL, "{"+name+"}") {`)
+	f.WriteLine(`			return "", fmt.Errorf("server %d has no variable %s", index, name)`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`	}`)
+	f.WriteLine(`	for _, variable := range servers[index].variables {`)
+	f.WriteLine(`		value, ok := variables[variable.name]`)
+	f.WriteLine(`		if !ok {`)
+	f.WriteLine(`			value = variable.defaultValue`)
+	f.WriteLine(`		} else if len(variable.enumValues) > 0 {`)
+	f.WriteLine(`			allowed := false`)
+	f.WriteLine(`			for _, enumValue := range variable.enumValues {`)
+	f.WriteLine(`				allowed = allowed || value == enumValue`)
+	f.WriteLine(`			}`)
+	f.WriteLine(`			if !allowed {`)
+	f.WriteLine(`				return "", fmt.Errorf("%q is not an allowed value of server variable %s", value, variable.name)`)
+	f.WriteLine(`			}`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`		serverURL = strings.Replace(serverURL, "{"+variable.name+"}", value, -1)`)
+	f.WriteLine(`	}`)
+	f.WriteLine(`	return serverURL, nil`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+}
 
-			// then handle "default"
-			hasDefault := false
-			for _, responseField := range responsesType.Fields {
-				if responseField.Name == "default" {
-					hasDefault = true
-					f.WriteLine(`default:`)
-					f.WriteLine(`  defer resp.Body.Close()`)
-					f.WriteLine(`  body, err := ioutil.ReadAll(resp.Body)`)
-					f.WriteLine(`  if err != nil {return nil, err}`)
-					f.WriteLine(`  result := &` + responseField.NativeType + `{}`)
-					f.WriteLine(`  err = json.Unmarshal(body, result)`)
-					f.WriteLine(`  if err != nil {return nil, err}`)
-					f.WriteLine(`  response.` + responseField.FieldName + ` = result`)
-				}
+// writeClientCredentialFields writes the fields that hold the credentials of a client.
+func (renderer *Renderer) writeClientCredentialFields(f *LineWriter) {
+	if renderer.hasSecurityScheme(isAPIKeyScheme) {
+		f.WriteLine(`  apiKeys map[string]string`)
+	}
+	if renderer.hasSecurityScheme(isBasicScheme) {
+		f.WriteLine(`  username string`)
+		f.WriteLine(`  password string`)
+	}
+	if renderer.hasSecurityScheme(isBearerScheme) {
+		f.WriteLine(`  token string`)
+	}
+	if renderer.hasSecurityScheme(isTokenSourceScheme) {
+		f.WriteLine(`  tokenSource oauth2.TokenSource`)
+	}
+}
+
+// writeClientOptions writes the options that set the credentials of a client.
+// Options are only written for the kinds of credentials that the API uses.
+func (renderer *Renderer) writeClientOptions(f *LineWriter) {
+	if renderer.hasSecurityScheme(isAPIKeyScheme) {
+		f.WriteLine(`// WithAPIKey sets the key that a client sends for an API key security scheme.`)
+		f.WriteLine(`func WithAPIKey(scheme, key string) ClientOption {`)
+		f.WriteLine(`  return func(client *Client) {`)
+		f.WriteLine(`    if client.apiKeys == nil {`)
+		f.WriteLine(`      client.apiKeys = make(map[string]string)`)
+		f.WriteLine(`    }`)
+		f.WriteLine(`    client.apiKeys[scheme] = key`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`}`)
+	}
+	if renderer.hasSecurityScheme(isBasicScheme) {
+		f.WriteLine(`// WithBasicAuth sets the username and password that a client sends for basic authentication.`)
+		f.WriteLine(`func WithBasicAuth(username, password string) ClientOption {`)
+		f.WriteLine(`  return func(client *Client) {`)
+		f.WriteLine(`    client.username = username`)
+		f.WriteLine(`    client.password = password`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`}`)
+	}
+	if renderer.hasSecurityScheme(isBearerScheme) {
+		f.WriteLine(`// WithBearerToken sets a token that a client sends for bearer, OAuth2 and OpenID Connect security schemes.`)
+		f.WriteLine(`func WithBearerToken(token string) ClientOption {`)
+		f.WriteLine(`  return func(client *Client) {`)
+		f.WriteLine(`    client.token = token`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`}`)
+	}
+	if renderer.hasSecurityScheme(isTokenSourceScheme) {
+		f.WriteLine(`// WithTokenSource sets a source of the tokens that a client sends for OAuth2 and OpenID Connect security schemes.`)
+		f.WriteLine(`// Tokens from the source are used instead of a token that is set with WithBearerToken.`)
+		f.WriteLine(`func WithTokenSource(tokenSource oauth2.TokenSource) ClientOption {`)
+		f.WriteLine(`  return func(client *Client) {`)
+		f.WriteLine(`    client.tokenSource = tokenSource`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`}`)
+	}
+}
+
+// writeClientAuthorization writes the functions that add a client's credentials to requests.
+func (renderer *Renderer) writeClientAuthorization(f *LineWriter) {
+	f.WriteLine(`// hasCredentials returns true if the client has credentials for a security scheme.`)
+	f.WriteLine(`func (client *Client) hasCredentials(scheme string) bool {`)
+	f.WriteLine(`  switch scheme {`)
+	for _, s := range renderer.Model.SecuritySchemes {
+		switch {
+		case isAPIKeyScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    return client.apiKeys[scheme] != ""`)
+		case isBasicScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    return client.username != ""`)
+		case isTokenSourceScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    return client.tokenSource != nil || client.token != ""`)
+		case isBearerScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    return client.token != ""`)
+		}
+	}
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return false`)
+	f.WriteLine(`}`)
+
+	if renderer.hasSecurityScheme(isTokenSourceScheme) {
+		f.WriteLine(`// sourceToken gets a token from the client's token source. Token sources don't take contexts,`)
+		f.WriteLine(`// so the token is fetched in another goroutine and sourceToken returns when the context of the call is done.`)
+		f.WriteLine(`func (client *Client) sourceToken(ctx context.Context) (*oauth2.Token, error) {`)
+		f.WriteLine(`  type result struct {`)
+		f.WriteLine(`    token *oauth2.Token`)
+		f.WriteLine(`    err   error`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`  results := make(chan result, 1)`)
+		f.WriteLine(`  go func() {`)
+		f.WriteLine(`    token, err := client.tokenSource.Token()`)
+		f.WriteLine(`    results <- result{token: token, err: err}`)
+		f.WriteLine(`  }()`)
+		f.WriteLine(`  select {`)
+		f.WriteLine(`  case r := <-results:`)
+		f.WriteLine(`    return r.token, r.err`)
+		f.WriteLine(`  case <-ctx.Done():`)
+		f.WriteLine(`    return nil, ctx.Err()`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`}`)
+	}
+
+	f.WriteLine(`// addCredentials adds the client's credentials for a security scheme to a request.`)
+	f.WriteLine(`func (client *Client) addCredentials(ctx context.Context, req *http.Request, scheme string) error {`)
+	f.WriteLine(`  switch scheme {`)
+	for _, s := range renderer.Model.SecuritySchemes {
+		switch {
+		case isAPIKeyScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			switch s.Position {
+			case surface.Position_QUERY:
+				f.WriteLine(`    v := req.URL.Query()`)
+				f.WriteLine(`    v.Set(` + strconv.Quote(s.ParameterName) + `, client.apiKeys[scheme])`)
+				f.WriteLine(`    req.URL.RawQuery = v.Encode()`)
+			case surface.Position_COOKIE:
+				f.WriteLine(`    req.AddCookie(&http.Cookie{Name: ` + strconv.Quote(s.ParameterName) + `, Value: client.apiKeys[scheme]})`)
+			default:
+				f.WriteLine(`    req.Header.Set(` + strconv.Quote(s.ParameterName) + `, client.apiKeys[scheme])`)
 			}
-			if !hasDefault {
-				f.WriteLine(`default:`)
-				f.WriteLine(`  break`)
+		case isBasicScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    req.SetBasicAuth(client.username, client.password)`)
+		case isTokenSourceScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    if client.tokenSource != nil {`)
+			f.WriteLine(`      token, err := client.sourceToken(ctx)`)
+			f.WriteLine(`      if err != nil {`)
+			f.WriteLine(`        return err`)
+			f.WriteLine(`      }`)
+			f.WriteLine(`      token.SetAuthHeader(req)`)
+			f.WriteLine(`    } else {`)
+			f.WriteLine(`      req.Header.Set("Authorization", "Bearer " + client.token)`)
+			f.WriteLine(`    }`)
+		case isBearerScheme(s):
+			f.WriteLine(`  case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`    req.Header.Set("Authorization", "Bearer " + client.token)`)
+		}
+	}
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return nil`)
+	f.WriteLine(`}`)
+
+	f.WriteLine(`// authorize adds credentials to a request for the first set of security schemes`)
+	f.WriteLine(`// that the client has credentials for. Requests that the client has no credentials for`)
+	f.WriteLine(`// are sent without them.`)
+	f.WriteLine(`func (client *Client) authorize(ctx context.Context, req *http.Request, requirements [][]string) error {`)
+	f.WriteLine(`  for _, schemes := range requirements {`)
+	f.WriteLine(`    satisfied := true`)
+	f.WriteLine(`    for _, scheme := range schemes {`)
+	f.WriteLine(`      satisfied = satisfied && client.hasCredentials(scheme)`)
+	f.WriteLine(`    }`)
+	f.WriteLine(`    if satisfied {`)
+	f.WriteLine(`      for _, scheme := range schemes {`)
+	f.WriteLine(`        if err := client.addCredentials(ctx, req, scheme); err != nil {`)
+	f.WriteLine(`          return err`)
+	f.WriteLine(`        }`)
+	f.WriteLine(`      }`)
+	f.WriteLine(`      return nil`)
+	f.WriteLine(`    }`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return nil`)
+	f.WriteLine(`}`)
+}
+
+// securityRequirementsLiteral returns a Go expression for the names of the schemes of each of a method's security requirements.
+func securityRequirementsLiteral(method *surface.Method) string {
+	requirements := make([]string, 0)
+	for _, requirement := range method.SecurityRequirements {
+		names := make([]string, 0)
+		for _, scheme := range requirement.Schemes {
+			names = append(names, strconv.Quote(scheme.Name))
+		}
+		requirements = append(requirements, `{`+strings.Join(names, `, `)+`}`)
+	}
+	return `[][]string{` + strings.Join(requirements, `, `) + `}`
+}
+
+// writeAPIError writes the type of the errors that client methods return for responses that aren't successful.
+func writeAPIError(f *LineWriter) {
+	f.WriteLine(`// APIError is returned by client methods when a request fails with a status code that isn't 2XX.`)
+	f.WriteLine(`// Use errors.As to get it from the errors of client methods.`)
+	f.WriteLine(`type APIError struct {`)
+	f.WriteLine(`  StatusCode int         // the status code of the response`)
+	f.WriteLine(`  Status     string      // the status of the response, such as "404 Not Found"`)
+	f.WriteLine(`  Header     http.Header // the headers of the response`)
+	f.WriteLine(`  Body       []byte      // the body of the response`)
+	f.WriteLine(`  // Value is the decoded body of the response if the API describes the response for its status code,`)
+	f.WriteLine(`  // such as a pointer to an error type, and is nil if it doesn't or if the body can't be decoded.`)
+	f.WriteLine(`  Value interface{}`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`func (e *APIError) Error() string {`)
+	f.WriteLine(`  text := strings.TrimSpace(string(e.Body))`)
+	f.WriteLine(`  if text == "" {`)
+	f.WriteLine(`    return e.Status`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return e.Status + ": " + text`)
+	f.WriteLine(`}`)
+}
+
+// writeResponseSwitch writes a switch statement on the status code of a response that calls write
+// for each of the responses of a method that are (or aren't) successful. Default responses are
+// used for status codes that don't have their own responses.
+func writeResponseSwitch(f *LineWriter, responsesType *surface.Type, successful bool, write func(responseField *surface.Field)) {
+	var defaultField *surface.Field
+	cases := make([]*surface.Field, 0)
+	for _, responseField := range responsesType.Fields {
+		if responseField.Name == "default" {
+			defaultField = responseField
+		} else if strings.HasPrefix(responseField.Name, "2") == successful {
+			cases = append(cases, responseField)
+		}
+	}
+	if len(cases) == 0 && defaultField == nil {
+		return
+	}
+	f.WriteLine(`switch {`)
+	for _, responseField := range cases {
+		f.WriteLine(`case ` + statusCondition(responseField.Name) + `:`)
+		write(responseField)
+	}
+	if defaultField != nil {
+		f.WriteLine(`default:`)
+		write(defaultField)
+	}
+	f.WriteLine(`}`)
+}
+
+// statusCondition returns an expression that is true if the status code of a response matches a response code,
+// which is either a status code or a range of status codes like "4XX".
+func statusCondition(code string) string {
+	if isStatusCodeRange(code) {
+		return `resp.StatusCode >= ` + code[:1] + `00 && resp.StatusCode <= ` + code[:1] + `99`
+	}
+	return `resp.StatusCode == ` + code
+}
+
+// writeResponseValue writes code that decodes the body of a response into target,
+// which is left unchanged if the body can't be decoded.
+func writeResponseValue(f *LineWriter, responseField *surface.Field, target string) {
+	switch {
+	case responseField.Kind == surface.FieldKind_ARRAY || responseField.Kind == surface.FieldKind_MAP:
+		f.WriteLine(`  var result ` + goFieldType(responseField))
+		f.WriteLine(`  if json.Unmarshal(respBody, &result) == nil {`)
+		f.WriteLine(`    ` + target + ` = result`)
+		f.WriteLine(`  }`)
+	case responseField.NativeType == "[]byte":
+		f.WriteLine(`  ` + target + ` = &respBody`)
+	case responseField.NativeType == "string":
+		f.WriteLine(`  text := string(respBody)`)
+		f.WriteLine(`  ` + target + ` = &text`)
+	default:
+		f.WriteLine(`  result := new(` + responseField.NativeType + `)`)
+		f.WriteLine(`  if json.Unmarshal(respBody, result) == nil {`)
+		f.WriteLine(`    ` + target + ` = result`)
+		f.WriteLine(`  }`)
+	}
+}
+
+// writeResponseResult writes code that sets result to the contents of a response body.
+// Text and binary bodies are used as they are, and other bodies, including arrays and maps, are read as JSON.
+func writeResponseResult(f *LineWriter, responseField *surface.Field) {
+	switch {
+	case responseField.Kind == surface.FieldKind_ARRAY || responseField.Kind == surface.FieldKind_MAP:
+		f.WriteLine(`  var result ` + goFieldType(responseField))
+		f.WriteLine(`  err = json.Unmarshal(respBody, &result)`)
+		f.WriteLine(`  if err != nil {return nil, err}`)
+	case responseField.NativeType == "[]byte":
+		f.WriteLine(`  result := &respBody`)
+	case responseField.NativeType == "string":
+		f.WriteLine(`  text := string(respBody)`)
+		f.WriteLine(`  result := &text`)
+	default:
+		f.WriteLine(`  result := new(` + responseField.NativeType + `)`)
+		f.WriteLine(`  err = json.Unmarshal(respBody, result)`)
+		f.WriteLine(`  if err != nil {return nil, err}`)
+	}
+}
+
+// writeRequestBody writes code that sets reqBody and reqContentType for the body of a request.
+// It returns false if the request has no body.
+func (renderer *Renderer) writeRequestBody(f *LineWriter, method *surface.Method, parametersType *surface.Type) bool {
+	if parametersType == nil {
+		return false
+	}
+	bodyField := parametersType.FieldWithPosition(surface.Position_BODY)
+	if fields, mediaType := renderer.formFields(method, parametersType); mediaType != "" {
+		value := func(field *surface.Field) string {
+			if bodyField != nil {
+				return bodyField.ParameterName + "." + field.FieldName
 			}
-			f.WriteLine(`}`) // close switch statement
+			return field.ParameterName
 		}
-		f.WriteLine("return")
-		f.WriteLine("}")
+		if mediaType == "multipart/form-data" {
+			contentTypes := partContentTypes(method, mediaType)
+			f.WriteLine(`reqBody := new(bytes.Buffer)`)
+			f.WriteLine(`reqWriter := multipart.NewWriter(reqBody)`)
+			for _, field := range fields {
+				name := strconv.Quote(field.Name)
+				renderer.writeFormValue(f, field, value(field), func(kind int, v string) {
+					switch kind {
+					case formValueText:
+						f.WriteLine(`err = reqWriter.WriteField(` + name + `, string(` + v + `))`)
+					case formValueScalar:
+						f.WriteLine(`err = reqWriter.WriteField(` + name + `, fmt.Sprint(` + v + `))`)
+					case formValueBytes:
+						contentType := contentTypes[field.Name]
+						if contentType == "" {
+							contentType = "application/octet-stream"
+						}
+						f.WriteLine(`err = writePart(reqWriter, ` + name + `, ` + name + `, "` + contentType + `", ` + v + `)`)
+					case formValueJSON:
+						contentType := contentTypes[field.Name]
+						if contentType == "" {
+							contentType = "application/json"
+						}
+						f.WriteLine(`var data []byte`)
+						f.WriteLine(`data, err = json.Marshal(` + v + `)`)
+						f.WriteLine(`if err != nil {return}`)
+						f.WriteLine(`err = writePart(reqWriter, ` + name + `, "", "` + contentType + `", data)`)
+					}
+					f.WriteLine(`if err != nil {return}`)
+				})
+			}
+			f.WriteLine(`err = reqWriter.Close()`)
+			f.WriteLine(`if err != nil {return}`)
+			f.WriteLine(`reqContentType := reqWriter.FormDataContentType()`)
+		} else {
+			f.WriteLine(`reqForm := url.Values{}`)
+			for _, field := range fields {
+				name := strconv.Quote(field.Name)
+				renderer.writeFormValue(f, field, value(field), func(kind int, v string) {
+					switch kind {
+					case formValueText, formValueBytes:
+						f.WriteLine(`reqForm.Add(` + name + `, string(` + v + `))`)
+					case formValueScalar:
+						f.WriteLine(`reqForm.Add(` + name + `, fmt.Sprint(` + v + `))`)
+					case formValueJSON:
+						f.WriteLine(`var data []byte`)
+						f.WriteLine(`data, err = json.Marshal(` + v + `)`)
+						f.WriteLine(`if err != nil {return}`)
+						f.WriteLine(`reqForm.Add(` + name + `, string(data))`)
+					}
+				})
+			}
+			f.WriteLine(`reqBody := strings.NewReader(reqForm.Encode())`)
+			f.WriteLine(`reqContentType := "` + mediaType + `"`)
+		}
+		return true
+	}
+	if bodyField == nil {
+		return false
+	}
+	switch {
+	case bodyField.Kind == surface.FieldKind_SCALAR && bodyField.NativeType == "[]byte":
+		f.WriteLine(`reqBody := bytes.NewReader(` + bodyField.ParameterName + `)`)
+	case bodyField.Kind == surface.FieldKind_SCALAR && bodyField.NativeType == "string":
+		f.WriteLine(`reqBody := strings.NewReader(` + bodyField.ParameterName + `)`)
+	default:
+		f.WriteLine(`reqBody := new(bytes.Buffer)`)
+		f.WriteLine(`err = json.NewEncoder(reqBody).Encode(` + bodyField.ParameterName + `)`)
+		f.WriteLine(`if err != nil {return}`)
+	}
+	mediaType := bodyField.MediaType
+	if mediaType == "" {
+		mediaType = "application/json"
 	}
+	f.WriteLine(`reqContentType := "` + mediaType + `"`)
+	return true
+}
 
-	return f.Bytes(), nil
+// writeFormValue writes code that adds the value of a field to a form using add,
+// which is called with the way that the value is written and an expression for it.
+// Arrays of values that aren't written as JSON are added one element at a time unless their
+// style joins them into a single value, and optional values are skipped when they are empty.
+func (renderer *Renderer) writeFormValue(f *LineWriter, field *surface.Field, value string, add func(kind int, v string)) {
+	kind := renderer.formValueKind(field)
+	if isDelimitedArray(field, kind) {
+		f.WriteLine(`if len(` + value + `) > 0 {`)
+		writeItemTexts(f, value, func(item string) string { return textForValue(kind, item) })
+		add(formValueText, `strings.Join(texts, `+strconv.Quote(itemSeparator(field))+`)`)
+		f.WriteLine(`}`)
+		return
+	}
+	if field.Kind == surface.FieldKind_ARRAY && kind != formValueJSON {
+		f.WriteLine(`for _, item := range ` + value + ` {`)
+		add(kind, "item")
+		f.WriteLine(`}`)
+		return
+	}
+	if condition := renderer.valueCondition(field, kind, value); condition != "" {
+		f.WriteLine(`if ` + condition + ` {`)
+	} else {
+		f.WriteLine(`{`)
+	}
+	if renderer.isOptionalScalar(field) {
+		value = `*` + value
+	}
+	add(kind, value)
+	f.WriteLine(`}`)
+}
+
+// writeParameterValue writes code that passes the value of a PATH, QUERY, HEADER or COOKIE parameter
+// to add as text, escaping it with the named function if escape is not empty. add is also passed an
+// expression for the name that the value is sent with. Exploded arrays and maps of QUERY and COOKIE
+// parameters are passed one item at a time, with maps passing their keys as names, and other arrays
+// and maps are joined with the separator of their style. Maps of values that can't be written as text
+// and other objects are passed as JSON, and optional values of parameters that aren't in paths are
+// skipped when they are empty.
+func (renderer *Renderer) writeParameterValue(f *LineWriter, field *surface.Field, escape string, add func(name, v string)) {
+	kind := renderer.formValueKind(field)
+	if field.Kind == surface.FieldKind_MAP {
+		kind = renderer.formValueKind(&surface.Field{NativeType: field.NativeType})
+	}
+	text := func(v string) string {
+		v = textForValue(kind, v)
+		if escape != "" {
+			v = escape + `(` + v + `)`
+		}
+		return v
+	}
+	name := strconv.Quote(field.Name)
+	value := field.ParameterName
+	separate := field.Explode && (field.Position == surface.Position_QUERY || field.Position == surface.Position_COOKIE)
+	// PATH parameters are always sent because their templates must be replaced.
+	if condition := renderer.valueCondition(field, kind, value); condition != "" && field.Position != surface.Position_PATH {
+		f.WriteLine(`if ` + condition + ` {`)
+	} else {
+		f.WriteLine(`{`)
+	}
+	if renderer.isOptionalScalar(field) {
+		value = `*` + value
+	}
+	switch {
+	case kind == formValueJSON || kind == formValueBytes && field.Kind == surface.FieldKind_MAP:
+		f.WriteLine(`var data []byte`)
+		f.WriteLine(`data, err = json.Marshal(` + value + `)`)
+		f.WriteLine(`if err != nil {return}`)
+		add(name, text(`data`))
+	case field.Kind == surface.FieldKind_MAP:
+		f.WriteLine(`keys := make([]string, 0, len(` + value + `))`)
+		f.WriteLine(`for key := range ` + value + ` {`)
+		f.WriteLine(`  keys = append(keys, key)`)
+		f.WriteLine(`}`)
+		f.WriteLine(`sort.Strings(keys)`)
+		if separate {
+			f.WriteLine(`for _, key := range keys {`)
+			add(`key`, text(value+`[key]`))
+			f.WriteLine(`}`)
+		} else {
+			// Exploded maps are sent as key=value pairs, and other maps alternate keys and values.
+			pair := `","`
+			if field.Explode {
+				pair = `"="`
+			}
+			key := `key`
+			if escape != "" {
+				key = escape + `(key)`
+			}
+			f.WriteLine(`texts := make([]string, 0, len(keys))`)
+			f.WriteLine(`for _, key := range keys {`)
+			f.WriteLine(`  texts = append(texts, ` + key + ` + ` + pair + ` + ` + text(value+`[key]`) + `)`)
+			f.WriteLine(`}`)
+			add(name, `strings.Join(texts, `+strconv.Quote(itemSeparator(field))+`)`)
+		}
+	case field.Kind == surface.FieldKind_ARRAY && separate:
+		f.WriteLine(`for _, item := range ` + value + ` {`)
+		add(name, text("item"))
+		f.WriteLine(`}`)
+	case field.Kind == surface.FieldKind_ARRAY:
+		writeItemTexts(f, value, text)
+		add(name, `strings.Join(texts, `+strconv.Quote(itemSeparator(field))+`)`)
+	default:
+		add(name, text(value))
+	}
+	f.WriteLine(`}`)
+}
+
+// writeItemTexts writes code that sets texts to the items of an array converted to text with text.
+func writeItemTexts(f *LineWriter, value string, text func(item string) string) {
+	f.WriteLine(`texts := make([]string, 0, len(` + value + `))`)
+	f.WriteLine(`for _, item := range ` + value + ` {`)
+	f.WriteLine(`  texts = append(texts, ` + text("item") + `)`)
+	f.WriteLine(`}`)
+}
+
+// textForValue returns an expression for the text of a value that is written in a way other than as JSON.
+func textForValue(kind int, v string) string {
+	switch kind {
+	case formValueScalar:
+		return `fmt.Sprint(` + v + `)`
+	default:
+		return `string(` + v + `)`
+	}
+}
+
+// valueCondition returns an expression that is true if an optional value of a field should be sent,
+// or an empty string if the value is always sent.
+func (renderer *Renderer) valueCondition(field *surface.Field, kind int, value string) string {
+	if field.Kind != surface.FieldKind_SCALAR || renderer.isOptionalScalar(field) {
+		return value + ` != nil`
+	}
+	if field.GetConstraints().GetRequired() {
+		return ""
+	}
+	switch {
+	case kind == formValueText:
+		return value + ` != ""`
+	case kind == formValueBytes:
+		return `len(` + value + `) > 0`
+	case kind == formValueScalar && field.NativeType == "bool":
+		return value
+	case kind == formValueScalar:
+		return value + ` != 0`
+	}
+	return ""
 }
@@ -14,6 +14,10 @@
 
 package main
 
+import (
+	"strconv"
+)
+
 func (renderer *Renderer) RenderConstants() ([]byte, error) {
 	f := NewLineWriter()
 	f.WriteLine("// GENERATED FILE: DO NOT EDIT!")
@@ -21,7 +25,11 @@ func (renderer *Renderer) RenderConstants() ([]byte, error) {
 	f.WriteLine("package " + renderer.Package)
 	f.WriteLine(``)
 	f.WriteLine(`// ServicePath is the base URL of the service.`)
-	f.WriteLine(`const ServicePath = "` + `"`)
+	if len(renderer.Model.Servers) > 0 {
+		f.WriteLine(`const ServicePath = ` + strconv.Quote(defaultServerURL(renderer.Model.Servers[0])))
+	} else {
+		f.WriteLine(`const ServicePath = "` + `"`)
+	}
 	f.WriteLine(``)
 	f.WriteLine(`// OAuthScopes lists the OAuth scopes required by the service.`)
 	f.WriteLine(`const OAuthScopes = "` + `"`)
@@ -0,0 +1,128 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// mockPackage returns the name of the package of mock providers, which is kept apart from the
+// generated package so that programs that use the API don't import the testing packages.
+func (renderer *Renderer) mockPackage() string {
+	return renderer.Package + "mock"
+}
+
+// RenderMock generates a Provider for tests that calls functions and records its calls.
+// If withClient is true, it also generates a function that serves the provider to a Client.
+func (renderer *Renderer) RenderMock(withClient bool) ([]byte, error) {
+	if renderer.ImportPath == "" {
+		return nil, errors.New("mock providers can't be generated without the import path of the generated package")
+	}
+	q := renderer.Package + "."
+	f := NewLineWriter()
+	f.WriteLine("// GENERATED FILE: DO NOT EDIT!")
+	f.WriteLine(``)
+	f.WriteLine("package " + renderer.mockPackage())
+	f.WriteLine(``)
+	f.WriteLine(`import ` + renderer.Package + ` ` + strconv.Quote(renderer.ImportPath))
+	f.WriteLine(``)
+	f.WriteLine(`// MockCall is a call of a method of a MockProvider.`)
+	f.WriteLine(`type MockCall struct {`)
+	f.WriteLine(`  Method     string      // the name of the method`)
+	f.WriteLine(`  Parameters interface{} // the parameters of the call, or nil if the method has none`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// MockProvider is a ` + q + `Provider for tests. Its methods record their calls and call the functions`)
+	f.WriteLine(`// in the fields that are named for them, and return errors if those functions aren't set.`)
+	f.WriteLine(`type MockProvider struct {`)
+	for _, method := range renderer.Model.Methods {
+		parameters, results := renderer.providerMethodSignature(method, q)
+		f.WriteLine(`  ` + method.ProcessorName + `Func func(` + strings.Join(parameters, `, `) + `) ` + results)
+	}
+	if len(renderer.Model.SecuritySchemes) > 0 {
+		f.WriteLine(`  // CheckCredentialsFunc checks the credentials of requests. All credentials are accepted if it isn't set.`)
+		f.WriteLine(`  CheckCredentialsFunc func(r *http.Request, credentials []*` + q + `Credentials) error`)
+	}
+	f.WriteLine(``)
+	f.WriteLine(`  mutex sync.Mutex`)
+	f.WriteLine(`  calls []MockCall`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// Calls returns the calls of the methods of a provider in the order that they were made.`)
+	f.WriteLine(`func (m *MockProvider) Calls() []MockCall {`)
+	f.WriteLine(`  m.mutex.Lock()`)
+	f.WriteLine(`  defer m.mutex.Unlock()`)
+	f.WriteLine(`  return append([]MockCall(nil), m.calls...)`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// record adds a call to the calls of a provider.`)
+	f.WriteLine(`func (m *MockProvider) record(method string, parameters interface{}) {`)
+	f.WriteLine(`  m.mutex.Lock()`)
+	f.WriteLine(`  defer m.mutex.Unlock()`)
+	f.WriteLine(`  m.calls = append(m.calls, MockCall{Method: method, Parameters: parameters})`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	for _, method := range renderer.Model.Methods {
+		parameters, results := renderer.providerMethodSignature(method, q)
+		arguments := make([]string, 0, len(parameters))
+		for _, parameter := range parameters {
+			arguments = append(arguments, strings.Fields(parameter)[0])
+		}
+		recorded := `nil`
+		if renderer.Model.TypeWithTypeName(method.ParametersTypeName) != nil {
+			recorded = `parameters`
+		}
+		function := method.ProcessorName + `Func`
+		f.WriteLine(`// ` + method.ProcessorName + ` records the call and calls ` + function + `.`)
+		f.WriteLine(`func (m *MockProvider) ` + method.ProcessorName + `(` + strings.Join(parameters, `, `) + `) ` + results + ` {`)
+		f.WriteLine(`  m.record(` + strconv.Quote(method.ProcessorName) + `, ` + recorded + `)`)
+		f.WriteLine(`  if m.` + function + ` == nil {`)
+		if renderer.ProviderStyle == providerStyleV2 {
+			f.WriteLine(`    return nil, errors.New("MockProvider.` + function + ` is not set")`)
+		} else {
+			f.WriteLine(`    return errors.New("MockProvider.` + function + ` is not set")`)
+		}
+		f.WriteLine(`  }`)
+		f.WriteLine(`  return m.` + function + `(` + strings.Join(arguments, `, `) + `)`)
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+	}
+	if len(renderer.Model.SecuritySchemes) > 0 {
+		f.WriteLine(`// CheckCredentials calls CheckCredentialsFunc if it is set.`)
+		f.WriteLine(`func (m *MockProvider) CheckCredentials(r *http.Request, credentials []*` + q + `Credentials) error {`)
+		f.WriteLine(`  if m.CheckCredentialsFunc == nil {`)
+		f.WriteLine(`    return nil`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`  return m.CheckCredentialsFunc(r, credentials)`)
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+	}
+	if withClient {
+		f.WriteLine(`// NewMockClient starts an httptest.Server that serves the API with a provider, such as a MockProvider,`)
+		f.WriteLine(`// and returns a ` + q + `Client that sends requests to it. The server is closed when the test completes.`)
+		if len(renderer.Model.SecuritySchemes) > 0 {
+			f.WriteLine(`// Methods that require credentials need options that add them to requests.`)
+		}
+		f.WriteLine(`func NewMockClient(t testing.TB, provider ` + q + `Provider, options ...` + q + `ClientOption) *` + q + `Client {`)
+		f.WriteLine(`  t.Helper()`)
+		f.WriteLine(`  server := httptest.NewServer(` + q + `NewHandler(provider))`)
+		f.WriteLine(`  t.Cleanup(server.Close)`)
+		f.WriteLine(`  return ` + q + `NewClient(append([]` + q + `ClientOption{` + q + `WithBaseURL(server.URL)}, options...)...)`)
+		f.WriteLine(`}`)
+	}
+	return f.Bytes(), nil
+}
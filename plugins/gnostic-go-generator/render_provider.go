@@ -15,7 +15,10 @@
 package main
 
 import (
+	"strconv"
 	"strings"
+
+	surface "github.com/googleapis/gnostic/surface"
 )
 
 func (renderer *Renderer) RenderProvider() ([]byte, error) {
@@ -24,33 +27,178 @@ func (renderer *Renderer) RenderProvider() ([]byte, error) {
 	f.WriteLine("package " + renderer.Package)
 	f.WriteLine(``)
 	f.WriteLine(`// To create a server, first write a class that implements this interface.`)
-	f.WriteLine(`// Then pass an instance of it to Initialize().`)
+	f.WriteLine(`// Then pass an instance of it to NewHandler().`)
+	if renderer.ProviderStyle == providerStyleV2 {
+		f.WriteLine(`// Methods return responses that are created with the constructors of their response types,`)
+		f.WriteLine(`// or errors, which are written with the ErrorEncoder of the handler.`)
+	}
 	f.WriteLine(`type Provider interface {`)
 	for _, method := range renderer.Model.Methods {
-		parametersType := renderer.Model.TypeWithTypeName(method.ParametersTypeName)
-		responsesType := renderer.Model.TypeWithTypeName(method.ResponsesTypeName)
+		parameters, results := renderer.providerMethodSignature(method, ``)
 		f.WriteLine(``)
-		f.WriteLine(commentForText(method.Description))
-		if parametersType != nil {
-			if responsesType != nil {
-				f.WriteLine(method.ProcessorName +
-					`(parameters *` + parametersType.Name +
-					`, responses *` + responsesType.Name + `) (err error)`)
-			} else {
-				f.WriteLine(method.ProcessorName + `(parameters *` + parametersType.Name + `) (err error)`)
-			}
-		} else {
-			if responsesType != nil {
-				f.WriteLine(method.ProcessorName + `(responses *` + responsesType.Name + `) (err error)`)
-			} else {
-				f.WriteLine(method.ProcessorName + `() (err error)`)
-			}
-		}
+		f.WriteLine(commentForMethod(method))
+		f.WriteLine(method.ProcessorName + `(` + strings.Join(parameters, `, `) + `) ` + results)
 	}
 	f.WriteLine(`}`)
+	if renderer.ProviderStyle == providerStyleV2 {
+		for _, method := range renderer.Model.Methods {
+			renderer.writeResponseType(f, method)
+		}
+	}
+	if len(renderer.Model.SecuritySchemes) > 0 {
+		f.WriteLine(``)
+		f.WriteLine(`// Credentials hold the credentials that a request was sent with for a security scheme.`)
+		f.WriteLine(`type Credentials struct {`)
+		f.WriteLine(`  Scheme string // the name of the security scheme`)
+		f.WriteLine(`  APIKey string // for API key schemes, the key`)
+		f.WriteLine(`  Username string // for basic authentication, the username`)
+		f.WriteLine(`  Password string // for basic authentication, the password`)
+		f.WriteLine(`  Token string // for bearer, OAuth2 and OpenID Connect schemes, the token`)
+		f.WriteLine(`  Scopes []string // for OAuth2 and OpenID Connect schemes, the scopes that the method needs`)
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+		f.WriteLine(`// A Provider can also implement this interface to check the credentials of requests.`)
+		f.WriteLine(`// It is called before requests are handled with the credentials of one of the sets of`)
+		f.WriteLine(`// security schemes that the method accepts. Requests are rejected if it returns an error.`)
+		f.WriteLine(`type CredentialsChecker interface {`)
+		f.WriteLine(`  CheckCredentials(r *http.Request, credentials []*Credentials) error`)
+		f.WriteLine(`}`)
+	}
 	return f.Bytes(), nil
 }
 
+// providerMethodSignature returns the parameters of the Provider method for a method, with their names
+// and types, and its results. v1 methods take structs of parameters and responses and return errors,
+// and v2 methods take contexts and structs of parameters and return responses and errors. Generated
+// types are prefixed with qualifier, such as "bookstore.", in code outside of the generated package.
+func (renderer *Renderer) providerMethodSignature(method *surface.Method, qualifier string) (parameters []string, results string) {
+	parametersType := renderer.Model.TypeWithTypeName(method.ParametersTypeName)
+	responsesType := renderer.Model.TypeWithTypeName(method.ResponsesTypeName)
+	parameters = make([]string, 0)
+	if renderer.ProviderStyle == providerStyleV2 {
+		parameters = append(parameters, `ctx context.Context`)
+	}
+	if parametersType != nil {
+		parameters = append(parameters, `parameters *`+qualifier+parametersType.Name)
+	}
+	if renderer.ProviderStyle == providerStyleV2 {
+		return parameters, `(*` + qualifier + goResponseTypeName(method.Name) + `, error)`
+	}
+	if responsesType != nil {
+		parameters = append(parameters, `responses *`+qualifier+responsesType.Name)
+	}
+	return parameters, `(err error)`
+}
+
+// writeResponseType writes the type of the responses that v2 providers return for a method
+// and a constructor for each of the method's response codes.
+func (renderer *Renderer) writeResponseType(f *LineWriter, method *surface.Method) {
+	typeName := goResponseTypeName(method.Name)
+	responsesType := renderer.Model.TypeWithTypeName(method.ResponsesTypeName)
+	f.WriteLine(``)
+	f.WriteLine(`// ` + typeName + ` is a response of ` + method.Name + `. Create it with one of the functions`)
+	f.WriteLine(`// that are named for the response codes of ` + method.Name + `, then add any headers to Header.`)
+	f.WriteLine(`type ` + typeName + ` struct {`)
+	f.WriteLine(`  StatusCode  int         // the status code of the response`)
+	f.WriteLine(`  Header      http.Header // the headers of the response`)
+	f.WriteLine(`  contentType string`)
+	f.WriteLine(`  body        interface{}`)
+	f.WriteLine(`}`)
+	written := make(map[string]bool)
+	for _, response := range method.Responses {
+		if written[response.Code] {
+			continue
+		}
+		written[response.Code] = true
+		var bodyField *surface.Field
+		if responsesType != nil {
+			bodyField = responsesType.FieldWithName(goFieldName(response.Code))
+		}
+		parameters := make([]string, 0)
+		statusCode := response.Code
+		f.WriteLine(``)
+		switch {
+		case response.Code == "default":
+			f.WriteLine(`// ` + goResponseConstructorName(method.Name, response.Code) + ` returns a response of ` + method.Name + ` for a status code that has no other response.`)
+			parameters = append(parameters, `statusCode int`)
+			statusCode = `statusCode`
+		case isStatusCodeRange(response.Code):
+			f.WriteLine(`// ` + goResponseConstructorName(method.Name, response.Code) + ` returns a response of ` + method.Name + ` for a status code in ` + response.Code + `.`)
+			parameters = append(parameters, `statusCode int`)
+			statusCode = `statusCode`
+		default:
+			f.WriteLine(`// ` + goResponseConstructorName(method.Name, response.Code) + ` returns a response of ` + method.Name + ` with status code ` + response.Code + `.`)
+		}
+		if response.Description != "" {
+			f.WriteLine(commentForText(response.Description))
+		}
+		if bodyField != nil {
+			parameters = append(parameters, `body `+goFieldType(bodyField))
+		}
+		f.WriteLine(`func ` + goResponseConstructorName(method.Name, response.Code) + `(` + strings.Join(parameters, `, `) + `) *` + typeName + ` {`)
+		f.WriteLine(`  response := &` + typeName + `{StatusCode: ` + statusCode + `, Header: make(http.Header)}`)
+		if bodyField != nil {
+			f.WriteLine(`  if body != nil {`)
+			f.WriteLine(`    response.contentType = ` + strconv.Quote(responseMediaType(method, bodyField)))
+			f.WriteLine(`    response.body = body`)
+			f.WriteLine(`  }`)
+		}
+		f.WriteLine(`  return response`)
+		f.WriteLine(`}`)
+	}
+}
+
+// responseMediaType returns the media type that a response body is written with. Bodies that are
+// written as JSON are written with a JSON media type if the method has one for the response code.
+func responseMediaType(method *surface.Method, bodyField *surface.Field) string {
+	mediaType := ""
+	for _, response := range method.Responses {
+		if response.Code != bodyField.Name || response.Type != bodyField.Type || response.MediaType == "" {
+			continue
+		}
+		if mediaType == "" || bodyField.NativeType != "[]byte" && bodyField.NativeType != "string" &&
+			strings.Contains(response.MediaType, "json") && !strings.Contains(mediaType, "json") {
+			mediaType = response.MediaType
+		}
+	}
+	if mediaType != "" {
+		return mediaType
+	}
+	switch bodyField.NativeType {
+	case "[]byte":
+		return "application/octet-stream"
+	case "string":
+		return "text/plain"
+	}
+	return "application/json"
+}
+
+// commentForMethod returns a comment that describes a method with its description or,
+// if it has none, its summary, and that marks deprecated methods.
+func commentForMethod(method *surface.Method) string {
+	text := method.Description
+	if text == "" {
+		text = method.Summary
+	}
+	if docs := method.ExternalDocs; docs != nil && docs.Url != "" {
+		if text != "" {
+			text += "\n\n"
+		}
+		text += "See " + docs.Url
+		if docs.Description != "" {
+			text += " (" + docs.Description + ")"
+		}
+		text += "."
+	}
+	if method.Deprecated {
+		if text != "" {
+			text += "\n\n"
+		}
+		text += "Deprecated: " + method.Name + " should no longer be used."
+	}
+	return commentForText(text)
+}
+
 func commentForText(text string) string {
 	result := ""
 	lines := strings.Split(text, "\n")
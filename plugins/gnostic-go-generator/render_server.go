@@ -15,7 +15,10 @@
 package main
 
 import (
-	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
 
 	surface "github.com/googleapis/gnostic/surface"
 )
@@ -26,25 +29,53 @@ func (renderer *Renderer) RenderServer() ([]byte, error) {
 	f.WriteLine(``)
 	f.WriteLine("package " + renderer.Package)
 	f.WriteLine(``)
-	imports := []string{
-		"github.com/gorilla/mux",
-		"net/http",
+	if renderer.hasMultipartForms() {
+		f.WriteLine(`// readFormFile returns the contents of a file in a multipart form, or nil if there is none.`)
+		f.WriteLine(`func readFormFile(r *http.Request, name string) ([]byte, error) {`)
+		f.WriteLine(`	file, _, err := r.FormFile(name)`)
+		f.WriteLine(`	if err == http.ErrMissingFile || err == http.ErrNotMultipart {`)
+		f.WriteLine(`		return nil, nil`)
+		f.WriteLine(`	} else if err != nil {`)
+		f.WriteLine(`		return nil, err`)
+		f.WriteLine(`	}`)
+		f.WriteLine(`	defer file.Close()`)
+		f.WriteLine(`	return ioutil.ReadAll(file)`)
+		f.WriteLine(`}`)
+		f.WriteLine(``)
 	}
-	f.WriteLine(``)
-	f.WriteLine(`import (`)
-	for _, imp := range imports {
-		f.WriteLine(`"` + imp + `"`)
+	if len(renderer.Model.SecuritySchemes) > 0 {
+		renderer.writeServerAuthorization(f)
 	}
-	f.WriteLine(`)`)
-
-	f.WriteLine(`func intValue(s string) (v int64) {`)
-	f.WriteLine(`	v, _ = strconv.ParseInt(s, 10, 64)`)
-	f.WriteLine(`	return v`)
+	writeHandlerOptions(f)
+	renderer.writeValidationTypes(f)
+	if renderer.ProviderStyle == providerStyleV2 {
+		writeResponseWriter(f)
+	}
+	f.WriteLine(`// server serves API methods with a Provider.`)
+	f.WriteLine(`type server struct {`)
+	f.WriteLine(`  provider    Provider`)
+	f.WriteLine(`  encodeError ErrorEncoder`)
+	f.WriteLine(`  middleware  []Middleware`)
 	f.WriteLine(`}`)
 	f.WriteLine(``)
-	f.WriteLine(`// This package-global variable holds the user-written Provider for API services.`)
-	f.WriteLine(`// See the Provider interface for details.`)
-	f.WriteLine(`var provider Provider`)
+	f.WriteLine(`// NewHandler returns an http.Handler that serves the API with a provider.`)
+	f.WriteLine(`// Requests are routed to API methods by their methods and paths.`)
+	f.WriteLine(`func NewHandler(p Provider, options ...HandlerOption) http.Handler {`)
+	f.WriteLine(`  s := &server{provider: p, encodeError: DefaultErrorEncoder}`)
+	f.WriteLine(`  for _, option := range options {`)
+	f.WriteLine(`    option(s)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  mux := http.NewServeMux()`)
+	for _, method := range renderer.Model.Methods {
+		pattern, _ := servePattern(method)
+		f.WriteLine(`  mux.HandleFunc(` + strconv.Quote(pattern) + `, s.` + method.HandlerName + `)`)
+	}
+	f.WriteLine(`  var handler http.Handler = mux`)
+	f.WriteLine(`  for i := len(s.middleware) - 1; i >= 0; i-- {`)
+	f.WriteLine(`    handler = s.middleware[i](handler)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return handler`)
+	f.WriteLine(`}`)
 	f.WriteLine(``)
 	f.WriteLine(`// These handlers serve API methods.`)
 	f.WriteLine(``)
@@ -54,115 +85,473 @@ func (renderer *Renderer) RenderServer() ([]byte, error) {
 		responsesType := renderer.Model.TypeWithTypeName(method.ResponsesTypeName)
 
 		f.WriteLine(`// Handler`)
-		f.WriteLine(commentForText(method.Description))
-		f.WriteLine(`func ` + method.HandlerName + `(w http.ResponseWriter, r *http.Request) {`)
+		f.WriteLine(commentForMethod(method))
+		f.WriteLine(`func (s *server) ` + method.HandlerName + `(w http.ResponseWriter, r *http.Request) {`)
 		f.WriteLine(`  var err error`)
+		if len(method.SecurityRequirements) > 0 {
+			f.WriteLine(`if !s.authorize(w, r, ` + credentialsRequirementsLiteral(method) + `) {`)
+			f.WriteLine(`  return`)
+			f.WriteLine(`}`)
+		}
 		if parametersType != nil {
 			f.WriteLine(`// instantiate the parameters structure`)
 			f.WriteLine(`parameters := &` + parametersType.Name + `{}`)
-			if method.Method == "POST" && parametersType.FieldWithPosition(surface.Position_BODY) != nil {
-				f.WriteLine(`// deserialize request from post data`)
-				f.WriteLine(`decoder := json.NewDecoder(r.Body)`)
-				f.WriteLine(`err = decoder.Decode(&parameters.` +
-					parametersType.FieldWithPosition(surface.Position_BODY).FieldName + `)`)
-				f.WriteLine(`if err != nil {`)
-				f.WriteLine(`	w.WriteHeader(http.StatusBadRequest)`)
-				f.WriteLine(`	w.Write([]byte(err.Error() + "\n"))`)
-				f.WriteLine(`	return`)
-				f.WriteLine(`}`)
-			}
-			f.WriteLine(`// get request fields in path and query parameters`)
-			if parametersType.HasFieldWithPosition(surface.Position_PATH) {
-				f.WriteLine(`vars := mux.Vars(r)`)
+			f.WriteLine(`// collect the problems of values that don't satisfy the constraints of the API`)
+			f.WriteLine(`violations := &ValidationError{}`)
+			renderer.writeRequestBodyReader(f, method, parametersType)
+			f.WriteLine(`// get request fields in path, query, header and cookie parameters`)
+			renderer.writeParameterReaders(f, method, parametersType)
+			f.WriteLine(`if len(violations.Violations) > 0 {`)
+			f.WriteLine(`  s.encodeError(w, r, http.StatusBadRequest, violations)`)
+			f.WriteLine(`  return`)
+			f.WriteLine(`}`)
+		}
+		if renderer.ProviderStyle == providerStyleV2 {
+			renderer.writeProviderCall(f, method, parametersType)
+		} else {
+			if responsesType != nil {
+				f.WriteLine(`// instantiate the responses structure`)
+				f.WriteLine(`responses := &` + method.ResponsesTypeName + `{}`)
 			}
-			if parametersType.HasFieldWithPosition(surface.Position_FORMDATA) {
-				f.WriteLine(`r.ParseForm()`)
+			f.WriteLine(`// call the service provider`)
+			callLine := `err = s.provider.` + method.ProcessorName
+			if parametersType != nil {
+				if responsesType != nil {
+					callLine += `(parameters, responses)`
+				} else {
+					callLine += `(parameters)`
+				}
+			} else {
+				if responsesType != nil {
+					callLine += `(responses)`
+				} else {
+					callLine += `()`
+				}
 			}
-			for _, field := range parametersType.Fields {
-				if field.Position == surface.Position_PATH {
-					if field.Type == "string" {
-						f.WriteLine(fmt.Sprintf("// %+v", field))
-						f.WriteLine(`if value, ok := vars["` + field.Name + `"]; ok {`)
-						f.WriteLine(`	parameters.` + field.FieldName + ` = value`)
-						f.WriteLine(`}`)
-					} else {
-						f.WriteLine(`if value, ok := vars["` + field.Name + `"]; ok {`)
-						f.WriteLine(`	parameters.` + field.FieldName + ` = intValue(value)`)
-						f.WriteLine(`}`)
+			f.WriteLine(callLine)
+			f.WriteLine(`if err == nil {`)
+			if responsesType != nil {
+				f.WriteLine(`for key, values := range responses.Header {`)
+				f.WriteLine(`  w.Header()[key] = values`)
+				f.WriteLine(`}`)
+				if responsesType.HasFieldWithName("OK") {
+					f.WriteLine(`if responses.OK != nil {`)
+					f.WriteLine(`  // write the normal response`)
+					f.WriteLine(`  encoder := json.NewEncoder(w)`)
+					f.WriteLine(`  encoder.Encode(responses.OK)`)
+					f.WriteLine(`  return`)
+					f.WriteLine(`}`)
+				}
+				for _, responseField := range responsesType.Fields {
+					if responseField.Name == "200" || responseField.Name == "default" {
+						continue
 					}
-				} else if field.Position == surface.Position_FORMDATA {
-					f.WriteLine(`if len(r.Form["` + field.Name + `"]) > 0 {`)
-					f.WriteLine(`	parameters.` + field.FieldName + ` = intValue(r.Form["` + field.Name + `"][0])`)
+					f.WriteLine(`if responses.` + responseField.FieldName + ` != nil {`)
+					f.WriteLine(`  // write the ` + responseField.Name + ` response`)
+					f.WriteLine(`  w.WriteHeader(` + goStatusCode(responseField.Name) + `)`)
+					f.WriteLine(`  encoder := json.NewEncoder(w)`)
+					f.WriteLine(`  encoder.Encode(responses.` + responseField.FieldName + `)`)
+					f.WriteLine(`  return`)
+					f.WriteLine(`}`)
+				}
+				if responsesType.HasFieldWithName("Default") {
+					f.WriteLine(`if responses.Default != nil {`)
+					f.WriteLine(`  // write the error response`)
+					if responsesType.FieldWithName("Default").ServiceType(renderer.Model).FieldWithName("Code") != nil {
+						f.WriteLine(`  w.WriteHeader(int(responses.Default.Code))`)
+					}
+					f.WriteLine(`  encoder := json.NewEncoder(w)`)
+					f.WriteLine(`  encoder.Encode(responses.Default)`)
+					f.WriteLine(`  return`)
 					f.WriteLine(`}`)
 				}
 			}
+			f.WriteLine(`} else {`)
+			f.WriteLine(`  s.encodeError(w, r, http.StatusInternalServerError, err)`)
+			f.WriteLine(`  return`)
+			f.WriteLine(`}`)
 		}
-		if responsesType != nil {
-			f.WriteLine(`// instantiate the responses structure`)
-			f.WriteLine(`responses := &` + method.ResponsesTypeName + `{}`)
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+	}
+	return f.Bytes(), nil
+}
+
+// writeProviderCall writes code that calls the method of a v2 provider and writes the response that it returns.
+func (renderer *Renderer) writeProviderCall(f *LineWriter, method *surface.Method, parametersType *surface.Type) {
+	f.WriteLine(`// call the service provider`)
+	if parametersType != nil {
+		f.WriteLine(`response, err := s.provider.` + method.ProcessorName + `(r.Context(), parameters)`)
+	} else {
+		f.WriteLine(`response, err := s.provider.` + method.ProcessorName + `(r.Context())`)
+	}
+	f.WriteLine(`if err != nil {`)
+	f.WriteLine(`  s.encodeError(w, r, http.StatusInternalServerError, err)`)
+	f.WriteLine(`  return`)
+	f.WriteLine(`}`)
+	f.WriteLine(`if response == nil {`)
+	f.WriteLine(`  w.WriteHeader(http.StatusNoContent)`)
+	f.WriteLine(`  return`)
+	f.WriteLine(`}`)
+	f.WriteLine(`writeResponse(w, response.StatusCode, response.Header, response.contentType, response.body)`)
+}
+
+// writeResponseWriter writes the function that writes the responses that v2 providers return.
+func writeResponseWriter(f *LineWriter) {
+	f.WriteLine(`// writeResponse writes a response that a provider returned. Text and binary bodies are written`)
+	f.WriteLine(`// as they are, other bodies are written as JSON, and responses without status codes are written`)
+	f.WriteLine(`// with http.StatusOK.`)
+	f.WriteLine(`func writeResponse(w http.ResponseWriter, statusCode int, header http.Header, contentType string, body interface{}) {`)
+	f.WriteLine(`  for key, values := range header {`)
+	f.WriteLine(`    w.Header()[key] = values`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  if contentType != "" && w.Header().Get("Content-Type") == "" {`)
+	f.WriteLine(`    w.Header().Set("Content-Type", contentType)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  if statusCode == 0 {`)
+	f.WriteLine(`    statusCode = http.StatusOK`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  w.WriteHeader(statusCode)`)
+	f.WriteLine(`  switch body := body.(type) {`)
+	f.WriteLine(`  case nil:`)
+	f.WriteLine(`  case *[]byte:`)
+	f.WriteLine(`    w.Write(*body)`)
+	f.WriteLine(`  case *string:`)
+	f.WriteLine(`    w.Write([]byte(*body))`)
+	f.WriteLine(`  default:`)
+	f.WriteLine(`    json.NewEncoder(w).Encode(body)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+}
+
+// writeServerAuthorization writes the functions that check the credentials of requests.
+func (renderer *Renderer) writeServerAuthorization(f *LineWriter) {
+	if renderer.hasSecurityScheme(isBearerScheme) {
+		f.WriteLine(`// bearerToken returns the bearer token in the Authorization header of a request.`)
+		f.WriteLine(`func bearerToken(r *http.Request) string {`)
+		f.WriteLine(`	authorization := r.Header.Get("Authorization")`)
+		f.WriteLine(`	if len(authorization) > 7 && strings.EqualFold(authorization[:7], "Bearer ") {`)
+		f.WriteLine(`		return authorization[7:]`)
+		f.WriteLine(`	}`)
+		f.WriteLine(`	return ""`)
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+	}
+	f.WriteLine(`// credentialsForScheme returns the credentials that a request was sent with for a security scheme,`)
+	f.WriteLine(`// or nil if it has none.`)
+	f.WriteLine(`func credentialsForScheme(r *http.Request, scheme string) *Credentials {`)
+	f.WriteLine(`	switch scheme {`)
+	for _, s := range renderer.Model.SecuritySchemes {
+		switch {
+		case isAPIKeyScheme(s):
+			f.WriteLine(`	case ` + strconv.Quote(s.Name) + `:`)
+			switch s.Position {
+			case surface.Position_QUERY:
+				f.WriteLine(`		if key := r.URL.Query().Get(` + strconv.Quote(s.ParameterName) + `); key != "" {`)
+				f.WriteLine(`			return &Credentials{Scheme: scheme, APIKey: key}`)
+			case surface.Position_COOKIE:
+				f.WriteLine(`		if cookie, err := r.Cookie(` + strconv.Quote(s.ParameterName) + `); err == nil && cookie.Value != "" {`)
+				f.WriteLine(`			return &Credentials{Scheme: scheme, APIKey: cookie.Value}`)
+			default:
+				f.WriteLine(`		if key := r.Header.Get(` + strconv.Quote(s.ParameterName) + `); key != "" {`)
+				f.WriteLine(`			return &Credentials{Scheme: scheme, APIKey: key}`)
+			}
+			f.WriteLine(`		}`)
+		case isBasicScheme(s):
+			f.WriteLine(`	case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`		if username, password, ok := r.BasicAuth(); ok {`)
+			f.WriteLine(`			return &Credentials{Scheme: scheme, Username: username, Password: password}`)
+			f.WriteLine(`		}`)
+		case isBearerScheme(s):
+			f.WriteLine(`	case ` + strconv.Quote(s.Name) + `:`)
+			f.WriteLine(`		if token := bearerToken(r); token != "" {`)
+			f.WriteLine(`			return &Credentials{Scheme: scheme, Token: token}`)
+			f.WriteLine(`		}`)
 		}
-		f.WriteLine(`// call the service provider`)
-		callLine := `err = provider.` + method.ProcessorName
-		if parametersType != nil {
-			if responsesType != nil {
-				callLine += `(parameters, responses)`
-			} else {
-				callLine += `(parameters)`
+	}
+	f.WriteLine(`	}`)
+	f.WriteLine(`	return nil`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// authorize returns true if a request has credentials for one of the sets of security schemes`)
+	f.WriteLine(`// that a method accepts and, if the provider is a CredentialsChecker, the provider accepts them.`)
+	f.WriteLine(`// Other requests get an Unauthorized response.`)
+	f.WriteLine(`func (s *server) authorize(w http.ResponseWriter, r *http.Request, requirements [][]*Credentials) bool {`)
+	f.WriteLine(`	err := errors.New("missing credentials")`)
+	f.WriteLine(`	for _, requirement := range requirements {`)
+	f.WriteLine(`		if len(requirement) == 0 {`)
+	f.WriteLine(`			return true`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`		credentials := make([]*Credentials, 0)`)
+	f.WriteLine(`		for _, required := range requirement {`)
+	f.WriteLine(`			if c := credentialsForScheme(r, required.Scheme); c != nil {`)
+	f.WriteLine(`				c.Scopes = required.Scopes`)
+	f.WriteLine(`				credentials = append(credentials, c)`)
+	f.WriteLine(`			}`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`		if len(credentials) < len(requirement) {`)
+	f.WriteLine(`			continue`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`		checker, ok := s.provider.(CredentialsChecker)`)
+	f.WriteLine(`		if !ok {`)
+	f.WriteLine(`			return true`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`		if err = checker.CheckCredentials(r, credentials); err == nil {`)
+	f.WriteLine(`			return true`)
+	f.WriteLine(`		}`)
+	f.WriteLine(`	}`)
+	f.WriteLine(`	s.encodeError(w, r, http.StatusUnauthorized, err)`)
+	f.WriteLine(`	return false`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+}
+
+// credentialsRequirementsLiteral returns a Go expression for the credentials that each of a method's security requirements needs.
+func credentialsRequirementsLiteral(method *surface.Method) string {
+	requirements := make([]string, 0)
+	for _, requirement := range method.SecurityRequirements {
+		credentials := make([]string, 0)
+		for _, scheme := range requirement.Schemes {
+			c := `Scheme: ` + strconv.Quote(scheme.Name)
+			if len(scheme.Scopes) > 0 {
+				scopes := make([]string, 0)
+				for _, scope := range scheme.Scopes {
+					scopes = append(scopes, strconv.Quote(scope))
+				}
+				c += `, Scopes: []string{` + strings.Join(scopes, `, `) + `}`
 			}
+			credentials = append(credentials, `{`+c+`}`)
+		}
+		requirements = append(requirements, `{`+strings.Join(credentials, `, `)+`}`)
+	}
+	return `[][]*Credentials{` + strings.Join(requirements, `, `) + `}`
+}
+
+// writeRequestBodyReader writes code that reads the body of a request into the parameters of a method.
+// Bodies are read according to their content type, and FORMDATA parameters are read from forms.
+func (renderer *Renderer) writeRequestBodyReader(f *LineWriter, method *surface.Method, parametersType *surface.Type) {
+	bodyField := parametersType.FieldWithPosition(surface.Position_BODY)
+	if bodyField == nil {
+		fields, _ := renderer.formFields(method, parametersType)
+		if len(fields) == 0 {
+			return
+		}
+		f.WriteLine(`// read form parameters from the request body`)
+		f.WriteLine(`err = r.ParseMultipartForm(32 << 20)`)
+		f.WriteLine(`if err == http.ErrNotMultipart {`)
+		f.WriteLine(`	err = r.ParseForm()`)
+		f.WriteLine(`}`)
+		writeBadRequestCheck(f, `"body"`)
+		for _, field := range fields {
+			renderer.writeFormValueReader(f, field, `parameters.`+field.FieldName, strconv.Quote(field.Name), `""`, true)
+		}
+		return
+	}
+	target := `parameters.` + bodyField.FieldName
+	name := strconv.Quote(bodyField.Name)
+	f.WriteLine(`// deserialize the request body according to its content type`)
+	f.WriteLine(`mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))`)
+	f.WriteLine(`switch mediaType {`)
+	written := make(map[string]bool)
+	for _, requestBody := range method.RequestBodies {
+		if requestBody.Type != bodyField.Type || requestBody.Kind != bodyField.Kind ||
+			requestBody.Format != bodyField.Format || written[requestBody.MediaType] {
+			continue
+		}
+		written[requestBody.MediaType] = true
+		if requestBody.MediaType == bodyField.MediaType {
+			f.WriteLine(`case "", "` + requestBody.MediaType + `":`)
 		} else {
-			if responsesType != nil {
-				callLine += `(responses)`
+			f.WriteLine(`case "` + requestBody.MediaType + `":`)
+		}
+		bodyType := bodyField.ServiceType(renderer.Model)
+		switch {
+		case isFormMediaType(requestBody.MediaType) && bodyType != nil && bodyType.Kind == surface.TypeKind_STRUCT:
+			if bodyField.Kind == surface.FieldKind_REFERENCE {
+				f.WriteLine(target + ` = &` + bodyType.TypeName + `{}`)
+			}
+			multipart := requestBody.MediaType == "multipart/form-data"
+			if multipart {
+				f.WriteLine(`err = r.ParseMultipartForm(32 << 20)`)
 			} else {
-				callLine += `()`
+				f.WriteLine(`err = r.ParseForm()`)
 			}
-		}
-		f.WriteLine(callLine)
-		f.WriteLine(`if err == nil {`)
-		if responsesType != nil {
-			if responsesType.HasFieldWithName("OK") {
-				f.WriteLine(`if responses.OK != nil {`)
-				f.WriteLine(`  // write the normal response`)
-				f.WriteLine(`  encoder := json.NewEncoder(w)`)
-				f.WriteLine(`  encoder.Encode(responses.OK)`)
-				f.WriteLine(`  return`)
-				f.WriteLine(`}`)
+			f.WriteLine(`if err != nil {break}`)
+			for _, field := range encodedFields(bodyType.Fields, requestBody) {
+				renderer.writeFormValueReader(f, field, target+`.`+field.FieldName, name, strconv.Quote("/"+field.Name), multipart)
 			}
-			if responsesType.HasFieldWithName("Default") {
-				f.WriteLine(`if responses.Default != nil {`)
-				f.WriteLine(`  // write the error response`)
-				if responsesType.FieldWithName("Default").ServiceType(renderer.Model).FieldWithName("Code") != nil {
-					f.WriteLine(`  w.WriteHeader(int(responses.Default.Code))`)
-				}
-				f.WriteLine(`  encoder := json.NewEncoder(w)`)
-				f.WriteLine(`  encoder.Encode(responses.Default)`)
-				f.WriteLine(`  return`)
-				f.WriteLine(`}`)
+		case bodyField.Kind == surface.FieldKind_SCALAR && bodyField.NativeType == "[]byte":
+			f.WriteLine(target + `, err = ioutil.ReadAll(r.Body)`)
+		case bodyField.Kind == surface.FieldKind_SCALAR && bodyField.NativeType == "string":
+			f.WriteLine(`var data []byte`)
+			f.WriteLine(`data, err = ioutil.ReadAll(r.Body)`)
+			f.WriteLine(target + ` = string(data)`)
+		default:
+			f.WriteLine(`err = json.NewDecoder(r.Body).Decode(&` + target + `)`)
+			f.WriteLine(`if err == io.EOF {`)
+			if bodyField.GetConstraints().GetRequired() {
+				f.WriteLine(`	err = errors.New("missing request body")`)
+			} else {
+				f.WriteLine(`	err = nil`)
 			}
+			f.WriteLine(`}`)
 		}
+	}
+	f.WriteLine(`default:`)
+	f.WriteLine(`	s.encodeError(w, r, http.StatusUnsupportedMediaType, errors.New("unsupported media type " + mediaType))`)
+	f.WriteLine(`	return`)
+	f.WriteLine(`}`)
+	writeBadRequestCheck(f, name)
+	renderer.writeValueChecks(f, bodyField, target, name, `""`, true)
+}
+
+// writeFormValueReader writes code that reads the value of a field from a parsed form into target and
+// adds the problems of the value to violations with the parameter and pointer expressions. Files are
+// read from multipart forms. Values of FORMDATA parameters are checked here, and the properties of
+// form bodies are checked with their bodies.
+func (renderer *Renderer) writeFormValueReader(f *LineWriter, field *surface.Field, target, parameter, pointer string, multipart bool) {
+	kind := renderer.formValueKind(field)
+	name := strconv.Quote(field.Name)
+	formData := field.Position == surface.Position_FORMDATA
+	if multipart && kind == formValueBytes && field.Kind == surface.FieldKind_SCALAR {
+		f.WriteLine(`if value, err := readFormFile(r, ` + name + `); err != nil {`)
+		f.WriteLine(`  violations.add(` + parameter + `, ` + pointer + `, "%v", err)`)
 		f.WriteLine(`} else {`)
-		f.WriteLine(`  w.WriteHeader(http.StatusInternalServerError)`)
-		f.WriteLine(`  w.Write([]byte(err.Error() + "\n"))`)
-		f.WriteLine(`  return`)
+		f.WriteLine(`  ` + target + ` = value`)
 		f.WriteLine(`}`)
-		f.WriteLine(`}`)
-		f.WriteLine(``)
+		if formData && field.GetConstraints().GetRequired() {
+			f.WriteLine(`if ` + target + ` == nil {`)
+			f.WriteLine(`  violations.add(` + parameter + `, ` + pointer + `, "missing required parameter")`)
+			f.WriteLine(`}`)
+		}
+		return
 	}
-	f.WriteLine(`// Initialize the API service.`)
-	f.WriteLine(`func Initialize(p Provider) {`)
-	f.WriteLine(`  provider = p`)
-	f.WriteLine(`  var router = mux.NewRouter()`)
-	for _, method := range renderer.Model.Methods {
-		f.WriteLine(`router.HandleFunc("` + method.Path + `", ` + method.HandlerName + `).Methods("` + method.Method + `")`)
+	f.WriteLine(`if values := r.Form[` + name + `]; len(values) > 0 {`)
+	renderer.writeValuesReader(f, field, target, parameter, pointer)
+	if formData {
+		renderer.writeValueChecks(f, field, target, parameter, pointer, false)
+		if field.GetConstraints().GetRequired() {
+			f.WriteLine(`} else {`)
+			f.WriteLine(`  violations.add(` + parameter + `, ` + pointer + `, "missing required parameter")`)
+		}
 	}
-	f.WriteLine(`  http.Handle("/", router)`)
 	f.WriteLine(`}`)
+}
+
+// writeBadRequestCheck writes code that rejects a request with an error that was found while reading
+// the value of a parameter.
+func writeBadRequestCheck(f *LineWriter, parameter string) {
+	f.WriteLine(`if err != nil {`)
+	f.WriteLine(`	violations.add(` + parameter + `, "", "%v", err)`)
+	f.WriteLine(`	s.encodeError(w, r, http.StatusBadRequest, violations)`)
+	f.WriteLine(`	return`)
+	f.WriteLine(`}`)
+}
+
+// writeHandlerOptions writes the options that configure the handlers of generated servers.
+func writeHandlerOptions(f *LineWriter) {
+	f.WriteLine(`// Middleware wraps a handler, for example to log requests or to add values to their contexts.`)
+	f.WriteLine(`type Middleware func(http.Handler) http.Handler`)
+	f.WriteLine(``)
+	f.WriteLine(`// ErrorEncoder writes the response for an error that occurred while handling a request.`)
+	f.WriteLine(`// status describes the error, such as http.StatusBadRequest for requests that are invalid`)
+	f.WriteLine(`// and http.StatusInternalServerError for errors returned by providers.`)
+	f.WriteLine(`type ErrorEncoder func(w http.ResponseWriter, r *http.Request, status int, err error)`)
 	f.WriteLine(``)
-	f.WriteLine(`// Provide the API service over HTTP.`)
-	f.WriteLine(`func ServeHTTP(address string) error {`)
-	f.WriteLine(`  if provider == nil {`)
-	f.WriteLine(`    return errors.New("Use ` + renderer.Package + `.Initialize() to set a service provider.")`)
+	f.WriteLine(`// DefaultErrorEncoder writes the status and the text of an error.`)
+	f.WriteLine(`// The violations of a ValidationError are written as JSON.`)
+	f.WriteLine(`func DefaultErrorEncoder(w http.ResponseWriter, r *http.Request, status int, err error) {`)
+	f.WriteLine(`  var validationErr *ValidationError`)
+	f.WriteLine(`  if errors.As(err, &validationErr) {`)
+	f.WriteLine(`    w.Header().Set("Content-Type", "application/json")`)
+	f.WriteLine(`    w.WriteHeader(status)`)
+	f.WriteLine(`    json.NewEncoder(w).Encode(validationErr)`)
+	f.WriteLine(`    return`)
 	f.WriteLine(`  }`)
-	f.WriteLine(`  return http.ListenAndServe(address, nil)`)
+	f.WriteLine(`  w.WriteHeader(status)`)
+	f.WriteLine(`  w.Write([]byte(err.Error() + "\n"))`)
 	f.WriteLine(`}`)
-	return f.Bytes(), nil
+	f.WriteLine(``)
+	f.WriteLine(`// HandlerOption configures a handler that is returned by NewHandler.`)
+	f.WriteLine(`type HandlerOption func(*server)`)
+	f.WriteLine(``)
+	f.WriteLine(`// WithMiddleware wraps a handler with middleware. The first middleware is the outermost.`)
+	f.WriteLine(`func WithMiddleware(middleware ...Middleware) HandlerOption {`)
+	f.WriteLine(`  return func(s *server) {`)
+	f.WriteLine(`    s.middleware = append(s.middleware, middleware...)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// WithErrorEncoder sets the function that writes the responses for errors.`)
+	f.WriteLine(`func WithErrorEncoder(encoder ErrorEncoder) HandlerOption {`)
+	f.WriteLine(`  return func(s *server) {`)
+	f.WriteLine(`    s.encodeError = encoder`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+}
+
+// pathWildcard describes the wildcard of an http.ServeMux pattern that matches a path parameter.
+// Parameters in path segments that have other text are matched with the whole segment,
+// and the text before and after them is removed from the values of their wildcards.
+type pathWildcard struct {
+	name   string
+	prefix string
+	suffix string
+}
+
+// pathTemplatePattern matches the templates of parameters in paths, like {name} and {+name}.
+var pathTemplatePattern = regexp.MustCompile(`\{\+?([^}]+)\}`)
+
+// servePattern returns the pattern that a method is routed with by an http.ServeMux
+// and the wildcards of its path parameters, keyed by parameter name.
+func servePattern(method *surface.Method) (pattern string, wildcards map[string]pathWildcard) {
+	wildcards = make(map[string]pathWildcard)
+	used := make(map[string]bool)
+	segments := strings.Split(method.Path, "/")
+	for i, segment := range segments {
+		matches := pathTemplatePattern.FindAllStringSubmatchIndex(segment, -1)
+		if len(matches) == 0 {
+			continue
+		}
+		name := wildcardName(segment[matches[0][2]:matches[0][3]])
+		for n := 2; used[name]; n++ {
+			name = wildcardName(segment[matches[0][2]:matches[0][3]]) + strconv.Itoa(n)
+		}
+		used[name] = true
+		// segments with more than one parameter are matched but their parameters aren't read
+		if len(matches) == 1 {
+			wildcards[segment[matches[0][2]:matches[0][3]]] = pathWildcard{
+				name:   name,
+				prefix: segment[:matches[0][0]],
+				suffix: segment[matches[0][1]:],
+			}
+		}
+		// reserved expansions at the ends of paths can contain slashes
+		if i == len(segments)-1 && strings.HasPrefix(segment, "{+") && len(matches) == 1 && matches[0][1] == len(segment) {
+			segments[i] = "{" + name + "...}"
+		} else {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	path := strings.Join(segments, "/")
+	if strings.HasSuffix(path, "/") {
+		path += "{$}"
+	}
+	return method.Method + " " + path, wildcards
+}
+
+// wildcardName returns a name for a wildcard in an http.ServeMux pattern, which must be a Go identifier.
+func wildcardName(name string) string {
+	result := []rune(name)
+	for i, r := range result {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			result[i] = '_'
+		}
+	}
+	if len(result) == 0 || unicode.IsDigit(result[0]) {
+		return "_" + string(result)
+	}
+	return string(result)
 }
@@ -15,6 +15,9 @@
 package main
 
 import (
+	"strconv"
+	"strings"
+
 	surface "github.com/googleapis/gnostic/surface"
 )
 
@@ -29,19 +32,20 @@ func (renderer *Renderer) RenderTypes() ([]byte, error) {
 		if modelType.Kind == surface.TypeKind_STRUCT {
 			f.WriteLine(`type ` + modelType.TypeName + ` struct {`)
 			for _, field := range modelType.Fields {
-				prefix := ""
-				if field.Kind == surface.FieldKind_REFERENCE {
-					prefix = "*"
-				} else if field.Kind == surface.FieldKind_ARRAY {
-					prefix = "[]"
-				} else if field.Kind == surface.FieldKind_MAP {
-					prefix = "map[string]"
-				}
-				f.WriteLine(field.FieldName + ` ` + prefix + field.NativeType + jsonTag(field))
+				f.WriteLine(field.FieldName + ` ` + renderer.fieldType(field) + jsonTag(field))
+			}
+			if renderer.isResponsesType(modelType) {
+				f.WriteLine(`Header http.Header // the headers of the response`)
 			}
 			f.WriteLine(`}`)
 		} else if modelType.Kind == surface.TypeKind_OBJECT {
-			f.WriteLine(`type ` + modelType.TypeName + ` map[string]` + modelType.ContentType)
+			f.WriteLine(`type ` + modelType.TypeName + ` map[string]` + goNativeType(modelType.ContentType, ""))
+		} else if modelType.Kind == surface.TypeKind_LIST {
+			f.WriteLine(`type ` + modelType.TypeName + ` []` + goNativeType(modelType.ContentType, ""))
+		} else if modelType.Kind == surface.TypeKind_ENUM {
+			renderEnumType(f, modelType)
+		} else if modelType.Kind == surface.TypeKind_UNION {
+			renderer.renderUnionType(f, modelType)
 		} else {
 			f.WriteLine(`type ` + modelType.TypeName + ` interface {}`)
 		}
@@ -49,6 +53,152 @@ func (renderer *Renderer) RenderTypes() ([]byte, error) {
 	return f.Bytes(), nil
 }
 
+// isResponsesType returns true if a type holds the responses of a method. Responses types
+// also hold the headers of responses, which clients read and v1 providers write.
+func (renderer *Renderer) isResponsesType(modelType *surface.Type) bool {
+	for _, method := range renderer.Model.Methods {
+		if method.ResponsesTypeName == modelType.TypeName {
+			return true
+		}
+	}
+	return false
+}
+
+// renderEnumType writes an enum type with a constant for each of its values
+// and an IsValid() method that checks that a value is one of them.
+func renderEnumType(f *LineWriter, modelType *surface.Type) {
+	f.WriteLine(`type ` + modelType.TypeName + ` ` + goNativeType(modelType.ContentType, ""))
+	f.WriteLine(``)
+	constantNames := make([]string, 0)
+	used := make(map[string]bool)
+	f.WriteLine(`const (`)
+	for _, value := range modelType.EnumValues {
+		constantName := goEnumConstantName(modelType.TypeName, value)
+		for i := 2; used[constantName]; i++ {
+			constantName = goEnumConstantName(modelType.TypeName, value) + strconv.Itoa(i)
+		}
+		used[constantName] = true
+		constantNames = append(constantNames, constantName)
+		literal := value
+		if modelType.ContentType == "string" {
+			literal = strconv.Quote(value)
+		}
+		f.WriteLine(constantName + ` ` + modelType.TypeName + ` = ` + literal)
+	}
+	f.WriteLine(`)`)
+	f.WriteLine(``)
+	f.WriteLine(`// IsValid returns true if a ` + modelType.TypeName + ` is one of its allowed values.`)
+	f.WriteLine(`func (v ` + modelType.TypeName + `) IsValid() bool {`)
+	if len(constantNames) > 0 {
+		f.WriteLine(`switch v {`)
+		f.WriteLine(`case ` + strings.Join(constantNames, ", ") + `:`)
+		f.WriteLine(`  return true`)
+		f.WriteLine(`}`)
+	}
+	f.WriteLine(`return false`)
+	f.WriteLine(`}`)
+}
+
+// unionVariant is a Go type that a union can hold.
+type unionVariant struct {
+	typeName            string
+	discriminatorValues []string
+}
+
+// renderUnionType writes a struct that holds a value of one of the variants of a union.
+// Variants implement an interface that is named for the union, and scalar variants are
+// given named types so that they can implement it. Values are read using the discriminator
+// if the union has one, and otherwise by trying each variant in turn.
+func (renderer *Renderer) renderUnionType(f *LineWriter, modelType *surface.Type) {
+	unionName := modelType.TypeName
+	interfaceName := unionName + "Value"
+	methodName := "is" + unionName
+	f.WriteLine(`type ` + unionName + ` struct {`)
+	f.WriteLine(`  Value ` + interfaceName)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	variants := make([]*unionVariant, 0)
+	for _, v := range modelType.Variants {
+		typeName := goNativeType(v.Type, "")
+		if typeName == "interface{}" {
+			// values of any type can't be distinguished from other variants.
+			continue
+		}
+		if renderer.Model.TypeWithTypeName(typeName) == nil {
+			scalarTypeName := typeName
+			typeName = unionName + strings.Title(scalarTypeName)
+			f.WriteLine(`// ` + typeName + ` is a ` + scalarTypeName + ` that can be held by a ` + unionName + `.`)
+			f.WriteLine(`type ` + typeName + ` ` + scalarTypeName)
+			f.WriteLine(``)
+		}
+		variants = append(variants, &unionVariant{typeName: typeName, discriminatorValues: v.DiscriminatorValues})
+	}
+	f.WriteLine(`// ` + interfaceName + ` is implemented by the types that a ` + unionName + ` can hold.`)
+	f.WriteLine(`type ` + interfaceName + ` interface {`)
+	f.WriteLine(`  ` + methodName + `()`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	for _, v := range variants {
+		f.WriteLine(`func (*` + v.typeName + `) ` + methodName + `() {}`)
+	}
+	f.WriteLine(``)
+	f.WriteLine(`// MarshalJSON writes the value that a ` + unionName + ` holds.`)
+	f.WriteLine(`func (u ` + unionName + `) MarshalJSON() ([]byte, error) {`)
+	f.WriteLine(`  return json.Marshal(u.Value)`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// UnmarshalJSON reads a value into a ` + unionName + `.`)
+	f.WriteLine(`func (u *` + unionName + `) UnmarshalJSON(data []byte) error {`)
+	hasDiscriminatorValues := false
+	for _, v := range variants {
+		if len(v.discriminatorValues) > 0 {
+			hasDiscriminatorValues = true
+		}
+	}
+	if modelType.Discriminator != "" && hasDiscriminatorValues {
+		f.WriteLine(`var discriminator struct {`)
+		f.WriteLine(`  Value string ` + "`" + `json:"` + modelType.Discriminator + `"` + "`")
+		f.WriteLine(`}`)
+		f.WriteLine(`if err := json.Unmarshal(data, &discriminator); err != nil {`)
+		f.WriteLine(`  return err`)
+		f.WriteLine(`}`)
+		f.WriteLine(`switch discriminator.Value {`)
+		for _, v := range variants {
+			if len(v.discriminatorValues) == 0 {
+				continue
+			}
+			values := make([]string, 0)
+			for _, value := range v.discriminatorValues {
+				values = append(values, strconv.Quote(value))
+			}
+			f.WriteLine(`case ` + strings.Join(values, ", ") + `:`)
+			f.WriteLine(`  value := new(` + v.typeName + `)`)
+			f.WriteLine(`  if err := json.Unmarshal(data, value); err != nil {`)
+			f.WriteLine(`    return err`)
+			f.WriteLine(`  }`)
+			f.WriteLine(`  u.Value = value`)
+			f.WriteLine(`  return nil`)
+		}
+		f.WriteLine(`}`)
+	}
+	for _, v := range variants {
+		if modelType.Discriminator != "" && len(v.discriminatorValues) > 0 {
+			continue
+		}
+		f.WriteLine(`{`)
+		f.WriteLine(`  value := new(` + v.typeName + `)`)
+		f.WriteLine(`  decoder := json.NewDecoder(bytes.NewReader(data))`)
+		f.WriteLine(`  decoder.DisallowUnknownFields()`)
+		f.WriteLine(`  if decoder.Decode(value) == nil {`)
+		f.WriteLine(`    u.Value = value`)
+		f.WriteLine(`    return nil`)
+		f.WriteLine(`  }`)
+		f.WriteLine(`}`)
+	}
+	f.WriteLine(`return fmt.Errorf("unable to read a ` + unionName + ` from %s", data)`)
+	f.WriteLine(`}`)
+}
+
 func jsonTag(field *surface.Field) string {
 	if field.Serialize {
 		return " `json:" + `"` + field.Name + `,omitempty"` + "`"
@@ -0,0 +1,503 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+
+	surface "github.com/googleapis/gnostic/surface"
+)
+
+// writeValidationTypes writes the types that describe requests that don't satisfy the constraints of an API,
+// the helpers that read request values, and the methods that check the values of the API's struct types.
+func (renderer *Renderer) writeValidationTypes(f *LineWriter) {
+	f.WriteLine(`// Violation describes a value in a request that doesn't satisfy the constraints of the API.`)
+	f.WriteLine(`type Violation struct {`)
+	f.WriteLine(`  Parameter string ` + "`" + `json:"parameter"` + "`" + `         // the name of the parameter that has the value`)
+	f.WriteLine(`  Pointer   string ` + "`" + `json:"pointer,omitempty"` + "`" + ` // for values in bodies, a JSON pointer to the value`)
+	f.WriteLine(`  Message   string ` + "`" + `json:"message"` + "`" + `           // describes the problem`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// ValidationError is the error of requests that don't satisfy the constraints of the API.`)
+	f.WriteLine(`// Requests are checked before they are passed to providers, and DefaultErrorEncoder writes`)
+	f.WriteLine(`// the violations of rejected requests as JSON.`)
+	f.WriteLine(`type ValidationError struct {`)
+	f.WriteLine(`  Violations []Violation ` + "`" + `json:"violations"` + "`")
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`func (e *ValidationError) Error() string {`)
+	f.WriteLine(`  messages := make([]string, 0, len(e.Violations))`)
+	f.WriteLine(`  for _, v := range e.Violations {`)
+	f.WriteLine(`    messages = append(messages, v.Parameter+v.Pointer+": "+v.Message)`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return "invalid request: " + strings.Join(messages, "; ")`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// add adds a violation by a value of a parameter.`)
+	f.WriteLine(`func (e *ValidationError) add(parameter, pointer, format string, args ...interface{}) {`)
+	f.WriteLine(`  e.Violations = append(e.Violations, Violation{Parameter: parameter, Pointer: pointer, Message: fmt.Sprintf(format, args...)})`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// textValues returns a list that holds a text, or nil if the text is empty.`)
+	f.WriteLine(`func textValues(text string) []string {`)
+	f.WriteLine(`  if text == "" {`)
+	f.WriteLine(`    return nil`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return []string{text}`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// cookieValues returns the values of the cookies of a request that have a name.`)
+	f.WriteLine(`func cookieValues(r *http.Request, name string) []string {`)
+	f.WriteLine(`  values := make([]string, 0)`)
+	f.WriteLine(`  for _, cookie := range r.Cookies() {`)
+	f.WriteLine(`    if cookie.Name == name {`)
+	f.WriteLine(`      values = append(values, cookie.Value)`)
+	f.WriteLine(`    }`)
+	f.WriteLine(`  }`)
+	f.WriteLine(`  return values`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// escapePointer escapes a key for use in a JSON pointer.`)
+	f.WriteLine(`func escapePointer(key string) string {`)
+	f.WriteLine(`  return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	f.WriteLine(`// isMultipleOf returns true if a number is a multiple of a divisor. Divisors like 0.01 can't be`)
+	f.WriteLine(`// represented exactly, so quotients that are within a small relative distance of integers are accepted.`)
+	f.WriteLine(`func isMultipleOf(value, divisor float64) bool {`)
+	f.WriteLine(`  quotient := value / divisor`)
+	f.WriteLine(`  return math.Abs(quotient-math.Round(quotient)) <= 1e-9*math.Max(1, math.Abs(quotient))`)
+	f.WriteLine(`}`)
+	f.WriteLine(``)
+	if patterns := renderer.validationPatterns(); len(patterns) > 0 {
+		f.WriteLine(`// validationPatterns holds the compiled patterns that strings are checked with.`)
+		f.WriteLine(`var validationPatterns = map[string]*regexp.Regexp{`)
+		for _, pattern := range patterns {
+			f.WriteLine(strconv.Quote(pattern) + `: regexp.MustCompile(` + strconv.Quote(pattern) + `),`)
+		}
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+	}
+	for _, modelType := range renderer.Model.Types {
+		if !renderer.isValidatedType(modelType) {
+			continue
+		}
+		f.WriteLine(`// validate adds the violations of the constraints of the API by a ` + modelType.TypeName + ` to violations.`)
+		f.WriteLine(`func (x *` + modelType.TypeName + `) validate(violations *ValidationError, parameter, pointer string) {`)
+		for _, field := range modelType.Fields {
+			value := `x.` + field.FieldName
+			pointer := `pointer + ` + strconv.Quote("/"+field.Name)
+			checks := NewLineWriter()
+			renderer.writeValueChecks(checks, field, value, `parameter`, pointer, true)
+			// values that are missing aren't checked further
+			condition := renderer.missingCondition(field, value)
+			if condition != "" {
+				f.WriteLine(`if ` + condition + ` {`)
+				f.WriteLine(`  violations.add(parameter, ` + pointer + `, "missing required value")`)
+				if checks.Len() > 0 {
+					f.WriteLine(`} else {`)
+				}
+			}
+			f.Write(checks.Bytes())
+			if condition != "" {
+				f.WriteLine(`}`)
+			}
+		}
+		f.WriteLine(`}`)
+		f.WriteLine(``)
+	}
+}
+
+// isValidatedType returns true if a type is a struct that has a validate method.
+// The types of the parameters and responses of methods are not validated as structs.
+func (renderer *Renderer) isValidatedType(t *surface.Type) bool {
+	if t == nil || t.Kind != surface.TypeKind_STRUCT {
+		return false
+	}
+	for _, method := range renderer.Model.Methods {
+		if t.TypeName == method.ParametersTypeName || t.TypeName == method.ResponsesTypeName {
+			return false
+		}
+	}
+	return true
+}
+
+// missingCondition returns an expression that is true if the value of a required field of a struct is missing,
+// or an empty string if the field isn't required or its missing values can't be told apart from zero values.
+// Read-only fields are set by servers, so they aren't required in requests.
+func (renderer *Renderer) missingCondition(field *surface.Field, value string) string {
+	if !field.GetConstraints().GetRequired() || field.GetConstraints().GetReadOnly() {
+		return ""
+	}
+	switch {
+	case field.Kind != surface.FieldKind_SCALAR || field.NativeType == "[]byte":
+		return value + ` == nil`
+	case renderer.formValueKind(field) == formValueText:
+		return value + ` == ""`
+	}
+	return ""
+}
+
+// validationPatterns returns the patterns of the fields of the model that can be checked with Go regular expressions.
+// Patterns that don't compile as Go regular expressions aren't checked.
+func (renderer *Renderer) validationPatterns() []string {
+	patterns := make(map[string]bool)
+	for _, t := range renderer.Model.Types {
+		for _, field := range t.Fields {
+			if pattern := field.GetConstraints().GetPattern(); pattern != "" && isValidPattern(pattern) {
+				patterns[pattern] = true
+			}
+		}
+	}
+	result := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		result = append(result, pattern)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// isValidPattern returns true if a pattern compiles as a Go regular expression.
+func isValidPattern(pattern string) bool {
+	_, err := regexp.Compile(pattern)
+	return err == nil
+}
+
+// writeParameterReaders writes code that reads the PATH, QUERY, HEADER and COOKIE parameters of a request,
+// checks their values, and adds their problems to violations.
+func (renderer *Renderer) writeParameterReaders(f *LineWriter, method *surface.Method, parametersType *surface.Type) {
+	if parametersType.HasFieldWithPosition(surface.Position_QUERY) {
+		f.WriteLine(`query := r.URL.Query()`)
+	}
+	_, wildcards := servePattern(method)
+	for _, field := range parametersType.Fields {
+		name := strconv.Quote(field.Name)
+		var values string
+		switch field.Position {
+		case surface.Position_PATH:
+			// parameters in path segments that have more than one parameter aren't read
+			wildcard, ok := wildcards[field.Name]
+			if !ok {
+				continue
+			}
+			values = `r.PathValue(` + strconv.Quote(wildcard.name) + `)`
+			if wildcard.prefix != "" {
+				values = `strings.TrimPrefix(` + values + `, ` + strconv.Quote(wildcard.prefix) + `)`
+			}
+			if wildcard.suffix != "" {
+				values = `strings.TrimSuffix(` + values + `, ` + strconv.Quote(wildcard.suffix) + `)`
+			}
+			values = `textValues(` + values + `)`
+		case surface.Position_QUERY:
+			// maps that are sent as separate query parameters aren't read
+			if field.Kind == surface.FieldKind_MAP && isSeparateValues(field) {
+				continue
+			}
+			values = `query[` + name + `]`
+		case surface.Position_HEADER:
+			values = `r.Header.Values(` + name + `)`
+		case surface.Position_COOKIE:
+			values = `cookieValues(r, ` + name + `)`
+		default:
+			continue
+		}
+		target := `parameters.` + field.FieldName
+		f.WriteLine(`if values := ` + values + `; len(values) > 0 {`)
+		renderer.writeValuesReader(f, field, target, name, `""`)
+		renderer.writeValueChecks(f, field, target, name, `""`, false)
+		if field.GetConstraints().GetRequired() {
+			f.WriteLine(`} else {`)
+			f.WriteLine(`  violations.add(` + name + `, "", "missing required parameter")`)
+		}
+		f.WriteLine(`}`)
+	}
+}
+
+// isSeparateValues returns true if the items of an array or map field are sent as separate values.
+// Items of form values are separate unless their style delimits them, and items of other values
+// are separate if they are exploded QUERY or COOKIE parameters.
+func isSeparateValues(field *surface.Field) bool {
+	switch field.Position {
+	case surface.Position_QUERY, surface.Position_COOKIE:
+		return field.Explode
+	case surface.Position_PATH, surface.Position_HEADER:
+		return false
+	default:
+		return field.Style == "" || field.Explode
+	}
+}
+
+// writeValuesReader writes code that sets target to the value of a field that is read from values,
+// a list of the texts that the field was sent with that isn't empty. Texts that can't be read are
+// added to violations with the parameter and pointer expressions.
+func (renderer *Renderer) writeValuesReader(f *LineWriter, field *surface.Field, target, parameter, pointer string) {
+	kind := renderer.formValueKind(field)
+	if field.Kind == surface.FieldKind_MAP {
+		kind = renderer.formValueKind(&surface.Field{NativeType: field.NativeType})
+	}
+	text := `values[0]`
+	if field.Position == surface.Position_PATH {
+		switch field.Style {
+		case "label":
+			text = `strings.TrimPrefix(values[0], ".")`
+		case "matrix":
+			text = `strings.TrimPrefix(values[0], ` + strconv.Quote(";"+field.Name+"=") + `)`
+		}
+	}
+	switch {
+	case kind == formValueJSON || field.Kind == surface.FieldKind_MAP && kind == formValueBytes:
+		f.WriteLine(`if err := json.Unmarshal([]byte(` + text + `), &` + target + `); err != nil {`)
+		f.WriteLine(`  violations.add(` + parameter + `, ` + pointer + `, "invalid value: %v", err)`)
+		f.WriteLine(`}`)
+	case field.Kind == surface.FieldKind_MAP:
+		// Exploded maps are sent as key=value pairs, and other maps as key,value pairs,
+		// which alternate keys and values when they are separated by commas.
+		separator, pair := itemSeparator(field), ","
+		if field.Explode {
+			pair = "="
+		}
+		f.WriteLine(`pairs := strings.Split(` + text + `, ` + strconv.Quote(separator) + `)`)
+		f.WriteLine(target + ` = make(map[string]` + field.NativeType + `)`)
+		if separator == pair {
+			f.WriteLine(`for i := 0; i+1 < len(pairs); i += 2 {`)
+			f.WriteLine(`  key, item := pairs[i], pairs[i+1]`)
+		} else {
+			f.WriteLine(`for _, pair := range pairs {`)
+			f.WriteLine(`  key, item, _ := strings.Cut(pair, ` + strconv.Quote(pair) + `)`)
+		}
+		renderer.writeTextParser(f, field.NativeType, `item`, parameter, pointer, func(v string) {
+			f.WriteLine(target + `[key] = ` + v)
+		})
+		f.WriteLine(`}`)
+	case field.Kind == surface.FieldKind_ARRAY:
+		items := `values`
+		if !isSeparateValues(field) {
+			items = `strings.Split(` + text + `, ` + strconv.Quote(itemSeparator(field)) + `)`
+		}
+		f.WriteLine(`for _, item := range ` + items + ` {`)
+		renderer.writeTextParser(f, field.NativeType, `item`, parameter, pointer, func(v string) {
+			f.WriteLine(target + ` = append(` + target + `, ` + v + `)`)
+		})
+		f.WriteLine(`}`)
+	case renderer.isOptionalScalar(field):
+		renderer.writeTextParser(f, field.NativeType, text, parameter, pointer, func(v string) {
+			f.WriteLine(`v := ` + v)
+			f.WriteLine(target + ` = &v`)
+		})
+	default:
+		renderer.writeTextParser(f, field.NativeType, text, parameter, pointer, func(v string) {
+			f.WriteLine(target + ` = ` + v)
+		})
+	}
+}
+
+// writeTextParser writes code that reads a value of a native type from a text expression and passes
+// an expression for the value to assign. Texts that can't be read are added to violations.
+func (renderer *Renderer) writeTextParser(f *LineWriter, nativeType, text, parameter, pointer string, assign func(v string)) {
+	parse := func(call, description, value string) {
+		f.WriteLine(`if value, err := ` + call + `; err != nil {`)
+		f.WriteLine(`  violations.add(` + parameter + `, ` + pointer + `, "invalid ` + description + ` %q", ` + text + `)`)
+		f.WriteLine(`} else {`)
+		assign(value)
+		f.WriteLine(`}`)
+	}
+	switch nativeType {
+	case "string":
+		assign(text)
+	case "[]byte":
+		assign(`[]byte(` + text + `)`)
+	case "bool":
+		parse(`strconv.ParseBool(`+text+`)`, "boolean", `value`)
+	case "int32":
+		parse(`strconv.ParseInt(`+text+`, 10, 32)`, "integer", `int32(value)`)
+	case "int64":
+		parse(`strconv.ParseInt(`+text+`, 10, 64)`, "integer", `value`)
+	case "float32":
+		parse(`strconv.ParseFloat(`+text+`, 32)`, "number", `float32(value)`)
+	case "float64":
+		parse(`strconv.ParseFloat(`+text+`, 64)`, "number", `value`)
+	default:
+		if enumType := renderer.enumTypeForField(&surface.Field{NativeType: nativeType}); enumType != nil {
+			switch enumType.ContentType {
+			case "string":
+				assign(nativeType + `(` + text + `)`)
+			case "integer":
+				parse(`strconv.ParseInt(`+text+`, 10, 64)`, "integer", nativeType+`(value)`)
+			case "number":
+				parse(`strconv.ParseFloat(`+text+`, 64)`, "number", nativeType+`(value)`)
+			default:
+				parse(`strconv.ParseBool(`+text+`)`, "boolean", nativeType+`(value)`)
+			}
+			return
+		}
+		f.WriteLine(`var value ` + nativeType)
+		f.WriteLine(`if err := json.Unmarshal([]byte(` + text + `), &value); err != nil {`)
+		f.WriteLine(`  violations.add(` + parameter + `, ` + pointer + `, "invalid value: %v", err)`)
+		f.WriteLine(`} else {`)
+		assign(`value`)
+		f.WriteLine(`}`)
+	}
+}
+
+// writeValueChecks writes code that checks the value of a field against its constraints, its enum values,
+// and the constraints of the struct types that it holds, and adds problems to violations with the parameter
+// and pointer expressions. If optional is true, zero values of fields that aren't required aren't checked,
+// because they can't be told apart from values that are missing. Optional scalars are checked if they aren't nil.
+func (renderer *Renderer) writeValueChecks(f *LineWriter, field *surface.Field, value, parameter, pointer string, optional bool) {
+	checks := NewLineWriter()
+	if renderer.isOptionalScalar(field) {
+		renderer.writeConstraintChecks(checks, field, `(*`+value+`)`, parameter, pointer)
+		optional = true
+	} else {
+		renderer.writeConstraintChecks(checks, field, value, parameter, pointer)
+	}
+	if checks.Len() == 0 {
+		return
+	}
+	condition := renderer.valueCondition(field, renderer.formValueKind(field), value)
+	if condition == "" && field.Kind == surface.FieldKind_SCALAR && renderer.isValidatedType(renderer.Model.TypeWithTypeName(field.NativeType)) {
+		// structs are held by value, so missing structs are zero values
+		condition = `!reflect.ValueOf(` + value + `).IsZero()`
+	}
+	if !optional || condition == "" || field.GetConstraints().GetRequired() {
+		f.Write(checks.Bytes())
+		return
+	}
+	f.WriteLine(`if ` + condition + ` {`)
+	f.Write(checks.Bytes())
+	f.WriteLine(`}`)
+}
+
+// writeConstraintChecks writes the code of writeValueChecks that checks a value.
+func (renderer *Renderer) writeConstraintChecks(f *LineWriter, field *surface.Field, value, parameter, pointer string) {
+	c := field.GetConstraints()
+	kind := renderer.formValueKind(field)
+	itemKind := renderer.formValueKind(&surface.Field{NativeType: field.NativeType})
+	itemType := renderer.Model.TypeWithTypeName(field.NativeType)
+	add := func(format string, args ...string) {
+		line := `violations.add(` + parameter + `, ` + pointer + `, ` + strconv.Quote(format)
+		for _, arg := range args {
+			line += `, ` + arg
+		}
+		f.WriteLine(line + `)`)
+	}
+	check := func(condition, format string, args ...string) {
+		f.WriteLine(`if ` + condition + ` {`)
+		add(format, args...)
+		f.WriteLine(`}`)
+	}
+	switch field.Kind {
+	case surface.FieldKind_ARRAY:
+		if c.GetMinItems() > 0 {
+			n := strconv.FormatInt(c.GetMinItems(), 10)
+			check(`len(`+value+`) < `+n, "must have at least "+n+" items")
+		}
+		if c.GetMaxItems() > 0 {
+			n := strconv.FormatInt(c.GetMaxItems(), 10)
+			check(`len(`+value+`) > `+n, "must have at most "+n+" items")
+		}
+		if c.GetUniqueItems() && (itemKind == formValueText || itemKind == formValueScalar) {
+			f.WriteLine(`{`)
+			f.WriteLine(`seen := make(map[` + field.NativeType + `]bool)`)
+			f.WriteLine(`for _, item := range ` + value + ` {`)
+			check(`seen[item]`, "items must be unique")
+			f.WriteLine(`  seen[item] = true`)
+			f.WriteLine(`}`)
+			f.WriteLine(`}`)
+		}
+		if renderer.enumTypeForField(field) != nil {
+			f.WriteLine(`for _, item := range ` + value + ` {`)
+			check(`!item.IsValid()`, "invalid value %v", `item`)
+			f.WriteLine(`}`)
+		} else if renderer.isValidatedType(itemType) {
+			f.WriteLine(`for i := range ` + value + ` {`)
+			f.WriteLine(value + `[i].validate(violations, ` + parameter + `, ` + pointer + ` + "/" + strconv.Itoa(i))`)
+			f.WriteLine(`}`)
+		}
+	case surface.FieldKind_MAP:
+		if renderer.isValidatedType(itemType) {
+			f.WriteLine(`for key, item := range ` + value + ` {`)
+			f.WriteLine(`  item.validate(violations, ` + parameter + `, ` + pointer + ` + "/" + escapePointer(key))`)
+			f.WriteLine(`}`)
+		}
+	case surface.FieldKind_REFERENCE:
+		if renderer.isValidatedType(itemType) {
+			f.WriteLine(`if ` + value + ` != nil {`)
+			f.WriteLine(value + `.validate(violations, ` + parameter + `, ` + pointer + `)`)
+			f.WriteLine(`}`)
+		}
+	default:
+		if renderer.isValidatedType(itemType) {
+			f.WriteLine(value + `.validate(violations, ` + parameter + `, ` + pointer + `)`)
+			return
+		}
+		if enumType := renderer.enumTypeForField(field); enumType != nil {
+			check(`!`+value+`.IsValid()`, "invalid value %v", value)
+		}
+		if kind == formValueText {
+			if c.GetMinLength() > 0 {
+				n := strconv.FormatInt(c.GetMinLength(), 10)
+				check(`utf8.RuneCountInString(string(`+value+`)) < `+n, "must have at least "+n+" characters")
+			}
+			if c.GetMaxLength() > 0 {
+				n := strconv.FormatInt(c.GetMaxLength(), 10)
+				check(`utf8.RuneCountInString(string(`+value+`)) > `+n, "must have at most "+n+" characters")
+			}
+			if pattern := c.GetPattern(); pattern != "" && isValidPattern(pattern) {
+				check(`!validationPatterns[`+strconv.Quote(pattern)+`].MatchString(string(`+value+`))`, "must match the pattern %q", strconv.Quote(pattern))
+			}
+		}
+		if kind == formValueScalar && renderer.isNumber(field.NativeType) {
+			number := `float64(` + value + `)`
+			if c.GetHasMinimum() {
+				limit := strconv.FormatFloat(c.GetMinimum(), 'g', -1, 64)
+				if c.GetExclusiveMinimum() {
+					check(number+` <= `+limit, "must be greater than "+limit)
+				} else {
+					check(number+` < `+limit, "must be at least "+limit)
+				}
+			}
+			if c.GetHasMaximum() {
+				limit := strconv.FormatFloat(c.GetMaximum(), 'g', -1, 64)
+				if c.GetExclusiveMaximum() {
+					check(number+` >= `+limit, "must be less than "+limit)
+				} else {
+					check(number+` > `+limit, "must be at most "+limit)
+				}
+			}
+			if divisor := c.GetMultipleOf(); divisor > 0 {
+				limit := strconv.FormatFloat(divisor, 'g', -1, 64)
+				if (field.NativeType == "int32" || field.NativeType == "int64") && divisor == math.Trunc(divisor) && divisor <= math.MaxInt64 {
+					check(`int64(`+value+`) % `+strconv.FormatInt(int64(divisor), 10)+` != 0`, "must be a multiple of "+limit)
+				} else {
+					check(`!isMultipleOf(`+number+`, `+limit+`)`, "must be a multiple of "+limit)
+				}
+			}
+		}
+	}
+}
+
+// isNumber returns true if values of a native type are numbers.
+func (renderer *Renderer) isNumber(nativeType string) bool {
+	switch nativeType {
+	case "int32", "int64", "float32", "float64":
+		return true
+	}
+	enumType := renderer.enumTypeForField(&surface.Field{NativeType: nativeType})
+	return enumType != nil && (enumType.ContentType == "integer" || enumType.ContentType == "number")
+}
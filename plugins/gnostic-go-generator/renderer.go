@@ -18,6 +18,7 @@ import (
 	"fmt"
 	_ "os"
 	"path/filepath"
+	"strings"
 
 	plugins "github.com/googleapis/gnostic/plugins"
 	surface "github.com/googleapis/gnostic/surface"
@@ -26,19 +27,33 @@ import (
 
 // Renderer generates code for a surface.Model.
 type Renderer struct {
-	Model   *surface.Model
-	Package string // package name
+	Model         *surface.Model
+	Package       string // package name
+	ProviderStyle string // the style of the Provider interface, providerStyleV1 or providerStyleV2
+	ImportPath    string // the import path of the generated package, which mock packages import
 }
 
+// Providers fill in the response structs of their methods in the v1 style,
+// and take contexts and return responses in the v2 style.
+const (
+	providerStyleV1 = "v1"
+	providerStyleV2 = "v2"
+)
+
 // NewServiceRenderer creates a renderer.
 func NewServiceRenderer(model *surface.Model) (renderer *Renderer, err error) {
-	renderer = &Renderer{}
+	renderer = &Renderer{ProviderStyle: providerStyleV1}
 	renderer.Model = model
 	return renderer, nil
 }
 
-// Generate runs the renderer to generate the named files.
+// Render runs the renderer to generate the named files. Problems with any of
+// the files are added to the errors of the response.
 func (renderer *Renderer) Render(response *plugins.Response, files []string) (err error) {
+	hasClient := false
+	for _, filename := range files {
+		hasClient = hasClient || filename == "client.go"
+	}
 	for _, filename := range files {
 		file := &plugins.File{Name: filename}
 		switch filename {
@@ -52,17 +67,248 @@ func (renderer *Renderer) Render(response *plugins.Response, files []string) (er
 			file.Data, err = renderer.RenderServer()
 		case "constants.go":
 			file.Data, err = renderer.RenderConstants()
+		case "mock.go":
+			file.Name = renderer.mockPackage() + "/" + filename
+			file.Data, err = renderer.RenderMock(hasClient)
 		default:
 			file.Data = nil
 		}
 		if err != nil {
 			response.Errors = append(response.Errors, fmt.Sprintf("ERROR %v", err))
 		}
-		// run generated Go files through imports pkg
+		// run generated Go files through imports pkg, keeping files that it can't process as they are
 		if filepath.Ext(file.Name) == ".go" {
-			file.Data, err = imports.Process(file.Name, file.Data, nil)
+			if data, err := imports.Process(file.Name, file.Data, nil); err == nil {
+				file.Data = data
+			} else {
+				response.Errors = append(response.Errors, fmt.Sprintf("ERROR %s: %v", file.Name, err))
+			}
 		}
 		response.Files = append(response.Files, file)
 	}
-	return
+	return nil
+}
+
+// enumTypeForField returns the enum type of a field, or nil if the field is not an enum.
+func (renderer *Renderer) enumTypeForField(field *surface.Field) *surface.Type {
+	t := field.ServiceType(renderer.Model)
+	if t == nil || t.Kind != surface.TypeKind_ENUM {
+		return nil
+	}
+	return t
+}
+
+// Values of fields are written in forms as text, as binary data, as formatted numbers and booleans, or as JSON.
+const (
+	formValueText = iota
+	formValueBytes
+	formValueScalar
+	formValueJSON
+)
+
+// formValueKind returns the way that values of a field (or of its elements, for arrays) are written in forms.
+func (renderer *Renderer) formValueKind(field *surface.Field) int {
+	if field.Kind == surface.FieldKind_MAP {
+		return formValueJSON
+	}
+	switch field.NativeType {
+	case "string":
+		return formValueText
+	case "[]byte":
+		return formValueBytes
+	case "bool", "int32", "int64", "float32", "float64":
+		return formValueScalar
+	}
+	if enumType := renderer.enumTypeForField(field); enumType != nil {
+		if enumType.ContentType == "string" {
+			return formValueText
+		}
+		return formValueScalar
+	}
+	return formValueJSON
+}
+
+// isOptionalScalar returns true if a field is an optional QUERY, HEADER, COOKIE or FORMDATA parameter
+// with a text or scalar value. These are held by pointers, so that zero values can be sent and read.
+func (renderer *Renderer) isOptionalScalar(field *surface.Field) bool {
+	switch field.Position {
+	case surface.Position_QUERY, surface.Position_HEADER, surface.Position_COOKIE, surface.Position_FORMDATA:
+	default:
+		return false
+	}
+	if field.Kind != surface.FieldKind_SCALAR || field.GetConstraints().GetRequired() {
+		return false
+	}
+	kind := renderer.formValueKind(field)
+	return kind == formValueText || kind == formValueScalar
+}
+
+// fieldType returns the Go type of a field, which is a pointer for optional scalars.
+func (renderer *Renderer) fieldType(field *surface.Field) string {
+	if renderer.isOptionalScalar(field) {
+		return "*" + field.NativeType
+	}
+	return goFieldType(field)
+}
+
+// isDelimitedArray returns true if the items of an array field are sent in a single value that is
+// delimited by the separator of its style. Only text and scalar items are delimited.
+func isDelimitedArray(field *surface.Field, kind int) bool {
+	return field.Kind == surface.FieldKind_ARRAY && field.Style != "" && !field.Explode &&
+		(kind == formValueText || kind == formValueScalar)
+}
+
+// itemSeparator returns the text that separates the items of an array that is sent in a single value.
+func itemSeparator(field *surface.Field) string {
+	switch field.Style {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	case "tabDelimited":
+		return "\t"
+	case "label":
+		if field.Explode {
+			return "."
+		}
+	case "matrix":
+		if field.Explode {
+			return ";" + field.Name + "="
+		}
+	}
+	return ","
+}
+
+// formFields returns the fields of a method's parameters that are sent as form values,
+// which are either FORMDATA parameters or the properties of a form or multipart body.
+func (renderer *Renderer) formFields(method *surface.Method, parametersType *surface.Type) (fields []*surface.Field, mediaType string) {
+	if bodyField := parametersType.FieldWithPosition(surface.Position_BODY); bodyField != nil {
+		if bodyType := bodyField.ServiceType(renderer.Model); bodyType != nil && bodyType.Kind == surface.TypeKind_STRUCT &&
+			isFormMediaType(bodyField.MediaType) {
+			for _, requestBody := range method.RequestBodies {
+				if requestBody.MediaType == bodyField.MediaType {
+					return encodedFields(bodyType.Fields, requestBody), bodyField.MediaType
+				}
+			}
+			return bodyType.Fields, bodyField.MediaType
+		}
+		return nil, ""
+	}
+	for _, field := range parametersType.GetFields() {
+		if field.Position == surface.Position_FORMDATA {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return nil, ""
+	}
+	return fields, formMediaType(method)
+}
+
+// encodedFields returns the properties of a form body with the styles that the encodings of the body
+// specify for them. Styles only apply to urlencoded forms. Fields with encoding styles are copies,
+// so the fields of the body type are unchanged.
+func encodedFields(fields []*surface.Field, requestBody *surface.RequestBody) []*surface.Field {
+	if requestBody.MediaType != "application/x-www-form-urlencoded" {
+		return fields
+	}
+	result := make([]*surface.Field, 0, len(fields))
+	for _, field := range fields {
+		for _, encoding := range requestBody.Encodings {
+			if encoding.Name == field.Name && encoding.Style != "" {
+				encoded := *field
+				encoded.Style = encoding.Style
+				encoded.Explode = encoding.Explode
+				field = &encoded
+			}
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// hasMultipartForms returns true if any method sends a multipart request body.
+func (renderer *Renderer) hasMultipartForms() bool {
+	for _, method := range renderer.Model.Methods {
+		parametersType := renderer.Model.TypeWithTypeName(method.ParametersTypeName)
+		if _, mediaType := renderer.formFields(method, parametersType); mediaType == "multipart/form-data" {
+			return true
+		}
+	}
+	return false
+}
+
+// formMediaType returns the media type of the form that a method's FORMDATA parameters are sent in.
+func formMediaType(method *surface.Method) string {
+	for _, requestBody := range method.RequestBodies {
+		if requestBody.Type == "" {
+			return requestBody.MediaType
+		}
+	}
+	return "application/x-www-form-urlencoded"
+}
+
+// isFormMediaType returns true for the media types of bodies whose properties are sent as form values.
+func isFormMediaType(mediaType string) bool {
+	return mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data"
+}
+
+// partContentTypes returns the content types that a method's request body specifies for multipart properties.
+func partContentTypes(method *surface.Method, mediaType string) map[string]string {
+	contentTypes := make(map[string]string)
+	for _, requestBody := range method.RequestBodies {
+		if requestBody.MediaType == mediaType {
+			for _, encoding := range requestBody.Encodings {
+				if encoding.ContentType != "" {
+					contentTypes[encoding.Name] = encoding.ContentType
+				}
+			}
+		}
+	}
+	return contentTypes
+}
+
+// hasSecurityScheme returns true if the model has a security scheme that a filter accepts.
+func (renderer *Renderer) hasSecurityScheme(filter func(s *surface.SecurityScheme) bool) bool {
+	for _, s := range renderer.Model.SecuritySchemes {
+		if filter(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAPIKeyScheme returns true for schemes whose credentials are API keys.
+func isAPIKeyScheme(s *surface.SecurityScheme) bool {
+	return s.Type == surface.SecuritySchemeType_API_KEY
+}
+
+// isBasicScheme returns true for schemes whose credentials are usernames and passwords.
+func isBasicScheme(s *surface.SecurityScheme) bool {
+	return s.Type == surface.SecuritySchemeType_HTTP && s.Scheme == "basic"
+}
+
+// isBearerScheme returns true for schemes whose credentials are sent as bearer tokens.
+func isBearerScheme(s *surface.SecurityScheme) bool {
+	return (s.Type == surface.SecuritySchemeType_HTTP && s.Scheme == "bearer") || isTokenSourceScheme(s)
+}
+
+// isTokenSourceScheme returns true for schemes whose tokens can be gotten from OAuth2 token sources.
+func isTokenSourceScheme(s *surface.SecurityScheme) bool {
+	return s.Type == surface.SecuritySchemeType_OAUTH2 || s.Type == surface.SecuritySchemeType_OPEN_ID_CONNECT
+}
+
+// isStatusCodeRange returns true if a response code is a range of status codes like "4XX".
+func isStatusCodeRange(code string) bool {
+	return len(code) == 3 && strings.HasSuffix(strings.ToUpper(code), "XX")
+}
+
+// defaultServerURL returns the URL of a server with its variables replaced by their default values.
+// Trailing slashes are removed because method paths begin with slashes.
+func defaultServerURL(server *surface.Server) string {
+	url := server.Url
+	for _, variable := range server.Variables {
+		url = strings.Replace(url, "{"+variable.Name+"}", variable.DefaultValue, -1)
+	}
+	return strings.TrimSuffix(url, "/")
 }
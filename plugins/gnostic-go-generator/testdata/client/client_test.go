@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// countingTransport counts the requests that it sends.
+type countingTransport struct {
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientOptions(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	transport := &countingTransport{}
+	client := NewClient(
+		WithBaseURL(server.URL+"/"),
+		WithTransport(transport),
+		WithUserAgent("gentest/1.0"),
+		WithDefaultHeader("X-Default", "default"),
+		WithDefaultHeader("X-Replaced", "default"),
+		WithAPIKey("key", "secret"),
+	)
+
+	err := client.Ping(context.Background(),
+		WithHeader("X-Call", "call"),
+		WithHeader("X-Replaced", "call"),
+		WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-Edited", req.Header.Get("X-Call"))
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string]string{
+		"User-Agent": "gentest/1.0",
+		"X-Default":  "default",
+		"X-Replaced": "call",
+		"X-Call":     "call",
+		"X-Edited":   "call",
+		"X-Api-Key":  "secret",
+	} {
+		if value := header.Get(key); value != expected {
+			t.Errorf("%s is %q, expected %q", key, value, expected)
+		}
+	}
+	if transport.count != 1 {
+		t.Errorf("the transport sent %d requests, expected 1", transport.count)
+	}
+}
+
+func TestClientDefaultURL(t *testing.T) {
+	if ServicePath != "https://example.com/v1" {
+		t.Errorf("ServicePath is %q", ServicePath)
+	}
+}
+
+func TestRequestEditorErrors(t *testing.T) {
+	client := NewClient(WithBaseURL("http://example.invalid"))
+	failure := errors.New("not sent")
+	err := client.Ping(context.Background(), WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+		return failure
+	}))
+	if err != failure {
+		t.Errorf("Ping returned %v, expected the error of the request editor", err)
+	}
+}
+
+func TestClientTimeouts(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+	client := NewClient(WithBaseURL(server.URL))
+
+	err := client.Ping(context.Background(), WithTimeout(10*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Ping returned %v, expected a deadline error", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = client.Ping(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Ping returned %v, expected a cancellation error", err)
+	}
+}
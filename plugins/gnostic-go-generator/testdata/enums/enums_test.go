@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+)
+
+type statusProvider struct {
+	status Status
+}
+
+func (p *statusProvider) GetStatus(responses *GetStatusResponses) error {
+	status := p.status
+	responses.OK = &status
+	return nil
+}
+
+func (p *statusProvider) SetStatus(parameters *SetStatusParameters, responses *SetStatusResponses) error {
+	previous := p.status
+	p.status = parameters.Status
+	responses.OK = &previous
+	return nil
+}
+
+func TestEnumResponses(t *testing.T) {
+	server := httptest.NewServer(NewHandler(&statusProvider{status: StatusActive}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	response, err := client.GetStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.OK == nil || *response.OK != StatusActive {
+		t.Errorf("GetStatus returned %v", response.OK)
+	}
+	response2, err := client.SetStatus(context.Background(), StatusPaused)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response2.OK == nil || *response2.OK != StatusActive {
+		t.Errorf("SetStatus returned %v", response2.OK)
+	}
+	response, err = client.GetStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.OK == nil || *response.OK != StatusPaused {
+		t.Errorf("GetStatus returned %v after SetStatus", response.OK)
+	}
+}
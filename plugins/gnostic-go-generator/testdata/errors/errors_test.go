@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// respond returns a server that responds to every request with a status code and a body.
+func respond(statusCode int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "1234")
+		w.WriteHeader(statusCode)
+		fmt.Fprint(w, body)
+	}))
+}
+
+func TestDeclaredErrors(t *testing.T) {
+	for _, test := range []struct {
+		description string
+		statusCode  int
+		body        string
+		value       interface{}
+	}{
+		{
+			description: "a response with a status code",
+			statusCode:  http.StatusNotFound,
+			body:        `{"id":"7"}`,
+			value:       &NotFound{Id: "7"},
+		},
+		{
+			description: "a response in a range of status codes",
+			statusCode:  http.StatusUnprocessableEntity,
+			body:        `{"detail":"no name"}`,
+			value:       &Problem{Detail: "no name"},
+		},
+		{
+			description: "a default response",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        `{"code":503,"message":"try later"}`,
+			value:       &Error{Code: 503, Message: "try later"},
+		},
+		{
+			description: "a response that can't be decoded",
+			statusCode:  http.StatusNotFound,
+			body:        `no pet`,
+			value:       nil,
+		},
+	} {
+		server := respond(test.statusCode, test.body)
+		client := NewClient(WithBaseURL(server.URL + "/"))
+		response, err := client.GetPet(context.Background(), "7")
+		server.Close()
+		if response != nil {
+			t.Errorf("%s: got response %+v, expected none", test.description, response)
+		}
+		var apiErr *APIError
+		if !errors.As(fmt.Errorf("wrapped: %w", err), &apiErr) {
+			t.Errorf("%s: got error %v, expected an APIError", test.description, err)
+			continue
+		}
+		if apiErr.StatusCode != test.statusCode {
+			t.Errorf("%s: status code is %d, expected %d", test.description, apiErr.StatusCode, test.statusCode)
+		}
+		if id := apiErr.Header.Get("X-Request-Id"); id != "1234" {
+			t.Errorf("%s: X-Request-Id is %q, expected %q", test.description, id, "1234")
+		}
+		if string(apiErr.Body) != test.body {
+			t.Errorf("%s: body is %q, expected %q", test.description, apiErr.Body, test.body)
+		}
+		if !reflect.DeepEqual(apiErr.Value, test.value) {
+			t.Errorf("%s: value is %#v, expected %#v", test.description, apiErr.Value, test.value)
+		}
+		if expected := apiErr.Status + ": " + test.body; apiErr.Error() != expected {
+			t.Errorf("%s: error is %q, expected %q", test.description, apiErr.Error(), expected)
+		}
+	}
+}
+
+func TestUndeclaredErrors(t *testing.T) {
+	server := respond(http.StatusInternalServerError, "")
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/"))
+
+	err := client.DeletePets(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error %v, expected an APIError", err)
+	}
+	if apiErr.Value != nil {
+		t.Errorf("value is %#v, expected nil", apiErr.Value)
+	}
+	if apiErr.Error() != apiErr.Status {
+		t.Errorf("error is %q, expected the status %q", apiErr.Error(), apiErr.Status)
+	}
+}
+
+func TestSuccessfulResponses(t *testing.T) {
+	server := respond(http.StatusOK, `{"name":"Rex"}`)
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/"))
+
+	response, err := client.GetPet(context.Background(), "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.OK == nil || response.OK.Name != "Rex" {
+		t.Errorf("got response %+v, expected the pet", response)
+	}
+}
@@ -0,0 +1,100 @@
+package apimock
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"gentest/api"
+)
+
+// the mock provider must implement the interfaces of providers
+var _ api.Provider = &MockProvider{}
+var _ api.CredentialsChecker = &MockProvider{}
+
+func TestMockProvider(t *testing.T) {
+	provider := &MockProvider{
+		ListPetsFunc: func(responses *api.ListPetsResponses) error {
+			responses.OK = []api.Pet{{Name: "rex"}}
+			return nil
+		},
+		CreatePetFunc: func(parameters *api.CreatePetParameters, responses *api.CreatePetResponses) error {
+			responses.OK = &parameters.Pet
+			return nil
+		},
+	}
+	client := NewMockClient(t, provider, api.WithAPIKey("apiKey", "secret"))
+
+	pets, err := client.ListPets(context.Background())
+	if err != nil {
+		t.Fatalf("ListPets: %v", err)
+	}
+	if !reflect.DeepEqual(pets.OK, []api.Pet{{Name: "rex"}}) {
+		t.Errorf("got pets %+v, expected rex", pets.OK)
+	}
+	created, err := client.CreatePet(context.Background(), api.Pet{Name: "tom"})
+	if err != nil {
+		t.Fatalf("CreatePet: %v", err)
+	}
+	if created.OK == nil || created.OK.Name != "tom" {
+		t.Errorf("got created pet %+v, expected tom", created.OK)
+	}
+
+	expected := []MockCall{
+		{Method: "ListPets", Parameters: nil},
+		{Method: "CreatePet", Parameters: &api.CreatePetParameters{Pet: api.Pet{Name: "tom"}}},
+	}
+	if calls := provider.Calls(); !reflect.DeepEqual(calls, expected) {
+		t.Errorf("got calls %+v, expected %+v", calls, expected)
+	}
+}
+
+func TestUnsetFunctions(t *testing.T) {
+	provider := &MockProvider{}
+	client := NewMockClient(t, provider, api.WithAPIKey("apiKey", "secret"))
+
+	_, err := client.ListPets(context.Background())
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got error %v for a method without a function, expected status code %d", err, http.StatusInternalServerError)
+	}
+	if calls := provider.Calls(); len(calls) != 1 || calls[0].Method != "ListPets" {
+		t.Errorf("got calls %+v, expected a call of ListPets", calls)
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	var keys []string
+	provider := &MockProvider{
+		ListPetsFunc: func(responses *api.ListPetsResponses) error {
+			responses.OK = []api.Pet{}
+			return nil
+		},
+		CheckCredentialsFunc: func(r *http.Request, credentials []*api.Credentials) error {
+			for _, c := range credentials {
+				keys = append(keys, c.APIKey)
+			}
+			if len(credentials) != 1 || credentials[0].APIKey != "secret" {
+				return errors.New("wrong key")
+			}
+			return nil
+		},
+	}
+
+	if _, err := NewMockClient(t, provider, api.WithAPIKey("apiKey", "secret")).ListPets(context.Background()); err != nil {
+		t.Errorf("ListPets with the right key: %v", err)
+	}
+	_, err := NewMockClient(t, provider, api.WithAPIKey("apiKey", "guess")).ListPets(context.Background())
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got error %v with the wrong key, expected status code %d", err, http.StatusUnauthorized)
+	}
+	if !reflect.DeepEqual(keys, []string{"secret", "guess"}) {
+		t.Errorf("got keys %v, expected the keys of both requests", keys)
+	}
+	if calls := provider.Calls(); len(calls) != 1 {
+		t.Errorf("got %d calls, expected only the call with the right key", len(calls))
+	}
+}
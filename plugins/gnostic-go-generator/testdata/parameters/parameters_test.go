@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// receive returns a server that describes the requests that it receives.
+func receive(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		header := make(map[string]string)
+		for key := range r.Header {
+			header[key] = strings.Join(r.Header.Values(key), "; ")
+		}
+		header["Path"] = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&Received{Query: r.URL.RawQuery, Header: header, Body: string(body)})
+	}))
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func boolPointer(value bool) *bool {
+	return &value
+}
+
+func TestParameterSerialization(t *testing.T) {
+	server := receive(t)
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/"))
+
+	response, err := client.ListItems(context.Background(),
+		[]int64{1, 2},
+		[]string{"a", "b"},
+		[]string{"red", "blue"},
+		[]string{"s", "m"},
+		map[string]int64{"y": 2, "x": 1},
+		int64Pointer(0),
+		boolPointer(false),
+		[]string{"a", "b"},
+		int64Pointer(0),
+		"secret",
+		[]string{"on", "off"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := response.OK
+	if path := received.Header["Path"]; path != "/items/1,2" {
+		t.Errorf("path is %q, expected %q", path, "/items/1,2")
+	}
+	query, err := url.ParseQuery(received.Query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string][]string{
+		"tags":    {"a", "b"},
+		"colors":  {"red,blue"},
+		"sizes":   {"s|m"},
+		"point":   {"x,1,y,2"},
+		"limit":   {"0"},
+		"verbose": {"false"},
+	} {
+		if values := query[key]; !reflect.DeepEqual(values, expected) {
+			t.Errorf("query parameter %s is %q, expected %q", key, values, expected)
+		}
+	}
+	// net/http quotes cookie values that contain commas
+	for key, expected := range map[string]string{
+		"X-Trace":  "a,b",
+		"X-Offset": "0",
+		"Cookie":   `session=secret; flags="on,off"`,
+	} {
+		if value := received.Header[key]; value != expected {
+			t.Errorf("header %s is %q, expected %q", key, value, expected)
+		}
+	}
+}
+
+func TestOmittedParameters(t *testing.T) {
+	server := receive(t)
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/"))
+
+	response, err := client.ListItems(context.Background(),
+		[]int64{1}, nil, nil, nil, nil, nil, nil, nil, nil, "secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := response.OK
+	if received.Query != "" {
+		t.Errorf("query is %q, expected none", received.Query)
+	}
+	for _, key := range []string{"X-Trace", "X-Offset"} {
+		if value, ok := received.Header[key]; ok {
+			t.Errorf("header %s is %q, expected none", key, value)
+		}
+	}
+	if value := received.Header["Cookie"]; value != "session=secret" {
+		t.Errorf("cookies are %q, expected only the session", value)
+	}
+}
+
+func TestFormSerialization(t *testing.T) {
+	server := receive(t)
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/"))
+
+	response, err := client.SubmitForm(context.Background(), SubmitFormRequestBody{
+		Names:   []string{"a", "b"},
+		Letters: []string{"x", "y"},
+		Active:  true,
+		Count:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	form, err := url.ParseQuery(response.OK.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, expected := range map[string][]string{
+		"names":   {"a", "b"},
+		"letters": {"x,y"},
+		"active":  {"true"},
+		"count":   {"3"},
+	} {
+		if values := form[key]; !reflect.DeepEqual(values, expected) {
+			t.Errorf("form value %s is %q, expected %q", key, values, expected)
+		}
+	}
+}
+
+// provider records the parameters of the requests that it handles.
+type provider struct {
+	items *ListItemsParameters
+	form  *SubmitFormParameters
+}
+
+func (p *provider) ListItems(parameters *ListItemsParameters, responses *ListItemsResponses) error {
+	p.items = parameters
+	responses.OK = &Received{}
+	return nil
+}
+
+func (p *provider) SubmitForm(parameters *SubmitFormParameters, responses *SubmitFormResponses) error {
+	p.form = parameters
+	responses.OK = &Received{}
+	return nil
+}
+
+func TestParameterRoundTrip(t *testing.T) {
+	p := &provider{}
+	server := httptest.NewServer(NewHandler(p))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL + "/"))
+
+	_, err := client.ListItems(context.Background(),
+		[]int64{1, 2},
+		[]string{"a", "b"},
+		[]string{"red", "blue"},
+		[]string{"s", "m"},
+		map[string]int64{"x": 1, "y": 2},
+		int64Pointer(0),
+		boolPointer(false),
+		[]string{"a", "b"},
+		int64Pointer(0),
+		"secret",
+		[]string{"on", "off"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &ListItemsParameters{
+		Ids:     []int64{1, 2},
+		Tags:    []string{"a", "b"},
+		Colors:  []string{"red", "blue"},
+		Sizes:   []string{"s", "m"},
+		Point:   map[string]int64{"x": 1, "y": 2},
+		Limit:   int64Pointer(0),
+		Verbose: boolPointer(false),
+		XTrace:  []string{"a", "b"},
+		XOffset: int64Pointer(0),
+		Session: "secret",
+		Flags:   []string{"on", "off"},
+	}
+	if !reflect.DeepEqual(p.items, expected) {
+		t.Errorf("parameters are %+v, expected %+v", p.items, expected)
+	}
+
+	_, err = client.ListItems(context.Background(),
+		[]int64{1}, nil, nil, nil, nil, nil, nil, nil, nil, "secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.items.Limit != nil || p.items.Verbose != nil || p.items.XOffset != nil {
+		t.Errorf("omitted parameters were received: %+v", p.items)
+	}
+
+	_, err = client.SubmitForm(context.Background(), SubmitFormRequestBody{
+		Names:   []string{"a", "b"},
+		Letters: []string{"x", "y"},
+		Active:  true,
+		Count:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := SubmitFormRequestBody{Names: []string{"a", "b"}, Letters: []string{"x", "y"}, Active: true, Count: 3}
+	if !reflect.DeepEqual(p.form.Body, body) {
+		t.Errorf("form body is %+v, expected %+v", p.form.Body, body)
+	}
+}
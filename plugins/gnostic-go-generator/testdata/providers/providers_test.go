@@ -0,0 +1,232 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type contextKey string
+
+// provider implements the methods of the API with functions and records the contexts that they were called with.
+type provider struct {
+	listPets  func(parameters *ListPetsParameters) (*ListPetsResponse, error)
+	createPet func(parameters *CreatePetParameters) (*CreatePetResponse, error)
+	deletePet func(parameters *DeletePetParameters) (*DeletePetResponse, error)
+	getPhoto  func(parameters *GetPhotoParameters) (*GetPhotoResponse, error)
+	contexts  []context.Context
+}
+
+func (p *provider) ListPets(ctx context.Context, parameters *ListPetsParameters) (*ListPetsResponse, error) {
+	p.contexts = append(p.contexts, ctx)
+	return p.listPets(parameters)
+}
+
+func (p *provider) CreatePet(ctx context.Context, parameters *CreatePetParameters) (*CreatePetResponse, error) {
+	p.contexts = append(p.contexts, ctx)
+	return p.createPet(parameters)
+}
+
+func (p *provider) DeletePet(ctx context.Context, parameters *DeletePetParameters) (*DeletePetResponse, error) {
+	p.contexts = append(p.contexts, ctx)
+	return p.deletePet(parameters)
+}
+
+func (p *provider) GetPhoto(ctx context.Context, parameters *GetPhotoParameters) (*GetPhotoResponse, error) {
+	p.contexts = append(p.contexts, ctx)
+	return p.getPhoto(parameters)
+}
+
+// serve returns a server of the API with a provider and a client of the server.
+func serve(p *provider, options ...HandlerOption) (*httptest.Server, *Client) {
+	server := httptest.NewServer(NewHandler(p, options...))
+	return server, NewClient(WithBaseURL(server.URL))
+}
+
+func TestResponseConstructors(t *testing.T) {
+	p := &provider{
+		listPets: func(parameters *ListPetsParameters) (*ListPetsResponse, error) {
+			response := ListPetsOK([]Pet{{Name: "rex"}})
+			response.Header.Set("X-Total", "1")
+			return response, nil
+		},
+		createPet: func(parameters *CreatePetParameters) (*CreatePetResponse, error) {
+			return CreatePetCode201(&parameters.Pet), nil
+		},
+		getPhoto: func(parameters *GetPhotoParameters) (*GetPhotoResponse, error) {
+			photo := []byte("photo of " + parameters.Id)
+			return GetPhotoOK(&photo), nil
+		},
+	}
+	server, client := serve(p)
+	defer server.Close()
+
+	pets, err := client.ListPets(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListPets: %v", err)
+	}
+	if !reflect.DeepEqual(pets.OK, []Pet{{Name: "rex"}}) {
+		t.Errorf("got pets %+v, expected rex", pets.OK)
+	}
+	if total := pets.Header.Get("X-Total"); total != "1" {
+		t.Errorf("got header X-Total %q, expected %q", total, "1")
+	}
+	if contentType := pets.Header.Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("got content type %q for pets, expected %q", contentType, "application/json")
+	}
+
+	created, err := client.CreatePet(context.Background(), Pet{Name: "tom"})
+	if err != nil {
+		t.Fatalf("CreatePet: %v", err)
+	}
+	if created.Code201 == nil || created.Code201.Name != "tom" {
+		t.Errorf("got created pet %+v, expected tom", created.Code201)
+	}
+
+	photo, err := client.GetPhoto(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("GetPhoto: %v", err)
+	}
+	if photo.OK == nil || string(*photo.OK) != "photo of 7" {
+		t.Errorf("got photo %v, expected %q", photo.OK, "photo of 7")
+	}
+	if contentType := photo.Header.Get("Content-Type"); contentType != "image/png" {
+		t.Errorf("got content type %q for photo, expected %q", contentType, "image/png")
+	}
+}
+
+func TestErrorResponses(t *testing.T) {
+	p := &provider{
+		listPets: func(parameters *ListPetsParameters) (*ListPetsResponse, error) {
+			return ListPetsDefault(http.StatusServiceUnavailable, &Error{Message: "try later"}), nil
+		},
+		createPet: func(parameters *CreatePetParameters) (*CreatePetResponse, error) {
+			return CreatePetCode409(&Error{Message: parameters.Pet.Name + " exists"}), nil
+		},
+		deletePet: func(parameters *DeletePetParameters) (*DeletePetResponse, error) {
+			return DeletePetCode404(), nil
+		},
+	}
+	server, client := serve(p)
+	defer server.Close()
+
+	for _, test := range []struct {
+		description string
+		call        func() error
+		statusCode  int
+		value       interface{}
+	}{
+		{
+			description: "a default response",
+			call: func() error {
+				_, err := client.ListPets(context.Background(), nil)
+				return err
+			},
+			statusCode: http.StatusServiceUnavailable,
+			value:      &Error{Message: "try later"},
+		},
+		{
+			description: "a response with a body",
+			call: func() error {
+				_, err := client.CreatePet(context.Background(), Pet{Name: "tom"})
+				return err
+			},
+			statusCode: http.StatusConflict,
+			value:      &Error{Message: "tom exists"},
+		},
+		{
+			description: "a response without a body",
+			call: func() error {
+				return client.DeletePet(context.Background(), "7")
+			},
+			statusCode: http.StatusNotFound,
+			value:      nil,
+		},
+	} {
+		var apiErr *APIError
+		if err := test.call(); !errors.As(err, &apiErr) {
+			t.Errorf("%s: got error %v, expected an APIError", test.description, err)
+			continue
+		}
+		if apiErr.StatusCode != test.statusCode {
+			t.Errorf("%s: got status code %d, expected %d", test.description, apiErr.StatusCode, test.statusCode)
+		}
+		if !reflect.DeepEqual(apiErr.Value, test.value) {
+			t.Errorf("%s: got value %#v, expected %#v", test.description, apiErr.Value, test.value)
+		}
+	}
+}
+
+func TestNilResponses(t *testing.T) {
+	p := &provider{
+		deletePet: func(parameters *DeletePetParameters) (*DeletePetResponse, error) {
+			return nil, nil
+		},
+	}
+	server, _ := serve(p)
+	defer server.Close()
+
+	req, err := http.NewRequest("DELETE", server.URL+"/pets/7", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("got status code %d for a nil response, expected %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestProviderErrors(t *testing.T) {
+	p := &provider{
+		listPets: func(parameters *ListPetsParameters) (*ListPetsResponse, error) {
+			return nil, errors.New("no pets")
+		},
+	}
+	server, client := serve(p)
+	defer server.Close()
+
+	_, err := client.ListPets(context.Background(), nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got error %v for a provider error, expected status code %d", err, http.StatusInternalServerError)
+	}
+}
+
+func TestProviderParameters(t *testing.T) {
+	var received *ListPetsParameters
+	p := &provider{
+		listPets: func(parameters *ListPetsParameters) (*ListPetsResponse, error) {
+			received = parameters
+			return ListPetsOK([]Pet{}), nil
+		},
+	}
+	// middleware adds a value to the contexts of requests, which providers should receive
+	addValue := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey("user"), "alice")))
+		})
+	}
+	server, client := serve(p, WithMiddleware(addValue))
+	defer server.Close()
+
+	limit := int64(3)
+	if _, err := client.ListPets(context.Background(), &limit); err != nil {
+		t.Fatalf("ListPets: %v", err)
+	}
+	if received == nil || received.Limit == nil || *received.Limit != 3 {
+		t.Errorf("got parameters %+v, expected a limit of 3", received)
+	}
+	if len(p.contexts) != 1 {
+		t.Fatalf("got %d calls of the provider, expected 1", len(p.contexts))
+	}
+	if user := p.contexts[0].Value(contextKey("user")); user != "alice" {
+		t.Errorf("got user %v in the context of the provider, expected %q", user, "alice")
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type petProvider struct {
+	pets     []Pet
+	problems []string
+}
+
+func (p *petProvider) ListTags(responses *ListTagsResponses) error {
+	if p.problems != nil {
+		responses.Code400 = p.problems
+		return nil
+	}
+	responses.OK = []string{"cat", "dog"}
+	responses.Header = http.Header{"X-Total-Count": {"2"}}
+	return nil
+}
+
+func (p *petProvider) ListPets(responses *ListPetsResponses) error {
+	responses.OK = p.pets
+	return nil
+}
+
+func (p *petProvider) CreatePet(parameters *CreatePetParameters, responses *CreatePetResponses) error {
+	p.pets = append(p.pets, parameters.Pet)
+	responses.Header = http.Header{"Location": {"/pets/" + parameters.Pet.Name}}
+	return nil
+}
+
+func (p *petProvider) GetPet(parameters *GetPetParameters, responses *GetPetResponses) error {
+	for i := range p.pets {
+		if p.pets[i].Name == parameters.Name {
+			responses.OK = &p.pets[i]
+			responses.Header = http.Header{"Etag": {`"` + parameters.Name + `"`}}
+		}
+	}
+	return nil
+}
+
+func (p *petProvider) CountPets(responses *CountPetsResponses) error {
+	responses.OK = make(map[string]int64)
+	for _, pet := range p.pets {
+		responses.OK[pet.Kind]++
+	}
+	return nil
+}
+
+func TestResponses(t *testing.T) {
+	provider := &petProvider{pets: []Pet{{Name: "tom", Kind: "cat"}}}
+	server := httptest.NewServer(NewHandler(provider))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	tags, err := client.ListTags(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tags.OK, []string{"cat", "dog"}) {
+		t.Errorf("ListTags returned %v", tags.OK)
+	}
+	if count := tags.Header.Get("X-Total-Count"); count != "2" {
+		t.Errorf("ListTags returned X-Total-Count %q", count)
+	}
+
+	created, err := client.CreatePet(ctx, Pet{Name: "rex", Kind: "dog"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location := created.Header.Get("Location"); location != "/pets/rex" {
+		t.Errorf("CreatePet returned Location %q", location)
+	}
+
+	pets, err := client.ListPets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(pets.OK, provider.pets) {
+		t.Errorf("ListPets returned %v", pets.OK)
+	}
+
+	pet, err := client.GetPet(ctx, "rex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pet.OK == nil || pet.OK.Kind != "dog" {
+		t.Errorf("GetPet returned %v", pet.OK)
+	}
+	if etag := pet.Header.Get("ETag"); etag != `"rex"` {
+		t.Errorf("GetPet returned ETag %q", etag)
+	}
+
+	counts, err := client.CountPets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(counts.OK, map[string]int64{"cat": 1, "dog": 1}) {
+		t.Errorf("CountPets returned %v", counts.OK)
+	}
+
+	// responses for other status codes are written with their status codes
+	provider.problems = []string{"too many tags"}
+	resp, err := http.Get(server.URL + "/tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var problems []string
+	if err := json.NewDecoder(resp.Body).Decode(&problems); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusBadRequest || !reflect.DeepEqual(problems, provider.problems) {
+		t.Errorf("ListTags returned %s with %v", resp.Status, problems)
+	}
+}
+
+func TestArrayErrorResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`["too many tags"]`))
+	}))
+	defer server.Close()
+	client := NewClient(WithBaseURL(server.URL))
+
+	_, err := client.ListTags(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("ListTags returned %v", err)
+	}
+	if problems, ok := apiErr.Value.([]string); !ok || !reflect.DeepEqual(problems, []string{"too many tags"}) {
+		t.Errorf("ListTags returned an error with value %#v", apiErr.Value)
+	}
+}
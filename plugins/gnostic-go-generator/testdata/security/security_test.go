@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+type petProvider struct {
+	credentials []*Credentials
+}
+
+func (p *petProvider) ListPets(responses *ListPetsResponses) error {
+	responses.OK = []string{"fido"}
+	return nil
+}
+
+func (p *petProvider) ListOwners(responses *ListOwnersResponses) error {
+	responses.OK = []string{"alice"}
+	return nil
+}
+
+func (p *petProvider) CheckCredentials(r *http.Request, credentials []*Credentials) error {
+	p.credentials = credentials
+	for _, c := range credentials {
+		if c.Token == "expired" {
+			return errors.New("expired token")
+		}
+	}
+	return nil
+}
+
+func TestSecurity(t *testing.T) {
+	provider := &petProvider{}
+	server := httptest.NewServer(NewHandler(provider))
+	defer server.Close()
+	ctx := context.Background()
+
+	// requests without credentials are rejected
+	if _, err := NewClient(WithBaseURL(server.URL)).ListPets(ctx); err == nil {
+		t.Errorf("ListPets succeeded without credentials")
+	}
+
+	// tokens from a token source are sent for OAuth2 schemes
+	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "secret", TokenType: "Bearer"})
+	client := NewClient(WithBaseURL(server.URL), WithTokenSource(tokenSource))
+	response, err := client.ListPets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.OK) != 1 {
+		t.Errorf("ListPets returned %v", response.OK)
+	}
+	if len(provider.credentials) != 1 ||
+		provider.credentials[0].Scheme != "petstore_auth" ||
+		provider.credentials[0].Token != "secret" ||
+		len(provider.credentials[0].Scopes) != 1 || provider.credentials[0].Scopes[0] != "read:pets" {
+		t.Errorf("ListPets was called with credentials %+v", provider.credentials)
+	}
+
+	// tokens that are set directly are sent when there is no token source
+	client = NewClient(WithBaseURL(server.URL), WithBearerToken("expired"))
+	if _, err = client.ListPets(ctx); err == nil {
+		t.Errorf("ListPets succeeded with credentials that the provider rejected")
+	}
+
+	// the first set of schemes that the client has credentials for is used
+	client = NewClient(WithBaseURL(server.URL), WithAPIKey("api_key", "key"))
+	if _, err = client.ListPets(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(provider.credentials) != 1 || provider.credentials[0].Scheme != "api_key" || provider.credentials[0].APIKey != "key" {
+		t.Errorf("ListPets was called with credentials %+v", provider.credentials)
+	}
+
+	// all of the schemes of a requirement are needed
+	if _, err = client.ListOwners(ctx); err == nil {
+		t.Errorf("ListOwners succeeded without basic authentication")
+	}
+	client = NewClient(WithBaseURL(server.URL), WithAPIKey("api_key", "key"), WithBasicAuth("alice", "password"))
+	if _, err = client.ListOwners(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(provider.credentials) != 2 ||
+		provider.credentials[0].Username != "alice" || provider.credentials[0].Password != "password" ||
+		provider.credentials[1].APIKey != "key" {
+		t.Errorf("ListOwners was called with credentials %+v", provider.credentials)
+	}
+}
+
+// slowTokenSource is a token source that takes longer to get a token than the calls that use it may take.
+type slowTokenSource struct{}
+
+func (slowTokenSource) Token() (*oauth2.Token, error) {
+	time.Sleep(time.Second)
+	return &oauth2.Token{AccessToken: "secret"}, nil
+}
+
+func TestTokenSourceTimeout(t *testing.T) {
+	// the call ends before its request is sent, so there is no server
+	client := NewClient(WithBaseURL("http://example.invalid"), WithTokenSource(slowTokenSource{}))
+	start := time.Now()
+	_, err := client.ListPets(context.Background(), WithTimeout(10*time.Millisecond))
+	if err != context.DeadlineExceeded {
+		t.Errorf("ListPets returned %v when its token source was too slow", err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("ListPets waited %v for its token source", elapsed)
+	}
+}
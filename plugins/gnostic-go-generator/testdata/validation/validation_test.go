@@ -0,0 +1,236 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// provider records the parameters of the requests that it handles.
+type provider struct {
+	parameters *UpdatePetParameters
+}
+
+func (p *provider) UpdatePet(parameters *UpdatePetParameters) error {
+	p.parameters = parameters
+	return nil
+}
+
+const validPet = `{"kind":"dog","age":3,"owner":{"email":"a@b"},"toys":[{"name":"ball"}]}`
+
+// request describes a request that differs from a valid request by its path, query, headers or body.
+type request struct {
+	path   string
+	query  url.Values
+	header http.Header
+	body   *string
+}
+
+// send sends a request to a handler and returns its response.
+func send(t *testing.T, handler http.Handler, req request) *httptest.ResponseRecorder {
+	path := "/pets/7"
+	if req.path != "" {
+		path = req.path
+	}
+	if req.query != nil {
+		path += "?" + req.query.Encode()
+	}
+	body := validPet
+	if req.body != nil {
+		body = *req.body
+	}
+	r := httptest.NewRequest("PUT", path, strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Request-Id", "1234")
+	for key, values := range req.header {
+		r.Header[key] = values
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func text(s string) *string {
+	return &s
+}
+
+func TestValidRequests(t *testing.T) {
+	p := &provider{}
+	handler := NewHandler(p)
+	for _, req := range []request{
+		{},
+		{path: "/pets/0", query: url.Values{"limit": {"0"}, "ratio": {"0.5"}, "step": {"10"}, "price": {"19.99"}, "nick": {"ab"},
+			"code": {"abc"}, "color": {"red"}, "tags": {"a", "b"}, "verbose": {"false"}}},
+		{query: url.Values{"limit": {"-5"}, "step": {"0"}, "price": {"0.3"}, "nick": {"abcd"}}},
+		// the id is read-only, so it isn't required, and the owner and its required email are optional
+		{body: text(`{"kind":"cat"}`)},
+	} {
+		p.parameters = nil
+		w := send(t, handler, req)
+		if w.Code != http.StatusOK || p.parameters == nil {
+			t.Errorf("%s %+v: got status %d, expected the request to be accepted: %s", req.path, req.query, w.Code, w.Body)
+		}
+	}
+}
+
+func TestViolations(t *testing.T) {
+	for _, test := range []struct {
+		rule       string
+		request    request
+		violations []Violation
+	}{
+		{
+			rule:       "path parameters are integers",
+			request:    request{path: "/pets/seven"},
+			violations: []Violation{{Parameter: "petId", Message: `invalid integer "seven"`}},
+		},
+		{
+			rule:       "a minimum of zero",
+			request:    request{path: "/pets/-1"},
+			violations: []Violation{{Parameter: "petId", Message: "must be at least 0"}},
+		},
+		{
+			rule:       "a maximum of zero",
+			request:    request{query: url.Values{"limit": {"99"}}},
+			violations: []Violation{{Parameter: "limit", Message: "must be at most 0"}},
+		},
+		{
+			rule:       "an exclusive minimum",
+			request:    request{query: url.Values{"ratio": {"0"}}},
+			violations: []Violation{{Parameter: "ratio", Message: "must be greater than 0"}},
+		},
+		{
+			rule:       "an exclusive maximum",
+			request:    request{query: url.Values{"ratio": {"1"}}},
+			violations: []Violation{{Parameter: "ratio", Message: "must be less than 1"}},
+		},
+		{
+			rule:       "multiples",
+			request:    request{query: url.Values{"step": {"7"}}},
+			violations: []Violation{{Parameter: "step", Message: "must be a multiple of 5"}},
+		},
+		{
+			rule:       "decimal multiples",
+			request:    request{query: url.Values{"price": {"0.015"}}},
+			violations: []Violation{{Parameter: "price", Message: "must be a multiple of 0.01"}},
+		},
+		{
+			rule:       "a minimum length",
+			request:    request{query: url.Values{"nick": {"a"}}},
+			violations: []Violation{{Parameter: "nick", Message: "must have at least 2 characters"}},
+		},
+		{
+			rule:       "a maximum length",
+			request:    request{query: url.Values{"nick": {"abcde"}}},
+			violations: []Violation{{Parameter: "nick", Message: "must have at most 4 characters"}},
+		},
+		{
+			rule:       "patterns",
+			request:    request{query: url.Values{"code": {"ABC"}}},
+			violations: []Violation{{Parameter: "code", Message: `must match the pattern "^[a-z]+$"`}},
+		},
+		{
+			rule:       "enums",
+			request:    request{query: url.Values{"color": {"blue"}}},
+			violations: []Violation{{Parameter: "color", Message: "invalid value blue"}},
+		},
+		{
+			rule:       "a maximum number of items",
+			request:    request{query: url.Values{"tags": {"a", "b", "c"}}},
+			violations: []Violation{{Parameter: "tags", Message: "must have at most 2 items"}},
+		},
+		{
+			rule:       "unique items",
+			request:    request{query: url.Values{"tags": {"a", "a"}}},
+			violations: []Violation{{Parameter: "tags", Message: "items must be unique"}},
+		},
+		{
+			rule:       "booleans",
+			request:    request{query: url.Values{"verbose": {"maybe"}}},
+			violations: []Violation{{Parameter: "verbose", Message: `invalid boolean "maybe"`}},
+		},
+		{
+			rule:       "required headers",
+			request:    request{header: http.Header{"X-Request-Id": nil}},
+			violations: []Violation{{Parameter: "X-Request-Id", Message: "missing required parameter"}},
+		},
+		{
+			rule:       "required bodies",
+			request:    request{body: text("")},
+			violations: []Violation{{Parameter: "pet", Message: "missing request body"}},
+		},
+		{
+			rule:       "required properties",
+			request:    request{body: text(`{"age":1}`)},
+			violations: []Violation{{Parameter: "pet", Pointer: "/kind", Message: "missing required value"}},
+		},
+		{
+			rule:       "a minimum of zero in a body",
+			request:    request{body: text(`{"kind":"dog","age":-3}`)},
+			violations: []Violation{{Parameter: "pet", Pointer: "/age", Message: "must be at least 0"}},
+		},
+		{
+			rule:    "properties of nested objects",
+			request: request{body: text(`{"kind":"dog","owner":{"email":"nobody"}}`)},
+			violations: []Violation{
+				{Parameter: "pet", Pointer: "/owner/email", Message: `must match the pattern "^[^@]+@[^@]+$"`},
+			},
+		},
+		{
+			rule:       "a minimum number of items",
+			request:    request{body: text(`{"kind":"dog","toys":[]}`)},
+			violations: []Violation{{Parameter: "pet", Pointer: "/toys", Message: "must have at least 1 items"}},
+		},
+		{
+			rule:    "items of arrays",
+			request: request{body: text(`{"kind":"dog","toys":[{"name":"ball"},{}]}`)},
+			violations: []Violation{
+				{Parameter: "pet", Pointer: "/toys/1/name", Message: "missing required value"},
+			},
+		},
+		{
+			rule:    "every violation is reported",
+			request: request{path: "/pets/-1", query: url.Values{"limit": {"1"}}, body: text(`{}`)},
+			violations: []Violation{
+				{Parameter: "pet", Pointer: "/kind", Message: "missing required value"},
+				{Parameter: "petId", Message: "must be at least 0"},
+				{Parameter: "limit", Message: "must be at most 0"},
+			},
+		},
+	} {
+		p := &provider{}
+		w := send(t, NewHandler(p), test.request)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: got status %d, expected %d: %s", test.rule, w.Code, http.StatusBadRequest, w.Body)
+			continue
+		}
+		if p.parameters != nil {
+			t.Errorf("%s: an invalid request was passed to the provider", test.rule)
+		}
+		if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("%s: content type is %q, expected application/json", test.rule, contentType)
+		}
+		var body ValidationError
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Errorf("%s: %v: %s", test.rule, err, w.Body)
+			continue
+		}
+		if !reflect.DeepEqual(body.Violations, test.violations) {
+			t.Errorf("%s: got violations %+v, expected %+v", test.rule, body.Violations, test.violations)
+		}
+	}
+}
+
+func TestViolationsFormat(t *testing.T) {
+	w := send(t, NewHandler(&provider{}), request{path: "/pets/-1", body: text(`{"kind":"dog","age":-3}`)})
+	expected := `{"violations":[` +
+		`{"parameter":"pet","pointer":"/age","message":"must be at least 0"},` +
+		`{"parameter":"petId","message":"must be at least 0"}]}`
+	if body := strings.TrimSpace(w.Body.String()); body != expected {
+		t.Errorf("got body %s, expected %s", body, expected)
+	}
+}
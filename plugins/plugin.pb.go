@@ -146,6 +146,8 @@ type Request struct {
 	CompilerVersion *Version `protobuf:"bytes,4,opt,name=compiler_version,json=compilerVersion" json:"compiler_version,omitempty"`
 	// API models
 	Models []*google_protobuf.Any `protobuf:"bytes,5,rep,name=models" json:"models,omitempty"`
+	// problems found by gnostic while building the API models
+	Messages []*Message `protobuf:"bytes,6,rep,name=messages" json:"messages,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -188,6 +190,13 @@ func (m *Request) GetModels() []*google_protobuf.Any {
 	return nil
 }
 
+func (m *Request) GetMessages() []*Message {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // Plugins can return messages to be collated and reported by gnostic.
 type Message struct {
 	// message severity
@@ -334,39 +343,39 @@ func init() {
 func init() { proto.RegisterFile("plugins/plugin.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xc5, 0xb1, 0xdd, 0xc4, 0x13, 0x28, 0x66, 0x55, 0x81, 0xa9, 0x90, 0x1a, 0xf9, 0x42, 0x0e,
-	0xe0, 0xa8, 0x41, 0xf4, 0xc4, 0x25, 0x91, 0x9a, 0xa8, 0xa2, 0x38, 0xd1, 0x0a, 0xe8, 0x31, 0xda,
-	0x3a, 0x1b, 0xc7, 0x60, 0x7b, 0xcd, 0xee, 0x3a, 0x6a, 0x3e, 0x81, 0xdf, 0xe0, 0x4b, 0xf8, 0x32,
-	0x84, 0x76, 0xd7, 0x89, 0x8a, 0x08, 0x07, 0x4e, 0x7e, 0xf3, 0xf4, 0xfc, 0x66, 0xe6, 0xed, 0xc0,
-	0x49, 0x95, 0xd7, 0x69, 0x56, 0x8a, 0x81, 0xf9, 0x46, 0x15, 0x67, 0x92, 0xa1, 0x27, 0x69, 0xc9,
-	0x84, 0xcc, 0x92, 0xa8, 0x61, 0x37, 0xe7, 0xa7, 0xcf, 0x53, 0xc6, 0xd2, 0x9c, 0x0e, 0xb4, 0xe0,
-	0xb6, 0x5e, 0x0d, 0x48, 0xb9, 0x35, 0xea, 0x30, 0x81, 0xf6, 0x67, 0xca, 0x45, 0xc6, 0x4a, 0x74,
-	0x02, 0x6e, 0x41, 0xbe, 0x30, 0x1e, 0x58, 0x3d, 0xab, 0xef, 0x62, 0x53, 0x68, 0x36, 0x2b, 0x19,
-	0x0f, 0x5a, 0x0d, 0xab, 0x0a, 0xc5, 0x56, 0x44, 0x26, 0xeb, 0xc0, 0x36, 0xac, 0x2e, 0xd0, 0x53,
-	0x38, 0x12, 0xf5, 0x6a, 0x95, 0xdd, 0x05, 0x4e, 0xcf, 0xea, 0x7b, 0xb8, 0xa9, 0xc2, 0xb7, 0xe0,
-	0xcd, 0x09, 0x27, 0x05, 0x95, 0x94, 0x23, 0x04, 0x4e, 0x49, 0x0a, 0xaa, 0xbb, 0x78, 0x58, 0x63,
-	0x65, 0xb7, 0x21, 0x79, 0x4d, 0x75, 0x13, 0x0f, 0x9b, 0x22, 0xfc, 0x65, 0x41, 0x1b, 0xd3, 0x6f,
-	0x35, 0x15, 0x12, 0x9d, 0x41, 0x57, 0xb0, 0x9a, 0x27, 0x74, 0x71, 0xef, 0x67, 0x30, 0x54, 0xac,
-	0x2c, 0xce, 0xa0, 0xcb, 0x6a, 0x59, 0xd5, 0x72, 0x51, 0x11, 0xb9, 0x6e, 0x8c, 0xc0, 0x50, 0x73,
-	0x22, 0xd7, 0xe8, 0x1d, 0x40, 0xb5, 0x1b, 0x42, 0x04, 0x76, 0xcf, 0xee, 0x77, 0x87, 0x2f, 0xa2,
-	0xbf, 0xc2, 0x8a, 0xf6, 0x93, 0xe2, 0x7b, 0x7a, 0x74, 0x09, 0x7e, 0xc2, 0x8a, 0x2a, 0xcb, 0x29,
-	0x5f, 0x6c, 0x4c, 0x60, 0x7a, 0xc9, 0xee, 0xf0, 0xf4, 0x80, 0x47, 0x13, 0x29, 0x7e, 0xbc, 0xfb,
-	0x67, 0x97, 0xf1, 0x2b, 0x38, 0x2a, 0xd8, 0x92, 0xe6, 0x22, 0x70, 0xf5, 0x00, 0x27, 0x91, 0x79,
-	0x9a, 0x68, 0xf7, 0x34, 0xd1, 0xa8, 0xdc, 0xe2, 0x46, 0x13, 0xfe, 0xb4, 0xa0, 0xfd, 0x81, 0x0a,
-	0x41, 0x52, 0x8a, 0x2e, 0xc0, 0xcd, 0xe9, 0x86, 0xe6, 0x7a, 0xf5, 0xe3, 0x61, 0xef, 0x40, 0xd7,
-	0x46, 0x1a, 0x5d, 0x2b, 0x1d, 0x36, 0x72, 0x15, 0x77, 0xc2, 0x96, 0xbb, 0x64, 0x35, 0x56, 0x9c,
-	0xa4, 0x77, 0x52, 0x3f, 0x9e, 0x87, 0x35, 0x56, 0xdc, 0x57, 0xba, 0x15, 0x81, 0xd3, 0xb3, 0x15,
-	0xa7, 0x70, 0x38, 0x02, 0x57, 0x7b, 0xa1, 0x2e, 0xb4, 0x3f, 0xc5, 0xef, 0xe3, 0xd9, 0x4d, 0xec,
-	0x3f, 0x40, 0x1d, 0x70, 0xae, 0xe2, 0xc9, 0xcc, 0xb7, 0x14, 0x7d, 0x33, 0xc2, 0xf1, 0x55, 0x3c,
-	0xf5, 0x5b, 0xc8, 0x03, 0xf7, 0x12, 0xe3, 0x19, 0xf6, 0x6d, 0x05, 0x27, 0xa3, 0x8f, 0xa3, 0x6b,
-	0xdf, 0x09, 0xc7, 0xd0, 0x69, 0xc6, 0x12, 0xe8, 0x02, 0x3a, 0x45, 0x83, 0x03, 0x4b, 0xaf, 0x7f,
-	0xfa, 0xef, 0x2d, 0xf0, 0x5e, 0x1b, 0x7e, 0xb7, 0xa0, 0x83, 0xa9, 0xa8, 0x58, 0x29, 0xa8, 0xba,
-	0x31, 0xca, 0x39, 0xe3, 0xc6, 0xc2, 0xc3, 0x4d, 0x85, 0x5e, 0x83, 0xbb, 0xca, 0x72, 0x2a, 0x82,
-	0x96, 0x76, 0x7e, 0x76, 0xc0, 0x79, 0x92, 0xe5, 0x14, 0x1b, 0xd5, 0x1f, 0xb3, 0xd8, 0xff, 0x31,
-	0x4b, 0x04, 0x8e, 0xb2, 0x39, 0x78, 0xc5, 0x08, 0x9c, 0x25, 0x91, 0x44, 0x47, 0xfd, 0x10, 0x6b,
-	0x3c, 0x7e, 0x09, 0xc7, 0x8c, 0xa7, 0x7b, 0xeb, 0xcd, 0xf9, 0xf8, 0xd1, 0xd4, 0xe0, 0xb9, 0xee,
-	0x32, 0xb7, 0x7e, 0xb4, 0xec, 0x69, 0x3c, 0xbb, 0x3d, 0xd2, 0x17, 0xf0, 0xe6, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0xa0, 0x65, 0xe7, 0xd5, 0x03, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0xfd, 0x1c, 0xdb, 0x49, 0x3c, 0xf9, 0x28, 0x66, 0x55, 0x81, 0xa9, 0x90, 0x6a, 0xf9, 0x42,
+	0x0f, 0xe0, 0xaa, 0x45, 0xf4, 0xc4, 0x25, 0x95, 0x9a, 0xa8, 0xa2, 0x38, 0xd1, 0x0a, 0xe8, 0x31,
+	0xda, 0x3a, 0x1b, 0xc7, 0x60, 0x7b, 0xcd, 0xee, 0x3a, 0x6a, 0x7e, 0x02, 0x7f, 0x83, 0x1f, 0xc1,
+	0x99, 0x9f, 0x86, 0x76, 0xd7, 0x89, 0x5a, 0x91, 0x1e, 0x7a, 0xf2, 0x9b, 0xa7, 0xb7, 0x6f, 0xc6,
+	0x6f, 0x06, 0xf6, 0xeb, 0xa2, 0xc9, 0xf2, 0x4a, 0x1c, 0x9b, 0x6f, 0x5c, 0x73, 0x26, 0x19, 0x7a,
+	0x96, 0x55, 0x4c, 0xc8, 0x3c, 0x8d, 0x5b, 0x76, 0x75, 0x72, 0xf0, 0x32, 0x63, 0x2c, 0x2b, 0xe8,
+	0xb1, 0x16, 0xdc, 0x34, 0x8b, 0x63, 0x52, 0xad, 0x8d, 0x3a, 0x4a, 0xa1, 0xf7, 0x95, 0x72, 0x91,
+	0xb3, 0x0a, 0xed, 0x83, 0x5b, 0x92, 0x6f, 0x8c, 0x07, 0x56, 0x68, 0x1d, 0xb9, 0xd8, 0x14, 0x9a,
+	0xcd, 0x2b, 0xc6, 0x83, 0x4e, 0xcb, 0xe6, 0x95, 0x61, 0x6b, 0x22, 0xd3, 0x65, 0x60, 0x1b, 0x56,
+	0x17, 0xe8, 0x39, 0x74, 0x45, 0xb3, 0x58, 0xe4, 0xb7, 0x81, 0x13, 0x5a, 0x47, 0x1e, 0x6e, 0xab,
+	0xe8, 0x3d, 0x78, 0x53, 0xc2, 0x49, 0x49, 0x25, 0xe5, 0x08, 0x81, 0x53, 0x91, 0x92, 0xea, 0x2e,
+	0x1e, 0xd6, 0x58, 0xd9, 0xad, 0x48, 0xd1, 0x50, 0xdd, 0xc4, 0xc3, 0xa6, 0x88, 0x7e, 0x77, 0xa0,
+	0x87, 0xe9, 0x8f, 0x86, 0x0a, 0x89, 0x0e, 0x61, 0x20, 0x58, 0xc3, 0x53, 0x3a, 0xbb, 0xf3, 0x18,
+	0x0c, 0x95, 0x28, 0x8b, 0x43, 0x18, 0xb0, 0x46, 0xd6, 0x8d, 0x9c, 0xd5, 0x44, 0x2e, 0x5b, 0x23,
+	0x30, 0xd4, 0x94, 0xc8, 0x25, 0xfa, 0x00, 0x50, 0x6f, 0x86, 0x10, 0x81, 0x1d, 0xda, 0x47, 0x83,
+	0xd3, 0x57, 0xf1, 0x3f, 0x61, 0xc5, 0xdb, 0x49, 0xf1, 0x1d, 0x3d, 0xba, 0x00, 0x3f, 0x65, 0x65,
+	0x9d, 0x17, 0x94, 0xcf, 0x56, 0x26, 0x30, 0xfd, 0x93, 0x83, 0xd3, 0x83, 0x1d, 0x1e, 0x6d, 0xa4,
+	0xf8, 0xe9, 0xe6, 0xcd, 0x26, 0xe3, 0x37, 0xd0, 0x2d, 0xd9, 0x9c, 0x16, 0x22, 0x70, 0xf5, 0x00,
+	0xfb, 0xb1, 0x59, 0x4d, 0xbc, 0x59, 0x4d, 0x3c, 0xac, 0xd6, 0xb8, 0xd5, 0xa0, 0x33, 0xe8, 0x97,
+	0x54, 0x08, 0x92, 0x51, 0x11, 0x74, 0x43, 0xfb, 0x81, 0x66, 0x9f, 0x8c, 0x04, 0x6f, 0xb5, 0xd1,
+	0x1f, 0x0b, 0x7a, 0x2d, 0x8b, 0xce, 0xc0, 0x2d, 0xe8, 0x8a, 0x16, 0x3a, 0xb2, 0xbd, 0xd3, 0xf0,
+	0x61, 0x83, 0xf8, 0x4a, 0xe9, 0xb0, 0x91, 0xab, 0x35, 0xa5, 0x6c, 0xbe, 0xd9, 0x88, 0xc6, 0x8a,
+	0x93, 0xf4, 0x56, 0xea, 0xa5, 0x7b, 0x58, 0x63, 0xc5, 0x7d, 0xa7, 0x6b, 0x11, 0x38, 0xa1, 0xad,
+	0x38, 0x85, 0xa3, 0x21, 0xb8, 0xda, 0x0b, 0x0d, 0xa0, 0xf7, 0x25, 0xf9, 0x98, 0x4c, 0xae, 0x13,
+	0xff, 0x3f, 0xd4, 0x07, 0xe7, 0x32, 0x19, 0x4d, 0x7c, 0x4b, 0xd1, 0xd7, 0x43, 0x9c, 0x5c, 0x26,
+	0x63, 0xbf, 0x83, 0x3c, 0x70, 0x2f, 0x30, 0x9e, 0x60, 0xdf, 0x56, 0x70, 0x34, 0xfc, 0x3c, 0xbc,
+	0xf2, 0x9d, 0xe8, 0x1c, 0xfa, 0xed, 0x58, 0xf7, 0x63, 0xb0, 0x1e, 0x11, 0xc3, 0x4f, 0x0b, 0xfa,
+	0x98, 0x8a, 0x9a, 0x55, 0x82, 0xaa, 0xdb, 0xa4, 0x9c, 0x33, 0x6e, 0x2c, 0x3c, 0xdc, 0x56, 0xe8,
+	0x2d, 0xb8, 0x8b, 0xbc, 0xa0, 0x22, 0xe8, 0x68, 0xe7, 0x17, 0x3b, 0x9c, 0x47, 0x79, 0x41, 0xb1,
+	0x51, 0xdd, 0x9b, 0xc5, 0x7e, 0xc4, 0x2c, 0x31, 0x38, 0xca, 0x66, 0xe7, 0xf5, 0x23, 0x70, 0xe6,
+	0x44, 0x12, 0x1d, 0xf5, 0xff, 0x58, 0xe3, 0xf3, 0xd7, 0xb0, 0xc7, 0x78, 0xb6, 0xb5, 0x5e, 0x9d,
+	0x9c, 0x3f, 0x19, 0x1b, 0x3c, 0xd5, 0x5d, 0xa6, 0xd6, 0xaf, 0x8e, 0x3d, 0x4e, 0x26, 0x37, 0x5d,
+	0x7d, 0x39, 0xef, 0xfe, 0x0e, 0x00, 0x74, 0x6a, 0xaf, 0x3c, 0x0d, 0x04, 0x00, 0x00,
 }
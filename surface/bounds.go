@@ -0,0 +1,153 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surface_v1
+
+import (
+	"fmt"
+	"strconv"
+
+	openapiv2 "github.com/googleapis/gnostic/OpenAPIv2"
+	openapiv3 "github.com/googleapis/gnostic/OpenAPIv3"
+	"github.com/googleapis/gnostic/compiler"
+)
+
+// boundKeys records whether the description of a schema or parameter specifies a minimum and a maximum.
+type boundKeys struct {
+	minimum bool
+	maximum bool
+}
+
+// bounds records the bounds of the schemas and parameters of a compiled document. Compiled documents
+// don't record numbers that are zero, so bounds are found in the description that a document was
+// compiled from by looking up the key path of each schema and parameter.
+type bounds struct {
+	info interface{}
+	keys map[constrainedValue]boundKeys
+}
+
+func newBounds(info interface{}) *bounds {
+	return &bounds{info: info, keys: make(map[constrainedValue]boundKeys)}
+}
+
+// record records the bounds that the description specifies for a value at a key path.
+func (b *bounds) record(v constrainedValue, path []string) error {
+	m, ok := compiler.UnpackMap(infoAtPath(b.info, path))
+	if ok {
+		b.keys[v] = boundKeys{
+			minimum: compiler.MapHasKey(m, "minimum"),
+			maximum: compiler.MapHasKey(m, "maximum"),
+		}
+	}
+	return nil
+}
+
+// constraintsForValue returns the constraints on the values of a schema or parameter. Values that
+// weren't found in the description have bounds if they are nonzero or exclusive.
+func (b *bounds) constraintsForValue(v constrainedValue) *Constraints {
+	c := constraintsForValue(v)
+	if keys, ok := b.keys[v]; ok {
+		c.HasMinimum = keys.minimum
+		c.HasMaximum = keys.maximum
+	}
+	return c
+}
+
+// infoAtPath returns the part of a description at a key path, or nil if there is none.
+func infoAtPath(info interface{}, path []string) interface{} {
+	for _, key := range path {
+		switch value := info.(type) {
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(value) {
+				return nil
+			}
+			info = value[i]
+		default:
+			m, ok := compiler.UnpackMap(info)
+			if !ok {
+				return nil
+			}
+			// keys such as response codes may be parsed as numbers, so compare them as strings
+			info = nil
+			for _, item := range m {
+				if fmt.Sprintf("%v", item.Key) == key {
+					info = item.Value
+					break
+				}
+			}
+		}
+	}
+	return info
+}
+
+// openAPI3BoundsVisitor records the bounds of the schemas of an OpenAPI v3 document.
+type openAPI3BoundsVisitor struct {
+	openapiv3.BaseVisitor
+	bounds *bounds
+}
+
+func (v *openAPI3BoundsVisitor) VisitSchema(node *openapiv3.Schema, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+// boundsForOpenAPI3 finds the bounds of the schemas of an OpenAPI v3 document in the description
+// that it was compiled from. Documents without descriptions have no recorded bounds.
+func boundsForOpenAPI3(document *openapiv3.Document, info interface{}) *bounds {
+	b := newBounds(info)
+	if info != nil {
+		openapiv3.Walk(document, &openAPI3BoundsVisitor{bounds: b})
+	}
+	return b
+}
+
+// openAPI2BoundsVisitor records the bounds of the schemas, parameters and headers of an OpenAPI v2 document.
+type openAPI2BoundsVisitor struct {
+	openapiv2.BaseVisitor
+	bounds *bounds
+}
+
+func (v *openAPI2BoundsVisitor) VisitSchema(node *openapiv2.Schema, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+func (v *openAPI2BoundsVisitor) VisitHeader(node *openapiv2.Header, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+func (v *openAPI2BoundsVisitor) VisitHeaderParameterSubSchema(node *openapiv2.HeaderParameterSubSchema, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+func (v *openAPI2BoundsVisitor) VisitFormDataParameterSubSchema(node *openapiv2.FormDataParameterSubSchema, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+func (v *openAPI2BoundsVisitor) VisitQueryParameterSubSchema(node *openapiv2.QueryParameterSubSchema, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+func (v *openAPI2BoundsVisitor) VisitPathParameterSubSchema(node *openapiv2.PathParameterSubSchema, path []string) error {
+	return v.bounds.record(node, path)
+}
+
+// boundsForOpenAPI2 finds the bounds of the schemas, parameters and headers of an OpenAPI v2 document
+// in the description that it was compiled from. Documents without descriptions have no recorded bounds.
+func boundsForOpenAPI2(document *openapiv2.Document, info interface{}) *bounds {
+	b := newBounds(info)
+	if info != nil {
+		openapiv2.Walk(document, &openAPI2BoundsVisitor{bounds: b})
+	}
+	return b
+}
@@ -0,0 +1,259 @@
+package surface_v1
+
+import (
+	"strings"
+	"testing"
+
+	openapiv3 "github.com/googleapis/gnostic/OpenAPIv3"
+	"github.com/googleapis/gnostic/compiler"
+)
+
+// boundsTest is a field and the bounds that are expected for it.
+type boundsTest struct {
+	field      string
+	hasMinimum bool
+	minimum    float64
+	hasMaximum bool
+	maximum    float64
+}
+
+func testBounds(t *testing.T, typ *Type, tests []boundsTest) {
+	for _, test := range tests {
+		c := fieldNamed(t, typ, test.field).GetConstraints()
+		if c.GetHasMinimum() != test.hasMinimum || c.GetMinimum() != test.minimum {
+			t.Errorf("%s has minimum %v (%v), expected %v (%v)",
+				test.field, c.GetMinimum(), c.GetHasMinimum(), test.minimum, test.hasMinimum)
+		}
+		if c.GetHasMaximum() != test.hasMaximum || c.GetMaximum() != test.maximum {
+			t.Errorf("%s has maximum %v (%v), expected %v (%v)",
+				test.field, c.GetMaximum(), c.GetHasMaximum(), test.maximum, test.hasMaximum)
+		}
+	}
+}
+
+func TestOpenAPI3Bounds(t *testing.T) {
+	model, _ := buildOpenAPI3(t, `
+openapi: 3.0.0
+info:
+  title: Bounds
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Bounds:
+      type: object
+      properties:
+        unbounded:
+          type: integer
+        natural:
+          type: integer
+          minimum: 0
+        nonPositive:
+          type: integer
+          maximum: 0
+        positive:
+          type: number
+          minimum: 0
+          exclusiveMinimum: true
+        negative:
+          type: number
+          maximum: 0
+          exclusiveMaximum: true
+        percent:
+          type: integer
+          minimum: 1
+          maximum: 100
+`)
+	testBounds(t, typeNamed(t, model, "Bounds"), []boundsTest{
+		{field: "unbounded"},
+		{field: "natural", hasMinimum: true},
+		{field: "nonPositive", hasMaximum: true},
+		{field: "positive", hasMinimum: true},
+		{field: "negative", hasMaximum: true},
+		{field: "percent", hasMinimum: true, minimum: 1, hasMaximum: true, maximum: 100},
+	})
+}
+
+func TestOpenAPI3ParameterBounds(t *testing.T) {
+	model, _ := buildOpenAPI3(t, `
+openapi: 3.0.0
+info:
+  title: Bounds
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+      - name: offset
+        in: query
+        schema:
+          type: integer
+          minimum: 0
+      - $ref: '#/components/parameters/limit'
+      responses:
+        "204":
+          description: no pets
+components:
+  parameters:
+    limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+        minimum: 0
+        maximum: 0
+`)
+	testBounds(t, typeNamed(t, model, "ListPetsParameters"), []boundsTest{
+		{field: "offset", hasMinimum: true},
+		{field: "limit", hasMinimum: true, hasMaximum: true},
+	})
+}
+
+func TestBoundsWithoutInfo(t *testing.T) {
+	// without descriptions, bounds that are zero can't be found
+	document, err := openapiv3.NewDocument(readInfo(t, `
+openapi: 3.0.0
+info:
+  title: Bounds
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Bounds:
+      type: object
+      properties:
+        natural:
+          type: integer
+          minimum: 0
+        percent:
+          type: integer
+          minimum: 1
+          maximum: 100
+`), compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	model, err := NewModelFromOpenAPI3(document)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	testBounds(t, typeNamed(t, model, "Bounds"), []boundsTest{
+		{field: "natural"},
+		{field: "percent", hasMinimum: true, minimum: 1, hasMaximum: true, maximum: 100},
+	})
+}
+
+func TestOpenAPI2Bounds(t *testing.T) {
+	model, _ := buildOpenAPI2(t, `
+swagger: "2.0"
+info:
+  title: Bounds
+  version: 1.0.0
+paths: {}
+definitions:
+  Bounds:
+    type: object
+    properties:
+      unbounded:
+        type: integer
+      natural:
+        type: integer
+        minimum: 0
+      positive:
+        type: number
+        minimum: 0
+        exclusiveMinimum: true
+      percent:
+        type: integer
+        minimum: 1
+        maximum: 100
+`)
+	testBounds(t, typeNamed(t, model, "Bounds"), []boundsTest{
+		{field: "unbounded"},
+		{field: "natural", hasMinimum: true},
+		{field: "positive", hasMinimum: true},
+		{field: "percent", hasMinimum: true, minimum: 1, hasMaximum: true, maximum: 100},
+	})
+}
+
+func TestOpenAPI2ParameterBounds(t *testing.T) {
+	model, _ := buildOpenAPI2(t, `
+swagger: "2.0"
+info:
+  title: Bounds
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+      - name: offset
+        in: query
+        type: integer
+        minimum: 0
+      - name: X-Limit
+        in: header
+        type: integer
+        maximum: 0
+      responses:
+        "204":
+          description: no pets
+`)
+	testBounds(t, typeNamed(t, model, "ListPetsParameters"), []boundsTest{
+		{field: "offset", hasMinimum: true},
+		{field: "X-Limit", hasMaximum: true},
+	})
+}
+
+func TestDiscoveryBounds(t *testing.T) {
+	model, _ := buildDiscovery(t, `{
+  "kind": "discovery#restDescription",
+  "discoveryVersion": "v1",
+  "name": "bounds",
+  "version": "v1",
+  "schemas": {
+    "Bounds": {
+      "id": "Bounds",
+      "type": "object",
+      "properties": {
+        "unbounded": {"type": "integer"},
+        "natural": {"type": "integer", "minimum": "0"},
+        "nonPositive": {"type": "integer", "maximum": "0"},
+        "percent": {"type": "integer", "minimum": "1", "maximum": "100"}
+      }
+    }
+  }
+}`)
+	testBounds(t, typeNamed(t, model, "Bounds"), []boundsTest{
+		{field: "unbounded"},
+		{field: "natural", hasMinimum: true},
+		{field: "nonPositive", hasMaximum: true},
+		{field: "percent", hasMinimum: true, minimum: 1, hasMaximum: true, maximum: 100},
+	})
+}
+
+func TestReadOnlyProperties(t *testing.T) {
+	schemas := `
+      type: object
+      required:
+      - id
+      properties:
+        id:
+          type: string
+          readOnly: true
+        name:
+          type: string
+`
+	model3, _ := buildOpenAPI3(t, "openapi: 3.0.0\ninfo:\n  title: ReadOnly\n  version: 1.0.0\npaths: {}\ncomponents:\n  schemas:\n    Pet:"+schemas)
+	model2, _ := buildOpenAPI2(t, "swagger: \"2.0\"\ninfo:\n  title: ReadOnly\n  version: 1.0.0\npaths: {}\ndefinitions:\n  Pet:"+strings.Replace(schemas, "\n  ", "\n", -1))
+	for _, model := range []*Model{model3, model2} {
+		pet := typeNamed(t, model, "Pet")
+		if c := fieldNamed(t, pet, "id").GetConstraints(); !c.GetReadOnly() || !c.GetRequired() {
+			t.Errorf("id has constraints %v, expected it to be required and read-only", c)
+		}
+		if c := fieldNamed(t, pet, "name").GetConstraints(); c.GetReadOnly() {
+			t.Errorf("name has constraints %v, expected it not to be read-only", c)
+		}
+	}
+}
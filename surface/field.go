@@ -14,7 +14,58 @@
 
 package surface_v1
 
+import (
+	"github.com/golang/protobuf/proto"
+)
+
 // ServiceType returns the Type associated with a field.
 func (f *Field) ServiceType(m *Model) *Type {
 	return m.TypeWithTypeName(f.NativeType)
 }
+
+// setConstraints sets the constraints of a field, keeping any requirement that was already set.
+// Fields without constraints are left with none.
+func (f *Field) setConstraints(c *Constraints) {
+	if c == nil {
+		return
+	}
+	c.Required = c.Required || f.GetConstraints().GetRequired()
+	if proto.Equal(c, &Constraints{}) {
+		f.Constraints = nil
+	} else {
+		f.Constraints = c
+	}
+}
+
+// setRequired marks a field as one that must have a value.
+func (f *Field) setRequired() {
+	if f.Constraints == nil {
+		f.Constraints = &Constraints{}
+	}
+	f.Constraints.Required = true
+}
+
+// defaultStyle returns the OpenAPI style that values of parameters in a position are serialized with by default.
+func defaultStyle(position Position) string {
+	switch position {
+	case Position_QUERY, Position_COOKIE, Position_FORMDATA:
+		return "form"
+	case Position_PATH, Position_HEADER:
+		return "simple"
+	default:
+		return ""
+	}
+}
+
+// setStyle sets the way that the values of a parameter are serialized. Parameters that don't
+// specify a style use the default style of their position, and parameters in the form style
+// are exploded unless they specify a style.
+func (f *Field) setStyle(style string, explode bool) {
+	if style == "" {
+		f.Style = defaultStyle(f.Position)
+		f.Explode = explode || f.Style == "form"
+	} else {
+		f.Style = style
+		f.Explode = explode
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surface_v1
+
+import (
+	"strings"
+)
+
+func (m *Method) addResponse(r *Response) {
+	m.Responses = append(m.Responses, r)
+}
+
+func (m *Method) addRequestBody(r *RequestBody) {
+	m.RequestBodies = append(m.RequestBodies, r)
+}
+
+func (m *Method) addExtension(name, yaml string) {
+	m.Extensions = append(m.Extensions, &Extension{Name: name, Yaml: strings.TrimSuffix(yaml, "\n")})
+}
+
+// ExtensionWithName returns the extension of a method with the specified name, or nil if there is none.
+func (m *Method) ExtensionWithName(name string) *Extension {
+	for _, extension := range m.Extensions {
+		if extension.Name == name {
+			return extension
+		}
+	}
+	return nil
+}
+
+func (r *SecurityRequirement) addScheme(name string, scopes []string) {
+	r.Schemes = append(r.Schemes, &RequiredScheme{Name: name, Scopes: scopes})
+}
+
+// positionForSecurityScheme returns the position of an API key that is sent "in" the specified location.
+func positionForSecurityScheme(in string) Position {
+	switch in {
+	case "query":
+		return Position_QUERY
+	case "cookie":
+		return Position_COOKIE
+	default:
+		return Position_HEADER
+	}
+}
+
+// markErrorResponses marks the responses of a method that report errors.
+// Responses with 4XX and 5XX codes report errors, and so do default responses
+// if the method has a response for success.
+func (m *Method) markErrorResponses() {
+	hasSuccess := false
+	for _, r := range m.Responses {
+		if strings.HasPrefix(r.Code, "2") {
+			hasSuccess = true
+		}
+	}
+	for _, r := range m.Responses {
+		switch {
+		case strings.HasPrefix(r.Code, "4"), strings.HasPrefix(r.Code, "5"):
+			r.IsError = true
+		case r.Code == "default":
+			r.IsError = hasSuccess
+		}
+	}
+}
+
+// hasResponseHeaders returns true if any of the responses of a method has headers.
+func (m *Method) hasResponseHeaders() bool {
+	for _, r := range m.Responses {
+		if len(r.Headers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// responseFieldKind returns the kind of the field that holds a response body of the specified kind.
+// Arrays and maps are held as they are, and other bodies are held by reference.
+func responseFieldKind(kind FieldKind) FieldKind {
+	switch kind {
+	case FieldKind_ARRAY, FieldKind_MAP:
+		return kind
+	default:
+		return FieldKind_REFERENCE
+	}
+}
+
+// preferredMediaType returns the media type that generated code should use when a choice is available.
+// JSON is preferred, followed by the first media type in the list.
+func preferredMediaType(mediaTypes []string) string {
+	for _, mediaType := range mediaTypes {
+		if mediaType == "application/json" {
+			return mediaType
+		}
+	}
+	for _, mediaType := range mediaTypes {
+		if strings.Contains(mediaType, "json") {
+			return mediaType
+		}
+	}
+	if len(mediaTypes) > 0 {
+		return mediaTypes[0]
+	}
+	return ""
+}
@@ -15,8 +15,13 @@
 package surface_v1
 
 import (
+	"fmt"
 	"path"
+	"strconv"
 	"strings"
+	"unicode"
+
+	yaml "gopkg.in/yaml.v2"
 )
 
 func (m *Model) addType(t *Type) {
@@ -27,6 +32,61 @@ func (m *Model) addMethod(method *Method) {
 	m.Methods = append(m.Methods, method)
 }
 
+func (m *Model) addSecurityScheme(s *SecurityScheme) {
+	m.SecuritySchemes = append(m.SecuritySchemes, s)
+}
+
+func (m *Model) addServer(s *Server) {
+	m.Servers = append(m.Servers, s)
+}
+
+// renameTypes changes the names of types and the references to them.
+// All types are renamed at once, so a type can take the old name of another.
+func (m *Model) renameTypes(names map[string]string) {
+	rename := func(name *string) {
+		if to, ok := names[*name]; ok {
+			*name = to
+		}
+	}
+	renameFields := func(fields []*Field) {
+		for _, f := range fields {
+			rename(&f.Type)
+		}
+	}
+	for _, t := range m.Types {
+		rename(&t.Name)
+		rename(&t.ContentType)
+		renameFields(t.Fields)
+		for _, v := range t.Variants {
+			rename(&v.Type)
+		}
+	}
+	for _, method := range m.Methods {
+		rename(&method.ParametersTypeName)
+		rename(&method.ResponsesTypeName)
+		for _, r := range method.Responses {
+			rename(&r.Type)
+			renameFields(r.Headers)
+		}
+		for _, r := range method.RequestBodies {
+			rename(&r.Type)
+			for _, e := range r.Encodings {
+				renameFields(e.Headers)
+			}
+		}
+	}
+}
+
+// SecuritySchemeWithName returns the security scheme with the specified name, or nil if there is none.
+func (m *Model) SecuritySchemeWithName(name string) *SecurityScheme {
+	for _, s := range m.SecuritySchemes {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
 func (m *Model) TypeWithTypeName(name string) *Type {
 	if name == "" {
 		return nil
@@ -39,6 +99,116 @@ func (m *Model) TypeWithTypeName(name string) *Type {
 	return nil
 }
 
+// The x-gnostic-name extension overrides the names of methods and types.
+const nameExtension = "x-gnostic-name"
+
+// namer assigns names to the methods and types of a model so that no two have the same name.
+// Names are reduced to identifiers before they are compared, names that are already used get
+// numeric suffixes, and each collision is reported as a warning.
+type namer struct {
+	methodNames  map[string]bool
+	typeNames    map[string]bool
+	renamedTypes map[string]string
+	reporter     *reporter
+}
+
+func newNamer(r *reporter) *namer {
+	return &namer{
+		methodNames:  make(map[string]bool),
+		typeNames:    make(map[string]bool),
+		renamedTypes: make(map[string]string),
+		reporter:     r,
+	}
+}
+
+// uniqueName returns a name that isn't in a set of names and adds it to the set.
+func uniqueName(names map[string]bool, name string) string {
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	names[unique] = true
+	return unique
+}
+
+// identifier reduces a name to an identifier. Characters other than letters, digits and
+// underscores separate words, which are capitalized and joined, and names that don't
+// start with letters are prefixed with an underscore.
+func identifier(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for i, word := range words {
+		words[i] = strings.Title(word)
+	}
+	name = strings.Join(words, "")
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "_" + name
+	}
+	return name
+}
+
+// typeIdentifier reduces the name of a type to an identifier. Names of types that are
+// qualified with paths or dots, like "io.k8s.Pod", are reduced to their last parts.
+func typeIdentifier(name string) string {
+	name = path.Base(name)
+	if i := strings.LastIndex(name, "."); i >= 0 && i < len(name)-1 {
+		name = name[i+1:]
+	}
+	return identifier(name)
+}
+
+// methodName returns a unique name for a method. Names specified with x-gnostic-name
+// extensions are used instead of the names that are derived from operations.
+func (n *namer) methodName(m *Method, name string) string {
+	if extension := m.ExtensionWithName(nameExtension); extension != nil {
+		name = enumValue(extension.Yaml)
+	}
+	name = identifier(name)
+	unique := uniqueName(n.methodNames, name)
+	if unique != name {
+		n.reporter.warn(nil, WarningNameCollision, "method name %s of %s %s is already used, using %s", name, m.Method, m.Path, unique)
+	}
+	return unique
+}
+
+// reserveTypeName reserves the name of a type of a schema that is named in the API description,
+// so that types that are named by the builders don't use it. Schemas keep their names while the
+// model is built and their types are renamed afterwards if their names aren't identifiers or if
+// they have x-gnostic-name extensions, whose values are the new names.
+func (n *namer) reserveTypeName(name string, newName string) {
+	to := typeIdentifier(name)
+	var keys []string
+	if newName != "" {
+		to = typeIdentifier(newName)
+		keys = []string{nameExtension}
+	}
+	unique := uniqueName(n.typeNames, to)
+	if unique != to {
+		n.reporter.warn(keys, WarningNameCollision, "type name %s of %s is already used, using %s", to, name, unique)
+	}
+	if unique != name {
+		n.renamedTypes[name] = unique
+	}
+}
+
+// typeName returns a unique name for a type that is added to the model.
+func (n *namer) typeName(name string) string {
+	name = typeIdentifier(name)
+	unique := uniqueName(n.typeNames, name)
+	if unique != name {
+		n.reporter.warn(nil, WarningNameCollision, "type name %s is already used, using %s", name, unique)
+	}
+	return unique
+}
+
+// renameTypes renames the types of schemas whose names weren't identifiers or that have x-gnostic-name extensions.
+func (n *namer) renameTypes(m *Model) {
+	if len(n.renamedTypes) > 0 {
+		m.renameTypes(n.renamedTypes)
+	}
+}
+
 func generateOperationName(method, path string) string {
 	filteredPath := strings.Replace(path, "/", "_", -1)
 	filteredPath = strings.Replace(filteredPath, ".", "_", -1)
@@ -56,3 +226,82 @@ func sanitizeOperationName(name string) string {
 func typeForRef(ref string) (typeName string) {
 	return path.Base(ref)
 }
+
+// nestedTypeName returns a name for a type that is described inside another type.
+// The name of the inner element is split into words and capitalized so that it can be
+// appended to the name of the outer type.
+func nestedTypeName(outer, inner string) string {
+	words := strings.FieldsFunc(inner, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = strings.Title(word)
+	}
+	return outer + strings.Join(words, "")
+}
+
+// isScalarType returns true if a type name refers to a scalar type.
+func isScalarType(typeName string) bool {
+	switch typeName {
+	case "string", "integer", "number", "boolean":
+		return true
+	default:
+		return false
+	}
+}
+
+// enumType returns a type that describes a scalar with a fixed set of values.
+func enumType(name, contentType string, values []string) *Type {
+	return &Type{
+		Name:        name,
+		Kind:        TypeKind_ENUM,
+		Description: name + " is an enumeration of " + contentType + " values",
+		ContentType: contentType,
+		EnumValues:  values,
+	}
+}
+
+// enumValue returns the text of an enum value that was read from a YAML representation.
+func enumValue(text string) string {
+	var value interface{}
+	if err := yaml.Unmarshal([]byte(text), &value); err != nil || value == nil {
+		return strings.TrimSpace(text)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// constrainedValue is implemented by the schemas and parameters that restrict their values.
+type constrainedValue interface {
+	GetMinLength() int64
+	GetMaxLength() int64
+	GetPattern() string
+	GetMinimum() float64
+	GetExclusiveMinimum() bool
+	GetMaximum() float64
+	GetExclusiveMaximum() bool
+	GetMultipleOf() float64
+	GetMinItems() int64
+	GetMaxItems() int64
+	GetUniqueItems() bool
+}
+
+// constraintsForValue returns the constraints on the values of a schema or parameter.
+// Compiled OpenAPI documents don't record whether numbers were written, so a bound is
+// present if it is nonzero or exclusive unless it is found in a description.
+func constraintsForValue(v constrainedValue) *Constraints {
+	return &Constraints{
+		MinLength:        v.GetMinLength(),
+		MaxLength:        v.GetMaxLength(),
+		Pattern:          v.GetPattern(),
+		Minimum:          v.GetMinimum(),
+		ExclusiveMinimum: v.GetExclusiveMinimum(),
+		HasMinimum:       v.GetMinimum() != 0 || v.GetExclusiveMinimum(),
+		Maximum:          v.GetMaximum(),
+		ExclusiveMaximum: v.GetExclusiveMaximum(),
+		HasMaximum:       v.GetMaximum() != 0 || v.GetExclusiveMaximum(),
+		MultipleOf:       v.GetMultipleOf(),
+		MinItems:         v.GetMinItems(),
+		MaxItems:         v.GetMaxItems(),
+		UniqueItems:      v.GetUniqueItems(),
+	}
+}
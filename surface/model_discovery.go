@@ -0,0 +1,458 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surface_v1
+
+import (
+	"strconv"
+	"strings"
+
+	discovery "github.com/googleapis/gnostic/discovery"
+)
+
+// NewModelFromDiscovery builds a model of an API service for use in code generation.
+func NewModelFromDiscovery(document *discovery.Document) (*Model, error) {
+	model, _, err := NewModelFromDiscoveryWithWarnings(document)
+	return model, err
+}
+
+// NewModelFromDiscoveryWithWarnings builds a model of an API service and returns
+// warnings that describe the problems that were found in the API description.
+func NewModelFromDiscoveryWithWarnings(document *discovery.Document) (*Model, []*Warning, error) {
+	b := newDiscoveryBuilder()
+	model, err := b.buildModel(document)
+	return model, b.reporter.warnings, err
+}
+
+type DiscoveryBuilder struct {
+	model    *Model
+	document *discovery.Document
+	names    *namer
+	reporter *reporter
+}
+
+func newDiscoveryBuilder() *DiscoveryBuilder {
+	r := &reporter{}
+	return &DiscoveryBuilder{model: &Model{}, names: newNamer(r), reporter: r}
+}
+
+func (b *DiscoveryBuilder) buildModel(document *discovery.Document) (*Model, error) {
+	// Set model properties from passed-in document.
+	b.document = document
+	b.model.Name = document.Title
+	if b.model.Name == "" {
+		b.model.Name = document.Name
+	}
+	b.model.Types = make([]*Type, 0)
+	b.model.Methods = make([]*Method, 0)
+	err := b.build(document)
+	if err != nil {
+		return nil, err
+	}
+	return b.model, nil
+}
+
+// build builds an API service description, preprocessing its types and methods for code generation.
+func (b *DiscoveryBuilder) build(document *discovery.Document) (err error) {
+	// Reserve the names of schemas, which types that are described inline can't use.
+	for _, pair := range document.Schemas.GetAdditionalProperties() {
+		b.reporter.at("schemas", pair.Name)
+		b.names.reserveTypeName(pair.Name, "")
+	}
+	// Collect service type descriptions from Schemas.
+	if document.Schemas != nil {
+		for _, pair := range document.Schemas.AdditionalProperties {
+			b.reporter.at("schemas", pair.Name)
+			t, err := b.buildTypeFromSchema(pair.Name, pair.Value)
+			if err != nil {
+				b.reporter.warn(nil, WarningOmitted, "unable to build type %s: %v", pair.Name, err)
+				continue
+			}
+			b.model.addType(t)
+		}
+	}
+	b.buildSecuritySchemes(document)
+	if url := b.rootURL(); url != "" {
+		b.model.addServer(&Server{Url: url})
+	}
+	// Collect service method descriptions from Methods and Resources.
+	b.buildMethodsFromMethods("", nil, document.Methods)
+	b.buildMethodsFromResources("", nil, document.Resources)
+	b.names.renameTypes(b.model)
+	return nil
+}
+
+// buildMethodsFromResources builds service method descriptions for the methods of resources and their nested resources.
+// Method names are prefixed with the names of the resources that contain them.
+func (b *DiscoveryBuilder) buildMethodsFromResources(prefix string, keys []string, resources *discovery.Resources) {
+	for _, pair := range resources.GetAdditionalProperties() {
+		resourcePrefix := nestedTypeName(prefix, pair.Name)
+		resourceKeys := append(append([]string{}, keys...), "resources", pair.Name)
+		b.buildMethodsFromMethods(resourcePrefix, resourceKeys, pair.Value.GetMethods())
+		b.buildMethodsFromResources(resourcePrefix, resourceKeys, pair.Value.GetResources())
+	}
+}
+
+// buildMethodsFromMethods builds service method descriptions for a set of methods.
+// Methods that can't be built are reported and left out of the model.
+func (b *DiscoveryBuilder) buildMethodsFromMethods(prefix string, keys []string, methods *discovery.Methods) {
+	for _, pair := range methods.GetAdditionalProperties() {
+		b.reporter.at(append(append([]string{}, keys...), "methods", pair.Name)...)
+		err := b.buildMethod(nestedTypeName(prefix, pair.Name), pair.Value)
+		if err != nil {
+			b.reporter.warn(nil, WarningOmitted, "unable to build method %s: %v", pair.Value.Id, err)
+		}
+	}
+}
+
+// buildMethod builds a service method description
+func (b *DiscoveryBuilder) buildMethod(name string, method *discovery.Method) (err error) {
+	var m Method
+	m.Operation = method.Id
+	m.Path = b.absolutePath(method.Path)
+	m.Method = method.HttpMethod
+	m.Name = b.names.methodName(&m, name)
+	m.Description = method.Description
+	m.SecurityRequirements = b.buildSecurityRequirements(method)
+	m.ParametersTypeName, err = b.buildTypeFromParameters(&m, method)
+	if err != nil {
+		return err
+	}
+	m.ResponsesTypeName, err = b.buildTypeFromResponse(m.Name, method.Response)
+	if err != nil {
+		return err
+	}
+	// Discovery documents only describe successful responses, which have JSON bodies.
+	response := &Response{Code: "200"}
+	if method.Response != nil && method.Response.XRef != "" {
+		response.MediaType = "application/json"
+		response.Type = method.Response.XRef
+	}
+	m.addResponse(response)
+	if method.SupportsMediaUpload && method.MediaUpload != nil {
+		protocols := method.MediaUpload.GetProtocols()
+		m.MediaUpload = &MediaUpload{
+			Accept:        method.MediaUpload.Accept,
+			MaxSize:       method.MediaUpload.MaxSize,
+			SimplePath:    protocols.GetSimple().GetPath(),
+			ResumablePath: protocols.GetResumable().GetPath(),
+			Multipart:     protocols.GetSimple().GetMultipart() || protocols.GetResumable().GetMultipart(),
+		}
+	}
+	b.model.addMethod(&m)
+	return nil
+}
+
+// buildSecuritySchemes builds descriptions of the ways that clients can be authenticated.
+// Discovery documents describe the OAuth2 scopes of Google's authorization server and
+// the API keys that are sent with the "key" query parameter.
+func (b *DiscoveryBuilder) buildSecuritySchemes(document *discovery.Document) {
+	if scopes := document.GetAuth().GetOauth2().GetScopes(); scopes != nil {
+		flow := &OAuthFlow{
+			Type:             "authorizationCode",
+			AuthorizationUrl: "https://accounts.google.com/o/oauth2/auth",
+			TokenUrl:         "https://oauth2.googleapis.com/token",
+		}
+		for _, pair := range scopes.AdditionalProperties {
+			flow.Scopes = append(flow.Scopes, pair.Name)
+		}
+		b.model.addSecurityScheme(&SecurityScheme{
+			Name:  "oauth2",
+			Type:  SecuritySchemeType_OAUTH2,
+			Flows: []*OAuthFlow{flow},
+		})
+	}
+	for _, pair := range document.GetParameters().GetAdditionalProperties() {
+		if pair.Name == "key" {
+			b.model.addSecurityScheme(&SecurityScheme{
+				Name:          "key",
+				Type:          SecuritySchemeType_API_KEY,
+				Description:   pair.Value.Description,
+				ParameterName: "key",
+				Position:      Position_QUERY,
+			})
+		}
+	}
+}
+
+// buildSecurityRequirements builds descriptions of the alternative sets of credentials that a method accepts.
+// Methods that have scopes need an OAuth2 token or an API key, and other methods can be called
+// with an API key or without credentials.
+func (b *DiscoveryBuilder) buildSecurityRequirements(method *discovery.Method) []*SecurityRequirement {
+	result := make([]*SecurityRequirement, 0)
+	if len(method.Scopes) > 0 && b.model.SecuritySchemeWithName("oauth2") != nil {
+		r := &SecurityRequirement{}
+		r.addScheme("oauth2", method.Scopes)
+		result = append(result, r)
+	}
+	if b.model.SecuritySchemeWithName("key") != nil {
+		r := &SecurityRequirement{}
+		r.addScheme("key", nil)
+		result = append(result, r)
+	}
+	if len(method.Scopes) == 0 && len(result) > 0 {
+		result = append(result, &SecurityRequirement{})
+	}
+	return result
+}
+
+// rootURL returns the URL that the absolute paths of methods are relative to.
+func (b *DiscoveryBuilder) rootURL() string {
+	if b.document.RootUrl != "" {
+		return strings.TrimSuffix(b.document.RootUrl, "/")
+	}
+	baseURL := strings.TrimSuffix(b.document.BaseUrl, "/")
+	servicePath := b.document.ServicePath
+	if servicePath == "" {
+		servicePath = b.document.BasePath
+	}
+	return strings.TrimSuffix(baseURL, "/"+strings.Trim(servicePath, "/"))
+}
+
+// absolutePath returns the HTTP path of a method, which Discovery documents specify relative to the service path.
+func (b *DiscoveryBuilder) absolutePath(methodPath string) string {
+	servicePath := b.document.ServicePath
+	if servicePath == "" {
+		servicePath = b.document.BasePath
+	}
+	servicePath = "/" + strings.Trim(servicePath, "/")
+	if servicePath == "/" {
+		return "/" + methodPath
+	}
+	return servicePath + "/" + methodPath
+}
+
+// buildTypeFromParameters builds a service type description from the parameters of an API method.
+// Parameters are ordered as specified by the method's parameterOrder, followed by the remaining parameters.
+func (b *DiscoveryBuilder) buildTypeFromParameters(m *Method, method *discovery.Method) (typeName string, err error) {
+	name := m.Name
+	t := &Type{}
+	t.Name = b.names.typeName(name + "Parameters")
+	t.Description = t.Name + " holds parameters to " + name
+	t.Kind = TypeKind_STRUCT
+	t.Fields = make([]*Field, 0)
+	pairs := make([]*discovery.NamedParameter, 0)
+	for _, parameterName := range method.ParameterOrder {
+		for _, pair := range method.Parameters.GetAdditionalProperties() {
+			if pair.Name == parameterName {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	for _, pair := range method.Parameters.GetAdditionalProperties() {
+		ordered := false
+		for _, parameterName := range method.ParameterOrder {
+			if pair.Name == parameterName {
+				ordered = true
+			}
+		}
+		if !ordered {
+			pairs = append(pairs, pair)
+		}
+	}
+	for _, pair := range pairs {
+		parameter := pair.Value
+		var f Field
+		f.Name = pair.Name
+		switch parameter.Location {
+		case "path":
+			f.Position = Position_PATH
+		case "query":
+			f.Position = Position_QUERY
+		case "header":
+			f.Position = Position_HEADER
+		}
+		f.Type = parameter.Type
+		f.Format = parameter.Format
+		if parameter.XRef != "" {
+			f.Type = parameter.XRef
+		} else if len(parameter.Enum) > 0 && isScalarType(parameter.Type) {
+			f.Type = b.names.typeName(nestedTypeName(t.Name, f.Name))
+			f.Format = ""
+			b.model.addType(enumType(f.Type, parameter.Type, parameter.Enum))
+		}
+		if parameter.Repeated {
+			f.Kind = FieldKind_ARRAY
+		}
+		// Repeated parameters are sent once for each of their values.
+		f.setStyle("", false)
+		f.setConstraints(b.constraintsForValue([]string{"parameters", pair.Name}, parameter.Required, parameter.Pattern, parameter.Minimum, parameter.Maximum))
+		f.Serialize = true
+		t.addField(&f)
+	}
+	if request := method.Request; request != nil && request.XRef != "" {
+		var f Field
+		f.Position = Position_BODY
+		f.Type = request.XRef
+		f.Name = request.ParameterName
+		if f.Name == "" {
+			f.Name = t.bodyFieldName(f.Type, true)
+		}
+		f.MediaType = "application/json"
+		f.Serialize = true
+		t.addField(&f)
+		m.addRequestBody(&RequestBody{MediaType: f.MediaType, Type: f.Type})
+	}
+	if len(t.Fields) > 0 {
+		b.model.addType(t)
+		return t.Name, err
+	}
+	return "", err
+}
+
+// buildTypeFromResponse builds a service type description from the response of an API method
+func (b *DiscoveryBuilder) buildTypeFromResponse(name string, response *discovery.Response) (typeName string, err error) {
+	if response == nil || response.XRef == "" {
+		return "", nil
+	}
+	t := &Type{}
+	t.Name = b.names.typeName(name + "Responses")
+	t.Description = t.Name + " holds responses of " + name
+	t.Kind = TypeKind_STRUCT
+	t.Fields = make([]*Field, 0)
+	var f Field
+	f.Name = "200"
+	f.Kind = FieldKind_REFERENCE
+	f.Type = response.XRef
+	t.addField(&f)
+	b.model.addType(t)
+	return t.Name, nil
+}
+
+// buildTypeFromSchema builds a service type description from a schema in the API description.
+func (b *DiscoveryBuilder) buildTypeFromSchema(name string, schema *discovery.Schema) (t *Type, err error) {
+	t = &Type{}
+	t.Name = name
+	t.Description = "implements the service definition of " + name
+	t.Fields = make([]*Field, 0)
+	if len(schema.Enum) > 0 && isScalarType(schema.Type) {
+		// If the schema is a scalar with a fixed set of values, generate an enum.
+		return enumType(name, schema.Type, schema.Enum), nil
+	}
+	switch schema.Type {
+	case "array":
+		// If the schema is an array, generate a list of its items.
+		t.Kind = TypeKind_LIST
+		t.ContentType = b.typeForElement(name+"Item", schema.Items)
+		return t, nil
+	case "object", "":
+		if len(schema.Properties.GetAdditionalProperties()) == 0 && schema.AdditionalProperties != nil {
+			// If the schema has no fixed properties and additional properties of a specified type,
+			// generate a map pointing to objects of that type.
+			t.Kind = TypeKind_OBJECT
+			t.ContentType = b.typeForElement(name+"Value", schema.AdditionalProperties)
+			return t, nil
+		}
+	}
+	// By default, generate a struct with a field for each property.
+	t.Kind = TypeKind_STRUCT
+	for _, pair := range schema.Properties.GetAdditionalProperties() {
+		var f Field
+		f.Name = pair.Name
+		f.Kind, f.Type, f.Format = b.typeForSchema(nestedTypeName(name, pair.Name), pair.Value)
+		f.Serialize = true
+		c := b.constraintsForValue([]string{"properties", pair.Name}, pair.Value.Required, pair.Value.Pattern, pair.Value.Minimum, pair.Value.Maximum)
+		c.ReadOnly = pair.Value.ReadOnly
+		f.setConstraints(c)
+		t.addField(&f)
+	}
+	return t, nil
+}
+
+// typeForSchema determines the language-specific type of a schema.
+// Types that are needed to describe inline schemas are added to the model using the specified name.
+func (b *DiscoveryBuilder) typeForSchema(name string, schema *discovery.Schema) (kind FieldKind, typeName, format string) {
+	if schema.XRef != "" {
+		return FieldKind_SCALAR, schema.XRef, ""
+	}
+	switch schema.Type {
+	case "string", "integer", "number", "boolean":
+		if len(schema.Enum) > 0 {
+			// Scalars with a fixed set of values are described with a new enum type.
+			name = b.names.typeName(name)
+			b.model.addType(enumType(name, schema.Type, schema.Enum))
+			return FieldKind_SCALAR, name, ""
+		}
+		return FieldKind_SCALAR, schema.Type, schema.Format
+	case "array":
+		return FieldKind_ARRAY, b.typeForElement(name+"Item", schema.Items), ""
+	case "object":
+		if len(schema.Properties.GetAdditionalProperties()) > 0 {
+			// Inline objects are described with a new named type.
+			name = b.names.typeName(name)
+			t, _ := b.buildTypeFromSchema(name, schema)
+			b.model.addType(t)
+			return FieldKind_SCALAR, name, ""
+		}
+		if schema.AdditionalProperties != nil {
+			return FieldKind_MAP, b.typeForElement(name+"Value", schema.AdditionalProperties), ""
+		}
+		return FieldKind_MAP, "object", ""
+	}
+	// Schemas of type "any" can hold anything.
+	return FieldKind_SCALAR, "object", ""
+}
+
+// typeForElement determines the type of an element of an array or map.
+// If the elements are arrays or maps, a named type is added to the model to describe them.
+func (b *DiscoveryBuilder) typeForElement(name string, schema *discovery.Schema) string {
+	if schema == nil {
+		return "object"
+	}
+	kind, typeName, _ := b.typeForSchema(name, schema)
+	switch kind {
+	case FieldKind_ARRAY:
+		name = b.names.typeName(name)
+		b.model.addType(&Type{
+			Name:        name,
+			Kind:        TypeKind_LIST,
+			Description: name + " is a list of " + typeName,
+			ContentType: typeName,
+		})
+		return name
+	case FieldKind_MAP:
+		name = b.names.typeName(name)
+		b.model.addType(&Type{
+			Name:        name,
+			Kind:        TypeKind_OBJECT,
+			Description: name + " is a map of " + typeName,
+			ContentType: typeName,
+		})
+		return name
+	default:
+		return typeName
+	}
+}
+
+// constraintsForValue returns the constraints on the values of a schema or parameter.
+// Discovery documents write minimum and maximum values as strings, and values that
+// aren't numbers are reported and left out of the constraints.
+func (b *DiscoveryBuilder) constraintsForValue(keys []string, required bool, pattern, minimum, maximum string) *Constraints {
+	c := &Constraints{Required: required, Pattern: pattern}
+	if minimum != "" {
+		if value, err := strconv.ParseFloat(minimum, 64); err == nil {
+			c.Minimum, c.HasMinimum = value, true
+		} else {
+			b.reporter.warn(append(keys, "minimum"), WarningOmitted, "minimum %q is not a number", minimum)
+		}
+	}
+	if maximum != "" {
+		if value, err := strconv.ParseFloat(maximum, 64); err == nil {
+			c.Maximum, c.HasMaximum = value, true
+		} else {
+			b.reporter.warn(append(keys, "maximum"), WarningOmitted, "maximum %q is not a number", maximum)
+		}
+	}
+	return c
+}
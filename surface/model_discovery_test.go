@@ -0,0 +1,131 @@
+package surface_v1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/googleapis/gnostic/compiler"
+)
+
+func buildDiscoveryFile(t *testing.T, filename string) (*Model, []*Warning) {
+	bytes, err := compiler.ReadBytesForFile(filename)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return buildDiscovery(t, string(bytes))
+}
+
+func TestDiscoveryMethods(t *testing.T) {
+	model, _ := buildDiscoveryFile(t, "testdata/discovery/library.json")
+	expected := []struct {
+		operation, path, method, name string
+	}{
+		{"library.shelves.list", "/library/v1/shelves", "GET", "ShelvesList"},
+		{"library.shelves.get", "/library/v1/{+name}", "GET", "ShelvesGet"},
+		{"library.shelves.create", "/library/v1/shelves", "POST", "ShelvesCreate"},
+		{"library.shelves.books.delete", "/library/v1/{+name}", "DELETE", "ShelvesBooksDelete"},
+	}
+	if len(model.Methods) != len(expected) {
+		t.Fatalf("built %d methods, expected %d", len(model.Methods), len(expected))
+	}
+	for i, m := range model.Methods {
+		if m.Operation != expected[i].operation || m.Path != expected[i].path ||
+			m.Method != expected[i].method || m.Name != expected[i].name {
+			t.Errorf("method %d is %s %s %s %s, expected %v", i, m.Operation, m.Method, m.Path, m.Name, expected[i])
+		}
+		if len(m.SecurityRequirements) != 1 || m.SecurityRequirements[0].Schemes[0].Name != "oauth2" {
+			t.Errorf("%s has security requirements %v", m.Name, m.SecurityRequirements)
+		}
+	}
+	if response := model.Methods[0].Responses; len(response) != 1 || response[0].Type != "ListShelvesResponse" {
+		t.Errorf("ShelvesList has responses %v", response)
+	}
+	if bodies := model.Methods[2].RequestBodies; len(bodies) != 1 || bodies[0].Type != "Shelf" {
+		t.Errorf("ShelvesCreate has request bodies %v", bodies)
+	}
+	if len(model.Servers) != 1 || model.Servers[0].Url != "https://library.example.com" {
+		t.Errorf("built servers %v", model.Servers)
+	}
+	if len(model.SecuritySchemes) != 1 || model.SecuritySchemes[0].Type != SecuritySchemeType_OAUTH2 {
+		t.Errorf("built security schemes %v", model.SecuritySchemes)
+	}
+}
+
+func TestDiscoveryParameters(t *testing.T) {
+	model, _ := buildDiscoveryFile(t, "testdata/discovery/library.json")
+	parameters := typeNamed(t, model, "ShelvesBooksDeleteParameters")
+	// parameters in the parameterOrder come first.
+	names := make([]string, 0)
+	for _, f := range parameters.Fields {
+		names = append(names, f.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"name", "force", "view", "fields"}) {
+		t.Errorf("parameters are %q", names)
+	}
+	name := fieldNamed(t, parameters, "name")
+	if name.Position != Position_PATH || !name.GetConstraints().GetRequired() || name.Style != "simple" {
+		t.Errorf("name is %v", name)
+	}
+	fields := fieldNamed(t, parameters, "fields")
+	if fields.Position != Position_QUERY || fields.Kind != FieldKind_ARRAY || fields.Style != "form" || !fields.Explode {
+		t.Errorf("fields is %v", fields)
+	}
+	view := fieldNamed(t, parameters, "view")
+	if view.Type != "ShelvesBooksDeleteParametersView" {
+		t.Errorf("view has type %s", view.Type)
+	}
+	enum := typeNamed(t, model, "ShelvesBooksDeleteParametersView")
+	if enum.Kind != TypeKind_ENUM || !reflect.DeepEqual(enum.EnumValues, []string{"BASIC", "FULL"}) {
+		t.Errorf("view enum is %v", enum)
+	}
+	body := fieldNamed(t, typeNamed(t, model, "ShelvesCreateParameters"), "shelf")
+	if body.Position != Position_BODY || body.Type != "Shelf" || body.MediaType != "application/json" {
+		t.Errorf("shelf is %v", body)
+	}
+}
+
+func TestDiscoverySchemas(t *testing.T) {
+	model, _ := buildDiscoveryFile(t, "testdata/discovery/library.json")
+	shelves := fieldNamed(t, typeNamed(t, model, "ListShelvesResponse"), "shelves")
+	if shelves.Kind != FieldKind_ARRAY || shelves.Type != "Shelf" {
+		t.Errorf("shelves is %v", shelves)
+	}
+	book := typeNamed(t, model, "Book")
+	if tags := fieldNamed(t, book, "tags"); tags.Kind != FieldKind_ARRAY || tags.Type != "string" {
+		t.Errorf("tags is %v", tags)
+	}
+	if genre := fieldNamed(t, book, "genre"); genre.Type != "BookGenre" {
+		t.Errorf("genre has type %s", genre.Type)
+	}
+	name := fieldNamed(t, typeNamed(t, model, "Shelf"), "name")
+	if name.GetConstraints().GetPattern() != "^shelves/[0-9]+$" {
+		t.Errorf("name has constraints %v", name.GetConstraints())
+	}
+	testBounds(t, typeNamed(t, model, "Shelf"), []boundsTest{
+		{field: "capacity", hasMinimum: true, hasMaximum: true, maximum: 1000},
+	})
+}
+
+func TestDiscoveryInvalidBounds(t *testing.T) {
+	model, warnings := buildDiscoveryFile(t, "testdata/discovery/library.json")
+	// bounds that aren't numbers are left out and reported.
+	testBounds(t, typeNamed(t, model, "Book"), []boundsTest{{field: "pages"}})
+	testBounds(t, typeNamed(t, model, "ShelvesListParameters"), []boundsTest{
+		{field: "pageSize", hasMinimum: true, minimum: 1},
+	})
+	expected := []*Warning{
+		{
+			Code: WarningOmitted,
+			Text: `minimum "one" is not a number`,
+			Keys: []string{"schemas", "Book", "properties", "pages", "minimum"},
+		},
+		{
+			Code: WarningOmitted,
+			Text: `maximum "many" is not a number`,
+			Keys: []string{"resources", "shelves", "methods", "list", "parameters", "pageSize", "maximum"},
+		},
+	}
+	if !reflect.DeepEqual(warnings, expected) {
+		t.Errorf("warnings are %v, expected %v", warnings, expected)
+	}
+}
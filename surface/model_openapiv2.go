@@ -16,25 +16,43 @@ package surface_v1
 
 import (
 	"fmt"
+	"strings"
 
 	openapiv2 "github.com/googleapis/gnostic/OpenAPIv2"
 )
 
 // NewModelFromOpenAPI2 builds a model of an API service for use in code generation.
 func NewModelFromOpenAPI2(document *openapiv2.Document) (*Model, error) {
-	return newOpenAPI2Builder().buildModel(document)
+	model, _, err := NewModelFromOpenAPI2WithInfo(document, nil)
+	return model, err
+}
+
+// NewModelFromOpenAPI2WithInfo builds a model of an API service from a document and the description
+// that it was compiled from, such as the result of compiler.ReadInfoFromBytes. Bounds that are zero
+// are only found in descriptions. Problems that are found in the API description are returned as warnings.
+func NewModelFromOpenAPI2WithInfo(document *openapiv2.Document, info interface{}) (*Model, []*Warning, error) {
+	b := newOpenAPI2Builder()
+	b.bounds = boundsForOpenAPI2(document, info)
+	model, err := b.buildModel(document)
+	return model, b.reporter.warnings, err
 }
 
 type OpenAPI2Builder struct {
-	model *Model
+	model    *Model
+	document *openapiv2.Document
+	bounds   *bounds
+	names    *namer
+	reporter *reporter
 }
 
 func newOpenAPI2Builder() *OpenAPI2Builder {
-	return &OpenAPI2Builder{model: &Model{}}
+	r := &reporter{}
+	return &OpenAPI2Builder{model: &Model{}, bounds: newBounds(nil), names: newNamer(r), reporter: r}
 }
 
 func (b *OpenAPI2Builder) buildModel(document *openapiv2.Document) (*Model, error) {
 	// Set model properties from passed-in document.
+	b.document = document
 	b.model.Name = document.Info.Title
 	b.model.Types = make([]*Type, 0)
 	b.model.Methods = make([]*Method, 0)
@@ -47,35 +65,62 @@ func (b *OpenAPI2Builder) buildModel(document *openapiv2.Document) (*Model, erro
 
 // buildV2 builds an API service description, preprocessing its types and methods for code generation.
 func (b *OpenAPI2Builder) build(document *openapiv2.Document) (err error) {
+	// Reserve the names of definitions, which types that are described inline can't use.
+	if document.Definitions != nil {
+		for _, pair := range document.Definitions.AdditionalProperties {
+			b.reporter.at("definitions", pair.Name)
+			b.names.reserveTypeName(pair.Name, nameForVendorExtensions(pair.Value.GetVendorExtension()))
+		}
+	}
 	// Collect service type descriptions from Definitions section.
 	if document.Definitions != nil {
 		for _, pair := range document.Definitions.AdditionalProperties {
+			b.reporter.at("definitions", pair.Name)
 			t, err := b.buildTypeFromDefinition(pair.Name, pair.Value)
 			if err != nil {
-				return err
+				b.reporter.warn(nil, WarningOmitted, "unable to build type %s: %v", pair.Name, err)
+				continue
 			}
 			b.model.addType(t)
 		}
 	}
+	// Collect the servers that provide the API, one for each of its schemes.
+	for _, server := range b.buildServers(document) {
+		b.model.addServer(server)
+	}
+	// Collect descriptions of the ways that clients can be authenticated.
+	if document.SecurityDefinitions != nil {
+		for _, pair := range document.SecurityDefinitions.AdditionalProperties {
+			b.reporter.at("securityDefinitions", pair.Name)
+			b.model.addSecurityScheme(b.buildSecurityScheme(pair.Name, pair.Value))
+		}
+	}
 	// Collect service method descriptions from Paths section.
 	if document.Paths != nil {
 		for _, pair := range document.Paths.Path {
 			v := pair.Value
-			if v.Get != nil {
-				b.buildMethodFromOperation(v.Get, "GET", pair.Name)
-			}
-			if v.Post != nil {
-				b.buildMethodFromOperation(v.Post, "POST", pair.Name)
-			}
-			if v.Put != nil {
-				b.buildMethodFromOperation(v.Put, "PUT", pair.Name)
-			}
-			if v.Delete != nil {
-				b.buildMethodFromOperation(v.Delete, "DELETE", pair.Name)
+			for _, operation := range []struct {
+				method string
+				op     *openapiv2.Operation
+			}{
+				{"GET", v.Get},
+				{"POST", v.Post},
+				{"PUT", v.Put},
+				{"DELETE", v.Delete},
+			} {
+				if operation.op != nil {
+					b.reporter.at("paths", pair.Name, strings.ToLower(operation.method))
+					err := b.buildMethodFromOperation(operation.op, operation.method, pair.Name, v.Parameters)
+					if err != nil {
+						// Operations that can't be built are left out of the model.
+						b.reporter.warn(nil, WarningOmitted, "unable to build method for %s %s: %v", operation.method, pair.Name, err)
+					}
+				}
 			}
 		}
 	}
-	return err
+	b.names.renameTypes(b.model)
+	return nil
 }
 
 func (b *OpenAPI2Builder) buildTypeFromDefinition(name string, schema *openapiv2.Schema) (t *Type, err error) {
@@ -91,13 +136,20 @@ func (b *OpenAPI2Builder) buildTypeFromDefinition(name string, schema *openapiv2
 		for _, pair2 := range schema.Properties.AdditionalProperties {
 			var f Field
 			f.Name = pair2.Name
-			f.Kind, f.Type, f.Format = b.typeForSchema(pair2.Value)
+			f.Kind, f.Type, f.Format = b.typeForSchema(nestedTypeName(t.Name, pair2.Name), pair2.Value)
 			f.Serialize = true
+			c := b.bounds.constraintsForValue(pair2.Value)
+			c.ReadOnly = pair2.Value.ReadOnly
+			f.setConstraints(c)
 			t.addField(&f)
 		}
+		t.setRequiredFields(schema.Required)
 	}
 	if len(t.Fields) == 0 {
-		if schema.AdditionalProperties != nil {
+		if len(schema.Enum) > 0 && schema.Type != nil && len(schema.Type.Value) == 1 && isScalarType(schema.Type.Value[0]) {
+			// If the schema is a scalar with a fixed set of values, generate an enum.
+			t = enumType(name, schema.Type.Value[0], enumValuesForAny(schema.Enum))
+		} else if schema.AdditionalProperties != nil {
 			// If the schema has no fixed properties and additional properties of a specified type,
 			// generate a map pointing to objects of that type.
 			t.Kind = TypeKind_OBJECT
@@ -109,75 +161,295 @@ func (b *OpenAPI2Builder) buildTypeFromDefinition(name string, schema *openapiv2
 	return t, err
 }
 
-func (b *OpenAPI2Builder) buildMethodFromOperation(op *openapiv2.Operation, method string, path string) (err error) {
+func (b *OpenAPI2Builder) buildMethodFromOperation(op *openapiv2.Operation, method string, path string, pathParameters []*openapiv2.ParametersItem) (err error) {
 	var m Method
 	m.Operation = op.OperationId
 	m.Path = path
 	m.Method = method
 	m.Description = op.Description
+	m.Summary = op.Summary
+	m.Tags = op.Tags
+	m.Deprecated = op.Deprecated
+	if docs := op.ExternalDocs; docs != nil {
+		m.ExternalDocs = &ExternalDocs{Url: docs.Url, Description: docs.Description}
+	}
+	for _, pair := range op.VendorExtension {
+		m.addExtension(pair.Name, pair.Value.GetYaml())
+	}
 
 	m.Name = sanitizeOperationName(op.OperationId)
 	if m.Name == "" {
 		m.Name = generateOperationName(method, path)
 	}
 
-	m.ParametersTypeName, err = b.buildTypeFromParameters(m.Name, op.Parameters)
-	m.ResponsesTypeName, err = b.buildTypeFromResponses(&m, m.Name, op.Responses)
+	// an operation without a security field uses the requirements of the document,
+	// but an empty security field means that the operation needs no credentials.
+	security := op.Security
+	if security == nil {
+		security = b.document.Security
+	}
+	m.SecurityRequirements = b.buildSecurityRequirements(security)
+
+	consumes := op.Consumes
+	if len(consumes) == 0 {
+		consumes = b.document.Consumes
+	}
+	parameters, err := b.resolveParameters(pathParameters, op.Parameters)
+	if err != nil {
+		return err
+	}
+	m.Name = b.names.methodName(&m, m.Name)
+	m.ParametersTypeName, err = b.buildTypeFromParameters(&m, m.Name, parameters, consumes)
+	if err != nil {
+		return err
+	}
+	produces := op.Produces
+	if len(produces) == 0 {
+		produces = b.document.Produces
+	}
+	m.ResponsesTypeName, err = b.buildTypeFromResponses(&m, m.Name, op.Responses, produces)
+	if err != nil {
+		return err
+	}
 	b.model.addMethod(&m)
-	return err
+	return nil
+}
+
+// buildServers builds descriptions of the servers that provide the API.
+// Documents that don't specify a host are served from the host that provides the
+// document, so their servers have relative URLs.
+func (b *OpenAPI2Builder) buildServers(document *openapiv2.Document) []*Server {
+	basePath := "/" + strings.Trim(document.BasePath, "/")
+	if document.Host == "" {
+		if document.BasePath == "" {
+			return nil
+		}
+		return []*Server{{Url: basePath}}
+	}
+	schemes := document.Schemes
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+	servers := make([]*Server, 0)
+	for _, scheme := range schemes {
+		servers = append(servers, &Server{Url: scheme + "://" + document.Host + strings.TrimSuffix(basePath, "/")})
+	}
+	return servers
+}
+
+// buildSecurityScheme builds a description of a way that clients can be authenticated.
+// The names of OAuth2 flows are those that are used in OpenAPI 3.
+func (b *OpenAPI2Builder) buildSecurityScheme(name string, item *openapiv2.SecurityDefinitionsItem) *SecurityScheme {
+	s := &SecurityScheme{Name: name}
+	if basic := item.GetBasicAuthenticationSecurity(); basic != nil {
+		s.Type = SecuritySchemeType_HTTP
+		s.Description = basic.Description
+		s.Scheme = "basic"
+	} else if apiKey := item.GetApiKeySecurity(); apiKey != nil {
+		s.Type = SecuritySchemeType_API_KEY
+		s.Description = apiKey.Description
+		s.ParameterName = apiKey.Name
+		s.Position = positionForSecurityScheme(apiKey.In)
+	} else if implicit := item.GetOauth2ImplicitSecurity(); implicit != nil {
+		s.Type = SecuritySchemeType_OAUTH2
+		s.Description = implicit.Description
+		s.Flows = []*OAuthFlow{{
+			Type:             "implicit",
+			AuthorizationUrl: implicit.AuthorizationUrl,
+			Scopes:           scopesForOauth2Scopes(implicit.Scopes),
+		}}
+	} else if password := item.GetOauth2PasswordSecurity(); password != nil {
+		s.Type = SecuritySchemeType_OAUTH2
+		s.Description = password.Description
+		s.Flows = []*OAuthFlow{{
+			Type:     "password",
+			TokenUrl: password.TokenUrl,
+			Scopes:   scopesForOauth2Scopes(password.Scopes),
+		}}
+	} else if application := item.GetOauth2ApplicationSecurity(); application != nil {
+		s.Type = SecuritySchemeType_OAUTH2
+		s.Description = application.Description
+		s.Flows = []*OAuthFlow{{
+			Type:     "clientCredentials",
+			TokenUrl: application.TokenUrl,
+			Scopes:   scopesForOauth2Scopes(application.Scopes),
+		}}
+	} else if accessCode := item.GetOauth2AccessCodeSecurity(); accessCode != nil {
+		s.Type = SecuritySchemeType_OAUTH2
+		s.Description = accessCode.Description
+		s.Flows = []*OAuthFlow{{
+			Type:             "authorizationCode",
+			AuthorizationUrl: accessCode.AuthorizationUrl,
+			TokenUrl:         accessCode.TokenUrl,
+			Scopes:           scopesForOauth2Scopes(accessCode.Scopes),
+		}}
+	}
+	return s
+}
+
+func scopesForOauth2Scopes(scopes *openapiv2.Oauth2Scopes) []string {
+	result := make([]string, 0)
+	for _, pair := range scopes.GetAdditionalProperties() {
+		result = append(result, pair.Name)
+	}
+	return result
+}
+
+// buildSecurityRequirements builds descriptions of the alternative sets of credentials that a method accepts.
+func (b *OpenAPI2Builder) buildSecurityRequirements(requirements []*openapiv2.SecurityRequirement) []*SecurityRequirement {
+	result := make([]*SecurityRequirement, 0)
+	for _, requirement := range requirements {
+		r := &SecurityRequirement{}
+		for _, pair := range requirement.GetAdditionalProperties() {
+			r.addScheme(pair.Name, pair.GetValue().GetValue())
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// resolveParameters returns the parameters of an operation, which include the parameters of its
+// path that it doesn't override with parameters that have the same name and location.
+// References to the parameters section of the document are resolved.
+func (b *OpenAPI2Builder) resolveParameters(pathParameters, operationParameters []*openapiv2.ParametersItem) ([]*openapiv2.Parameter, error) {
+	parameters := make([]*openapiv2.Parameter, 0)
+	for _, item := range append(append([]*openapiv2.ParametersItem{}, pathParameters...), operationParameters...) {
+		parameter := item.GetParameter()
+		if reference := item.GetJsonReference(); reference != nil {
+			parameter = b.parameterForReference(reference.XRef)
+			if parameter == nil {
+				return nil, fmt.Errorf("unable to resolve parameter %s", reference.XRef)
+			}
+		}
+		if parameter == nil {
+			continue
+		}
+		name, in := parameterNameAndLocation(parameter)
+		overridden := false
+		for i, p := range parameters {
+			if n, l := parameterNameAndLocation(p); n == name && l == in {
+				parameters[i] = parameter
+				overridden = true
+			}
+		}
+		if !overridden {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters, nil
+}
+
+// parameterForReference returns the parameter in the parameters section of the document
+// that a reference refers to, or nil if there is none.
+func (b *OpenAPI2Builder) parameterForReference(ref string) *openapiv2.Parameter {
+	if !strings.HasPrefix(ref, "#/parameters/") {
+		return nil
+	}
+	name := strings.TrimPrefix(ref, "#/parameters/")
+	for _, pair := range b.document.Parameters.GetAdditionalProperties() {
+		if pair.Name == name {
+			return pair.Value
+		}
+	}
+	return nil
+}
+
+// parameterNameAndLocation returns the name and location of a parameter.
+func parameterNameAndLocation(parameter *openapiv2.Parameter) (name, in string) {
+	if p := parameter.GetBodyParameter(); p != nil {
+		return p.Name, p.In
+	}
+	nonBodyParameter := parameter.GetNonBodyParameter()
+	if p := nonBodyParameter.GetHeaderParameterSubSchema(); p != nil {
+		return p.Name, p.In
+	}
+	if p := nonBodyParameter.GetFormDataParameterSubSchema(); p != nil {
+		return p.Name, p.In
+	}
+	if p := nonBodyParameter.GetQueryParameterSubSchema(); p != nil {
+		return p.Name, p.In
+	}
+	if p := nonBodyParameter.GetPathParameterSubSchema(); p != nil {
+		return p.Name, p.In
+	}
+	return "", ""
 }
 
-func (b *OpenAPI2Builder) buildTypeFromParameters(name string, parameters []*openapiv2.ParametersItem) (typeName string, err error) {
+func (b *OpenAPI2Builder) buildTypeFromParameters(m *Method, name string, parameters []*openapiv2.Parameter, consumes []string) (typeName string, err error) {
 	t := &Type{}
-	t.Name = name + "Parameters"
+	t.Name = b.names.typeName(name + "Parameters")
 	t.Description = t.Name + " holds parameters to " + name
 	t.Kind = TypeKind_STRUCT
 	t.Fields = make([]*Field, 0)
-	for _, parametersItem := range parameters {
+	for _, parameter := range parameters {
 		var f Field
-		f.Type = fmt.Sprintf("%+v", parametersItem)
-		parameter := parametersItem.GetParameter()
-		if parameter != nil {
-			bodyParameter := parameter.GetBodyParameter()
-			if bodyParameter != nil {
-				f.Name = bodyParameter.Name
-				if bodyParameter.Schema != nil {
-					f.Kind, f.Type, f.Format = b.typeForSchema(bodyParameter.Schema)
+		f.Type = fmt.Sprintf("%+v", parameter)
+		bodyParameter := parameter.GetBodyParameter()
+		if bodyParameter != nil {
+			f.Name = bodyParameter.Name
+			if bodyParameter.Schema != nil {
+				f.Kind, f.Type, f.Format = b.typeForSchema(nestedTypeName(t.Name, f.Name), bodyParameter.Schema)
+				f.setConstraints(b.bounds.constraintsForValue(bodyParameter.Schema))
+			}
+			if bodyParameter.Required {
+				f.setRequired()
+			}
+			f.Position = Position_BODY
+		}
+		nonBodyParameter := parameter.GetNonBodyParameter()
+		if nonBodyParameter != nil {
+			headerParameter := nonBodyParameter.GetHeaderParameterSubSchema()
+			if headerParameter != nil {
+				f.Name = headerParameter.Name
+				f.Kind, f.Type, f.Format = b.typeForPrimitive(nestedTypeName(t.Name, f.Name), headerParameter)
+				f.setConstraints(b.bounds.constraintsForValue(headerParameter))
+				if headerParameter.Required {
+					f.setRequired()
 				}
-				f.Position = Position_BODY
+				f.Position = Position_HEADER
+				f.setStyle(styleForCollectionFormat(f.Position, headerParameter.CollectionFormat))
 			}
-			nonBodyParameter := parameter.GetNonBodyParameter()
-			if nonBodyParameter != nil {
-				headerParameter := nonBodyParameter.GetHeaderParameterSubSchema()
-				if headerParameter != nil {
-					f.Name = headerParameter.Name
-					f.Type = headerParameter.Type
-					f.Position = Position_HEADER
+			formDataParameter := nonBodyParameter.GetFormDataParameterSubSchema()
+			if formDataParameter != nil {
+				f.Name = formDataParameter.Name
+				f.Kind, f.Type, f.Format = b.typeForPrimitive(nestedTypeName(t.Name, f.Name), formDataParameter)
+				if formDataParameter.Type == "file" {
+					f.Type, f.Format = "string", "binary"
 				}
-				formDataParameter := nonBodyParameter.GetFormDataParameterSubSchema()
-				if formDataParameter != nil {
-					f.Name = formDataParameter.Name
-					f.Type = formDataParameter.Type
-					f.Position = Position_FORMDATA
+				f.setConstraints(b.bounds.constraintsForValue(formDataParameter))
+				if formDataParameter.Required {
+					f.setRequired()
 				}
-				queryParameter := nonBodyParameter.GetQueryParameterSubSchema()
-				if queryParameter != nil {
-					f.Name = queryParameter.Name
-					f.Type = queryParameter.Type
-					f.Position = Position_QUERY
+				f.Position = Position_FORMDATA
+				f.setStyle(styleForCollectionFormat(f.Position, formDataParameter.CollectionFormat))
+			}
+			queryParameter := nonBodyParameter.GetQueryParameterSubSchema()
+			if queryParameter != nil {
+				f.Name = queryParameter.Name
+				f.Kind, f.Type, f.Format = b.typeForPrimitive(nestedTypeName(t.Name, f.Name), queryParameter)
+				f.setConstraints(b.bounds.constraintsForValue(queryParameter))
+				if queryParameter.Required {
+					f.setRequired()
 				}
-				pathParameter := nonBodyParameter.GetPathParameterSubSchema()
-				if pathParameter != nil {
-					f.Name = pathParameter.Name
-					f.Type = pathParameter.Type
-					f.Format = pathParameter.Format
-					f.Position = Position_PATH
+				f.Position = Position_QUERY
+				f.setStyle(styleForCollectionFormat(f.Position, queryParameter.CollectionFormat))
+			}
+			pathParameter := nonBodyParameter.GetPathParameterSubSchema()
+			if pathParameter != nil {
+				f.Name = pathParameter.Name
+				f.Kind, f.Type, f.Format = b.typeForPrimitive(nestedTypeName(t.Name, f.Name), pathParameter)
+				f.setConstraints(b.bounds.constraintsForValue(pathParameter))
+				if pathParameter.Required {
+					f.setRequired()
 				}
+				f.Position = Position_PATH
+				f.setStyle(styleForCollectionFormat(f.Position, pathParameter.CollectionFormat))
 			}
-			f.Serialize = true
-			t.addField(&f)
 		}
+		f.Serialize = true
+		t.addField(&f)
 	}
+	b.addRequestBodies(m, t, consumes)
 	if len(t.Fields) > 0 {
 		b.model.addType(t)
 		return t.Name, err
@@ -185,33 +457,146 @@ func (b *OpenAPI2Builder) buildTypeFromParameters(name string, parameters []*ope
 	return "", err
 }
 
-func (b *OpenAPI2Builder) buildTypeFromResponses(m *Method, name string, responses *openapiv2.Responses) (typeName string, err error) {
+// addRequestBodies records the media types that a method accepts for its request body.
+// Body parameters can be sent in any media type that the method consumes. FORMDATA parameters
+// are sent in multipart bodies if the method consumes them or has file parameters,
+// and in URL-encoded forms otherwise.
+func (b *OpenAPI2Builder) addRequestBodies(m *Method, t *Type, consumes []string) {
+	if len(consumes) == 0 {
+		consumes = []string{"application/json"}
+	}
+	if f := t.FieldWithPosition(Position_BODY); f != nil {
+		f.MediaType = preferredMediaType(consumes)
+		for _, mediaType := range consumes {
+			m.addRequestBody(&RequestBody{
+				MediaType: mediaType,
+				Kind:      f.Kind,
+				Type:      f.Type,
+				Format:    f.Format,
+				Required:  f.GetConstraints().GetRequired(),
+			})
+		}
+	}
+	if t.HasFieldWithPosition(Position_FORMDATA) {
+		r := &RequestBody{MediaType: "application/x-www-form-urlencoded"}
+		for _, mediaType := range consumes {
+			if mediaType == "multipart/form-data" {
+				r.MediaType = mediaType
+			}
+		}
+		for _, f := range t.Fields {
+			if f.Position != Position_FORMDATA {
+				continue
+			}
+			if f.Format == "binary" {
+				r.MediaType = "multipart/form-data"
+			}
+			if f.GetConstraints().GetRequired() {
+				r.Required = true
+			}
+		}
+		m.addRequestBody(r)
+	}
+}
+
+// buildTypeFromResponses builds a service type description from the responses of an API method.
+// Each response is also added to the method once for each media type that the method produces.
+func (b *OpenAPI2Builder) buildTypeFromResponses(m *Method, name string, responses *openapiv2.Responses, produces []string) (typeName string, err error) {
 	t := &Type{}
-	t.Name = name + "Responses"
+	t.Name = b.names.typeName(name + "Responses")
 	t.Description = t.Name + " holds responses of " + name
 	t.Kind = TypeKind_STRUCT
 	t.Fields = make([]*Field, 0)
+	if len(produces) == 0 {
+		produces = []string{"application/json"}
+	}
 
-	for _, responseCode := range responses.ResponseCode {
-		var f Field
-		f.Name = responseCode.Name
-		f.Serialize = false
-		response := responseCode.Value.GetResponse()
-		if response != nil && response.Schema != nil && response.Schema.GetSchema() != nil {
-			f.Kind, f.Type, f.Format = b.typeForSchema(response.Schema.GetSchema())
-			f.Kind = FieldKind_REFERENCE
+	for _, responseCode := range responses.GetResponseCode() {
+		response := b.responseForResponseValue(responseCode.Value)
+		if response == nil {
+			b.reporter.warn([]string{"responses", responseCode.Name}, WarningUnresolvedReference, "unable to resolve response %s of %s", responseCode.Name, name)
+			continue
+		}
+		var kind FieldKind
+		var bodyType, bodyFormat string
+		if schema := response.Schema.GetSchema(); schema != nil {
+			kind, bodyType, bodyFormat = b.typeForSchema(nestedTypeName(t.Name, responseCode.Name), schema)
+			var f Field
+			f.Name = responseCode.Name
+			f.Serialize = false
+			f.Type, f.Format = bodyType, bodyFormat
+			f.Kind = responseFieldKind(kind)
 			t.addField(&f)
+		} else if response.Schema.GetFileSchema() != nil {
+			kind, bodyType, bodyFormat = FieldKind_SCALAR, "string", "binary"
+		}
+		headers := b.buildFieldsFromHeaders(nestedTypeName(t.Name, responseCode.Name), response.Headers)
+		if bodyType == "" {
+			m.addResponse(&Response{Code: responseCode.Name, Description: response.Description, Headers: headers})
+			continue
+		}
+		for _, mediaType := range produces {
+			m.addResponse(&Response{
+				Code:        responseCode.Name,
+				Description: response.Description,
+				MediaType:   mediaType,
+				Kind:        kind,
+				Type:        bodyType,
+				Format:      bodyFormat,
+				Headers:     headers,
+			})
 		}
 	}
+	m.markErrorResponses()
 
-	if len(t.Fields) > 0 {
+	// Methods with responses that have headers get a responses type even if they have no bodies,
+	// so that the headers can be read and written with it.
+	if len(t.Fields) > 0 || m.hasResponseHeaders() {
 		b.model.addType(t)
 		return t.Name, err
 	}
 	return "", err
 }
 
-func (b *OpenAPI2Builder) typeForSchema(schema *openapiv2.Schema) (kind FieldKind, typeName, format string) {
+// responseForResponseValue returns a response, looking it up in the document's responses if it is a reference.
+func (b *OpenAPI2Builder) responseForResponseValue(value *openapiv2.ResponseValue) *openapiv2.Response {
+	if reference := value.GetJsonReference(); reference != nil {
+		responseName := typeForRef(reference.XRef)
+		for _, pair := range b.document.Responses.GetAdditionalProperties() {
+			if pair.Name == responseName {
+				return pair.Value
+			}
+		}
+		return nil
+	}
+	return value.GetResponse()
+}
+
+// buildFieldsFromHeaders builds field descriptions for the headers of a response.
+func (b *OpenAPI2Builder) buildFieldsFromHeaders(name string, headers *openapiv2.Headers) []*Field {
+	fields := make([]*Field, 0)
+	for _, pair := range headers.GetAdditionalProperties() {
+		header := pair.Value
+		var f Field
+		f.Name = pair.Name
+		f.Position = Position_HEADER
+		if header.Type == "array" && header.Items != nil {
+			f.Kind = FieldKind_ARRAY
+			f.Type = b.typeForEnum(nestedTypeName(name, pair.Name), header.Items.Type, header.Items.Enum)
+			f.Format = header.Items.Format
+		} else {
+			f.Type = b.typeForEnum(nestedTypeName(name, pair.Name), header.Type, header.Enum)
+			f.Format = header.Format
+		}
+		f.setConstraints(b.bounds.constraintsForValue(header))
+		fields = append(fields, &f)
+	}
+	return fields
+}
+
+// typeForSchema determines the language-specific type of a schema.
+// Types that are needed to describe inline schemas are added to the model using the specified name.
+func (b *OpenAPI2Builder) typeForSchema(name string, schema *openapiv2.Schema) (kind FieldKind, typeName, format string) {
 	ref := schema.XRef
 	format = schema.Format
 	if ref != "" {
@@ -219,6 +604,10 @@ func (b *OpenAPI2Builder) typeForSchema(schema *openapiv2.Schema) (kind FieldKin
 	}
 	if schema.Type != nil {
 		types := schema.Type.Value
+		if len(types) == 1 && isScalarType(types[0]) && len(schema.Enum) > 0 {
+			// Scalars with a fixed set of values are described with a new enum type.
+			return FieldKind_SCALAR, b.typeForEnum(name, types[0], schema.Enum), ""
+		}
 		if len(types) == 1 && types[0] == "string" {
 			return FieldKind_SCALAR, "string", format
 		}
@@ -237,8 +626,11 @@ func (b *OpenAPI2Builder) typeForSchema(schema *openapiv2.Schema) (kind FieldKin
 		if len(types) == 1 && types[0] == "array" && schema.Items != nil {
 			// we have an array.., but of what?
 			items := schema.Items.Schema
-			if len(items) == 1 && items[0].XRef != "" {
-				return FieldKind_ARRAY, typeForRef(items[0].XRef), format
+			if len(items) == 1 {
+				// arrays of references and scalars hold values of those types.
+				if itemKind, itemType, itemFormat := b.typeForSchema(name+"Item", items[0]); itemKind == FieldKind_SCALAR {
+					return FieldKind_ARRAY, itemType, itemFormat
+				}
 			}
 		}
 		if len(types) == 1 && types[0] == "object" && schema.AdditionalProperties == nil {
@@ -248,11 +640,79 @@ func (b *OpenAPI2Builder) typeForSchema(schema *openapiv2.Schema) (kind FieldKin
 	if schema.AdditionalProperties != nil {
 		additionalProperties := schema.AdditionalProperties
 		if propertySchema := additionalProperties.GetSchema(); propertySchema != nil {
-			if ref := propertySchema.XRef; ref != "" {
-				return FieldKind_MAP, typeForRef(ref), format
+			// maps of references and scalars hold values of those types.
+			if valueKind, valueType, valueFormat := b.typeForSchema(name+"Value", propertySchema); valueKind == FieldKind_SCALAR {
+				return FieldKind_MAP, valueType, valueFormat
 			}
 		}
 	}
 	// this function is incomplete... so return a string representing anything that we don't handle
 	return FieldKind_SCALAR, fmt.Sprintf("%v", schema), format
 }
+
+// primitiveValue describes the values of parameters that aren't sent in request bodies
+// and the items of arrays of those values.
+type primitiveValue interface {
+	GetType() string
+	GetFormat() string
+	GetEnum() []*openapiv2.Any
+	GetItems() *openapiv2.PrimitivesItems
+}
+
+// typeForPrimitive determines the language-specific type of a parameter that isn't sent in a request body.
+// Arrays have the type of their items.
+func (b *OpenAPI2Builder) typeForPrimitive(name string, value primitiveValue) (kind FieldKind, typeName, format string) {
+	kind = FieldKind_SCALAR
+	if value.GetType() == "array" && value.GetItems() != nil {
+		kind = FieldKind_ARRAY
+		value = value.GetItems()
+	}
+	return kind, b.typeForEnum(name, value.GetType(), value.GetEnum()), value.GetFormat()
+}
+
+// styleForCollectionFormat returns the OpenAPI 3 style that corresponds to the collection format
+// of an array parameter in a position, and whether the items of the array are exploded.
+func styleForCollectionFormat(position Position, collectionFormat string) (style string, explode bool) {
+	switch collectionFormat {
+	case "multi":
+		return "form", true
+	case "ssv":
+		return "spaceDelimited", false
+	case "tsv":
+		return "tabDelimited", false
+	case "pipes":
+		return "pipeDelimited", false
+	default:
+		return defaultStyle(position), false
+	}
+}
+
+// typeForEnum returns the name of a new enum type if a scalar has a fixed set of values.
+// Otherwise it returns the name of the scalar type.
+func (b *OpenAPI2Builder) typeForEnum(name string, typeName string, values []*openapiv2.Any) string {
+	if len(values) == 0 || !isScalarType(typeName) {
+		return typeName
+	}
+	name = b.names.typeName(name)
+	b.model.addType(enumType(name, typeName, enumValuesForAny(values)))
+	return name
+}
+
+// nameForVendorExtensions returns the name that an x-gnostic-name extension specifies, or an empty string if there is none.
+func nameForVendorExtensions(extensions []*openapiv2.NamedAny) string {
+	for _, pair := range extensions {
+		if pair.Name == nameExtension {
+			return enumValue(pair.Value.GetYaml())
+		}
+	}
+	return ""
+}
+
+// enumValuesForAny returns the text of a list of enum values.
+func enumValuesForAny(values []*openapiv2.Any) []string {
+	result := make([]string, 0)
+	for _, value := range values {
+		result = append(result, enumValue(value.Yaml))
+	}
+	return result
+}
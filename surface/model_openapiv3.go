@@ -17,8 +17,6 @@ package surface_v1
 import (
 	"errors"
 	"fmt"
-	"log"
-
 	"strings"
 
 	openapiv3 "github.com/googleapis/gnostic/OpenAPIv3"
@@ -26,22 +24,42 @@ import (
 
 // NewModelFromOpenAPIv3 builds a model of an API service for use in code generation.
 func NewModelFromOpenAPI3(document *openapiv3.Document) (*Model, error) {
-	return newOpenAPI3Builder().buildModel(document)
+	model, _, err := NewModelFromOpenAPI3WithInfo(document, "", nil)
+	return model, err
+}
+
+// NewModelFromOpenAPI3WithInfo builds a model of an API service from a document, the name of the file
+// that it was read from, and the description that it was compiled from, such as the result of
+// compiler.ReadInfoFromBytes. References to other files are relative to sourceName, which may be
+// empty if the document only has local references. Bounds that are zero are only found in descriptions.
+// Problems that are found in the API description are returned as warnings.
+func NewModelFromOpenAPI3WithInfo(document *openapiv3.Document, sourceName string, info interface{}) (*Model, []*Warning, error) {
+	b := newOpenAPI3Builder()
+	b.bounds = boundsForOpenAPI3(document, info)
+	model, err := b.buildModel(document, sourceName)
+	return model, b.reporter.warnings, err
 }
 
 type OpenAPI3Builder struct {
-	model *Model
+	model    *Model
+	resolver *openapiv3.Resolver
+	bounds   *bounds
+	security []*openapiv3.SecurityRequirement // the requirements of methods that don't specify their own
+	names    *namer
+	reporter *reporter
 }
 
 func newOpenAPI3Builder() *OpenAPI3Builder {
-	return &OpenAPI3Builder{model: &Model{}}
+	r := &reporter{}
+	return &OpenAPI3Builder{model: &Model{}, bounds: newBounds(nil), names: newNamer(r), reporter: r}
 }
 
-func (b *OpenAPI3Builder) buildModel(document *openapiv3.Document) (*Model, error) {
+func (b *OpenAPI3Builder) buildModel(document *openapiv3.Document, sourceName string) (*Model, error) {
 	// Set model properties from passed-in document.
 	b.model.Name = document.Info.Title
 	b.model.Types = make([]*Type, 0)
 	b.model.Methods = make([]*Method, 0)
+	b.resolver = openapiv3.NewResolver(document, sourceName)
 	err := b.build(document)
 	if err != nil {
 		return nil, err
@@ -51,25 +69,49 @@ func (b *OpenAPI3Builder) buildModel(document *openapiv3.Document) (*Model, erro
 
 // build builds an API service description, preprocessing its types and methods for code generation.
 func (b *OpenAPI3Builder) build(document *openapiv3.Document) (err error) {
+	// Reserve the names of schemas, which types that are described inline can't use.
+	if document.Components != nil && document.Components.Schemas != nil {
+		for _, pair := range document.Components.Schemas.AdditionalProperties {
+			b.reporter.at("components", "schemas", pair.Name)
+			b.names.reserveTypeName(pair.Name, nameForExtensions(pair.Value.GetSchema().GetSpecificationExtension()))
+		}
+	}
 	// Collect service type descriptions from Components/Schemas.
 	if document.Components != nil && document.Components.Schemas != nil {
 		for _, pair := range document.Components.Schemas.AdditionalProperties {
+			b.reporter.at("components", "schemas", pair.Name)
 			t, err := b.buildTypeFromSchemaOrReference(pair.Name, pair.Value)
 			if err != nil {
-				return err
+				b.reporter.warn(nil, WarningOmitted, "unable to build type %s: %v", pair.Name, err)
+				continue
 			}
 			if t != nil {
 				b.model.addType(t)
 			}
 		}
 	}
+	// Collect descriptions of the ways that clients can be authenticated.
+	if document.Components != nil && document.Components.SecuritySchemes != nil {
+		for _, pair := range document.Components.SecuritySchemes.AdditionalProperties {
+			b.reporter.at("components", "securitySchemes", pair.Name)
+			if s := b.buildSecurityScheme(pair.Name, pair.Value); s != nil {
+				b.model.addSecurityScheme(s)
+			}
+		}
+	}
+	b.security = document.Security
+	// Collect the servers that provide the API.
+	for _, server := range document.Servers {
+		b.model.addServer(b.buildServer(server))
+	}
 	// Collect service method descriptions from each PathItem.
 	if document.Paths != nil {
 		for _, pair := range document.Paths.Path {
-			b.buildMethodFromPathItem(pair.Name, pair.Value)
+			b.buildMethodsFromPathItem(pair.Name, pair.Value)
 		}
 	}
-	return err
+	b.names.renameTypes(b.model)
+	return nil
 }
 
 // buildTypeFromSchemaOrReference builds a service type description from a schema in the API description.
@@ -77,43 +119,126 @@ func (b *OpenAPI3Builder) buildTypeFromSchemaOrReference(
 	name string,
 	schemaOrReference *openapiv3.SchemaOrReference) (t *Type, err error) {
 	if schema := schemaOrReference.GetSchema(); schema != nil {
-		t = &Type{}
-		t.Name = name
-		t.Description = "implements the service definition of " + name
-		t.Fields = make([]*Field, 0)
-		if schema.Properties != nil {
-			if len(schema.Properties.AdditionalProperties) > 0 {
-				// If the schema has properties, generate a struct.
-				t.Kind = TypeKind_STRUCT
-			}
-			for _, pair2 := range schema.Properties.AdditionalProperties {
-				if schema := pair2.Value; schema != nil {
-					var f Field
-					f.Name = pair2.Name
-					f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(schema)
-					f.Serialize = true
-					t.addField(&f)
-				}
+		return b.buildTypeFromSchema(name, schema)
+	} else {
+		return nil, errors.New("unable to determine service type for referenced schema " + name)
+	}
+}
+
+// buildTypeFromSchema builds a service type description from a schema.
+func (b *OpenAPI3Builder) buildTypeFromSchema(name string, schema *openapiv3.Schema) (t *Type, err error) {
+	t = &Type{}
+	t.Name = name
+	t.Description = "implements the service definition of " + name
+	t.Fields = make([]*Field, 0)
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		// If the schema lists alternatives, generate a union.
+		return b.buildUnionTypeFromSchema(name, schema), nil
+	}
+	// By default, generate a struct with a field for each property.
+	t.Kind = TypeKind_STRUCT
+	err = b.addFieldsFromSchema(t, schema)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.Fields) == 0 {
+		if len(schema.Enum) > 0 && isScalarType(schema.Type) {
+			// If the schema is a scalar with a fixed set of values, generate an enum.
+			t = enumType(name, schema.Type, enumValuesForSchema(schema))
+		} else if schema.AdditionalProperties != nil {
+			// If the schema has no fixed properties and additional properties of a specified type,
+			// generate a map pointing to objects of that type.
+			t.Kind = TypeKind_OBJECT
+			t.ContentType, _ = b.typeForAdditionalProperties(name+"Value", schema.AdditionalProperties)
+		} else if schema.Type == "array" {
+			// If the schema is an array, generate a list of its items.
+			t.Kind = TypeKind_LIST
+			t.ContentType, _ = b.typeForItems(name+"Item", schema.Items)
+		}
+	}
+	return t, err
+}
+
+// buildUnionTypeFromSchema builds a service type description from a schema that lists alternatives with oneOf or anyOf.
+// If the schema has a discriminator, each variant records the discriminator values that identify it.
+func (b *OpenAPI3Builder) buildUnionTypeFromSchema(name string, schema *openapiv3.Schema) *Type {
+	t := &Type{}
+	t.Name = name
+	t.Description = "implements the service definition of " + name
+	t.Kind = TypeKind_UNION
+	t.Fields = make([]*Field, 0)
+	t.Variants = make([]*Variant, 0)
+	// Explicitly mapped discriminator values replace the implicit ones, which are the names of the variant types.
+	mapping := make(map[string][]string)
+	if schema.Discriminator != nil {
+		t.Discriminator = schema.Discriminator.PropertyName
+		for _, pair := range schema.Discriminator.Mapping.GetAdditionalProperties() {
+			typeName := typeForRef(pair.Value)
+			mapping[typeName] = append(mapping[typeName], pair.Name)
+		}
+	}
+	alternatives := append(append([]*openapiv3.SchemaOrReference{}, schema.OneOf...), schema.AnyOf...)
+	for i, alternative := range alternatives {
+		typeName, _ := b.typeForElement(nestedTypeName(name, fmt.Sprintf("Variant%d", i+1)), alternative)
+		if t.variantWithType(typeName) != nil {
+			continue
+		}
+		v := &Variant{Type: typeName}
+		if values, ok := mapping[typeName]; ok {
+			v.DiscriminatorValues = values
+		} else if t.Discriminator != "" && alternative.GetReference() != nil {
+			v.DiscriminatorValues = []string{typeName}
+		}
+		t.Variants = append(t.Variants, v)
+	}
+	return t
+}
+
+// addFieldsFromSchema adds fields for the properties of a schema to a type.
+// Properties of schemas listed in allOf are added first, so properties of the schema itself override them.
+func (b *OpenAPI3Builder) addFieldsFromSchema(t *Type, schema *openapiv3.Schema) error {
+	for _, item := range schema.AllOf {
+		s, err := b.schemaForSchemaOrReference(item)
+		if err != nil {
+			return err
+		}
+		if s != nil {
+			err = b.addFieldsFromSchema(t, s)
+			if err != nil {
+				return err
 			}
 		}
-		if len(t.Fields) == 0 {
-			if schema.AdditionalProperties != nil {
-				// If the schema has no fixed properties and additional properties of a specified type,
-				// generate a map pointing to objects of that type.
-				t.Kind = TypeKind_OBJECT
-				t.ContentType = typeForRef(schema.AdditionalProperties.GetSchemaOrReference().GetReference().GetXRef())
+	}
+	if schema.Properties != nil {
+		for _, pair := range schema.Properties.AdditionalProperties {
+			if pair.Value == nil {
+				continue
 			}
+			var f Field
+			f.Name = pair.Name
+			f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(nestedTypeName(t.Name, pair.Name), pair.Value)
+			f.Serialize = true
+			f.setConstraints(b.constraintsForSchema(pair.Value.GetSchema()))
+			t.setField(&f)
 		}
-		return t, err
-	} else {
-		return nil, errors.New("unable to determine service type for referenced schema " + name)
 	}
+	t.setRequiredFields(schema.Required)
+	return nil
 }
 
-// buildMethodFromOperation builds a service method description
-func (b *OpenAPI3Builder) buildMethodFromPathItem(
+// schemaForSchemaOrReference returns a schema, resolving it if it is a reference.
+func (b *OpenAPI3Builder) schemaForSchemaOrReference(value *openapiv3.SchemaOrReference) (*openapiv3.Schema, error) {
+	if reference := value.GetReference(); reference != nil {
+		return b.resolver.ResolveSchema(reference.XRef)
+	}
+	return value.GetSchema(), nil
+}
+
+// buildMethodsFromPathItem builds service method descriptions for the operations of a path.
+// Operations that can't be built are reported and left out of the model.
+func (b *OpenAPI3Builder) buildMethodsFromPathItem(
 	path string,
-	pathItem *openapiv3.PathItem) (err error) {
+	pathItem *openapiv3.PathItem) {
 	for _, method := range []string{"GET", "PUT", "POST", "DELETE", "OPTIONS", "HEAD", "PATCH", "TRACE"} {
 		var op *openapiv3.Operation
 		switch method {
@@ -135,73 +260,232 @@ func (b *OpenAPI3Builder) buildMethodFromPathItem(
 			op = pathItem.Trace
 		}
 		if op != nil {
-			var m Method
-			m.Operation = op.OperationId
-			m.Path = path
-			m.Method = method
-			m.Name = sanitizeOperationName(op.OperationId)
-			if m.Name == "" {
-				m.Name = generateOperationName(method, path)
+			b.reporter.at("paths", path, strings.ToLower(method))
+			err := b.buildMethodFromOperation(path, method, op, pathItem.Parameters)
+			if err != nil {
+				b.reporter.warn(nil, WarningOmitted, "unable to build method for %s %s: %v", method, path, err)
+			}
+		}
+	}
+}
+
+// buildMethodFromOperation builds a service method description
+func (b *OpenAPI3Builder) buildMethodFromOperation(
+	path string,
+	method string,
+	op *openapiv3.Operation,
+	pathParameters []*openapiv3.ParameterOrReference) (err error) {
+	var m Method
+	m.Operation = op.OperationId
+	m.Path = path
+	m.Method = method
+	m.Name = sanitizeOperationName(op.OperationId)
+	if m.Name == "" {
+		m.Name = generateOperationName(method, path)
+	}
+	m.Description = op.Description
+	m.Summary = op.Summary
+	m.Tags = op.Tags
+	m.Deprecated = op.Deprecated
+	if docs := op.ExternalDocs; docs != nil {
+		m.ExternalDocs = &ExternalDocs{Url: docs.Url, Description: docs.Description}
+	}
+	for _, pair := range op.SpecificationExtension {
+		m.addExtension(pair.Name, pair.Value.GetYaml())
+	}
+	// an operation without a security field uses the requirements of the document,
+	// but an empty security field means that the operation needs no credentials.
+	security := op.Security
+	if security == nil {
+		security = b.security
+	}
+	m.SecurityRequirements = b.buildSecurityRequirements(security)
+	parameters, err := b.resolveParameters(pathParameters, op.Parameters)
+	if err != nil {
+		return err
+	}
+	m.Name = b.names.methodName(&m, m.Name)
+	m.ParametersTypeName, err = b.buildTypeFromParameters(&m, m.Name, parameters, op.RequestBody)
+	if err != nil {
+		return err
+	}
+	m.ResponsesTypeName, err = b.buildTypeFromResponses(&m, m.Name, op.Responses)
+	if err != nil {
+		return err
+	}
+	b.model.addMethod(&m)
+	return nil
+}
+
+// buildServer builds a description of a server that provides the API.
+func (b *OpenAPI3Builder) buildServer(server *openapiv3.Server) *Server {
+	s := &Server{
+		Url:         server.Url,
+		Description: server.Description,
+	}
+	for _, pair := range server.GetVariables().GetAdditionalProperties() {
+		s.Variables = append(s.Variables, &ServerVariable{
+			Name:         pair.Name,
+			DefaultValue: pair.Value.GetDefault(),
+			EnumValues:   pair.Value.GetEnum(),
+			Description:  pair.Value.GetDescription(),
+		})
+	}
+	return s
+}
+
+// buildSecurityScheme builds a description of a way that clients can be authenticated.
+// Schemes that can't be resolved or that have unknown types are reported and skipped.
+func (b *OpenAPI3Builder) buildSecurityScheme(name string, value *openapiv3.SecuritySchemeOrReference) *SecurityScheme {
+	scheme := value.GetSecurityScheme()
+	if reference := value.GetReference(); reference != nil {
+		var err error
+		scheme, err = b.resolver.ResolveSecurityScheme(reference.XRef)
+		if err != nil {
+			b.reporter.warn([]string{"$ref"}, WarningUnresolvedReference, "unable to resolve security scheme %s: %v", name, err)
+			return nil
+		}
+	}
+	s := &SecurityScheme{
+		Name:        name,
+		Description: scheme.GetDescription(),
+	}
+	switch scheme.GetType() {
+	case "apiKey":
+		s.Type = SecuritySchemeType_API_KEY
+		s.ParameterName = scheme.Name
+		s.Position = positionForSecurityScheme(scheme.In)
+	case "http":
+		s.Type = SecuritySchemeType_HTTP
+		s.Scheme = strings.ToLower(scheme.Scheme)
+		s.BearerFormat = scheme.BearerFormat
+	case "oauth2":
+		s.Type = SecuritySchemeType_OAUTH2
+		flows := scheme.GetFlows()
+		for _, flow := range []struct {
+			name  string
+			value *openapiv3.OauthFlow
+		}{
+			{"implicit", flows.GetImplicit()},
+			{"password", flows.GetPassword()},
+			{"clientCredentials", flows.GetClientCredentials()},
+			{"authorizationCode", flows.GetAuthorizationCode()},
+		} {
+			if flow.value != nil {
+				f := &OAuthFlow{
+					Type:             flow.name,
+					AuthorizationUrl: flow.value.AuthorizationUrl,
+					TokenUrl:         flow.value.TokenUrl,
+					RefreshUrl:       flow.value.RefreshUrl,
+				}
+				for _, pair := range flow.value.GetScopes().GetAdditionalProperties() {
+					f.Scopes = append(f.Scopes, pair.Name)
+				}
+				s.Flows = append(s.Flows, f)
+			}
+		}
+	case "openIdConnect":
+		s.Type = SecuritySchemeType_OPEN_ID_CONNECT
+		s.OpenIdConnectUrl = scheme.OpenIdConnectUrl
+	default:
+		b.reporter.warn([]string{"type"}, WarningUnsupported, "unsupported type %s of security scheme %s", scheme.GetType(), name)
+		return nil
+	}
+	return s
+}
+
+// buildSecurityRequirements builds descriptions of the alternative sets of credentials that a method accepts.
+func (b *OpenAPI3Builder) buildSecurityRequirements(requirements []*openapiv3.SecurityRequirement) []*SecurityRequirement {
+	result := make([]*SecurityRequirement, 0)
+	for _, requirement := range requirements {
+		r := &SecurityRequirement{}
+		for _, pair := range requirement.GetAdditionalProperties() {
+			r.addScheme(pair.Name, pair.GetValue().GetValue())
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// resolveParameters returns the parameters of an operation, which include the parameters of its
+// path that it doesn't override with parameters that have the same name and location.
+// Referenced parameters are resolved.
+func (b *OpenAPI3Builder) resolveParameters(
+	pathParameters []*openapiv3.ParameterOrReference,
+	operationParameters []*openapiv3.ParameterOrReference) ([]*openapiv3.Parameter, error) {
+	parameters := make([]*openapiv3.Parameter, 0)
+	for _, value := range append(append([]*openapiv3.ParameterOrReference{}, pathParameters...), operationParameters...) {
+		parameter := value.GetParameter()
+		if reference := value.GetReference(); reference != nil {
+			var err error
+			parameter, err = b.resolver.ResolveParameter(reference.XRef)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if parameter == nil {
+			continue
+		}
+		overridden := false
+		for i, p := range parameters {
+			if p.Name == parameter.Name && p.In == parameter.In {
+				parameters[i] = parameter
+				overridden = true
 			}
-			m.Description = op.Description
-			m.ParametersTypeName, err = b.buildTypeFromParameters(m.Name, op.Parameters, op.RequestBody)
-			m.ResponsesTypeName, err = b.buildTypeFromResponses(&m, m.Name, op.Responses)
-			b.model.addMethod(&m)
+		}
+		if !overridden {
+			parameters = append(parameters, parameter)
 		}
 	}
-	return err
+	return parameters, nil
 }
 
 // buildTypeFromParameters builds a service type description from the parameters of an API method
 func (b *OpenAPI3Builder) buildTypeFromParameters(
+	m *Method,
 	name string,
-	parameters []*openapiv3.ParameterOrReference,
+	parameters []*openapiv3.Parameter,
 	requestBody *openapiv3.RequestBodyOrReference) (typeName string, err error) {
 	t := &Type{}
-	t.Name = name + "Parameters"
+	t.Name = b.names.typeName(name + "Parameters")
 	t.Description = t.Name + " holds parameters to " + name
 	t.Kind = TypeKind_STRUCT
 	t.Fields = make([]*Field, 0)
-	for _, parametersItem := range parameters {
+	for _, parameter := range parameters {
 		var f Field
-		f.Type = fmt.Sprintf("%+v", parametersItem)
-		parameter := parametersItem.GetParameter()
-		if parameter != nil {
-			switch parameter.In {
-			case "body":
-				f.Position = Position_BODY
-			case "header":
-				f.Position = Position_HEADER
-			case "formdata":
-				f.Position = Position_FORMDATA
-			case "query":
-				f.Position = Position_QUERY
-			case "path":
-				f.Position = Position_PATH
-			}
-			f.Name = parameter.Name
-			if parameter.GetSchema() != nil && parameter.GetSchema() != nil {
-				f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(parameter.GetSchema())
-			}
-			f.Serialize = true
-			t.addField(&f)
+		f.Type = fmt.Sprintf("%+v", parameter)
+		switch parameter.In {
+		case "body":
+			f.Position = Position_BODY
+		case "header":
+			f.Position = Position_HEADER
+		case "formdata":
+			f.Position = Position_FORMDATA
+		case "query":
+			f.Position = Position_QUERY
+		case "path":
+			f.Position = Position_PATH
+		case "cookie":
+			f.Position = Position_COOKIE
 		}
+		f.Name = parameter.Name
+		if parameter.GetSchema() != nil && parameter.GetSchema() != nil {
+			f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(nestedTypeName(t.Name, f.Name), parameter.GetSchema())
+			f.setConstraints(b.constraintsForSchema(parameter.GetSchema().GetSchema()))
+		}
+		// Documents don't record whether explode is specified, so parameters that specify
+		// a style are only exploded if they also specify explode.
+		f.setStyle(parameter.Style, parameter.Explode)
+		if parameter.Required {
+			f.setRequired()
+		}
+		f.Serialize = true
+		t.addField(&f)
 	}
 	if requestBody != nil {
-		content := requestBody.GetRequestBody().GetContent()
-		if content != nil {
-			for _, pair2 := range content.GetAdditionalProperties() {
-				if pair2.Name != "application/json" {
-					log.Printf("unimplemented: %q requestBody(%s)", name, pair2.Name)
-					continue
-				}
-				var f Field
-				f.Position = Position_BODY
-				f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(pair2.GetValue().GetSchema())
-				f.Name = strings.ToLower(f.Type) // use the schema name as the parameter name, since none is directly specified
-				f.Serialize = true
-				t.addField(&f)
-			}
+		err = b.buildRequestBodies(m, t, name, requestBody)
+		if err != nil {
+			return "", err
 		}
 	}
 	if len(t.Fields) > 0 {
@@ -211,29 +495,148 @@ func (b *OpenAPI3Builder) buildTypeFromParameters(
 	return "", err
 }
 
+// buildRequestBodies records the media types that a method accepts for its request body
+// and adds a field for the body in the preferred media type to the parameters type.
+// Bodies that use the schema of the preferred body share its type.
+func (b *OpenAPI3Builder) buildRequestBodies(m *Method, t *Type, name string, value *openapiv3.RequestBodyOrReference) error {
+	requestBody := value.GetRequestBody()
+	if reference := value.GetReference(); reference != nil {
+		var err error
+		requestBody, err = b.resolver.ResolveRequestBody(reference.XRef)
+		if err != nil {
+			return err
+		}
+	}
+	content := requestBody.GetContent().GetAdditionalProperties()
+	mediaTypes := make([]string, 0)
+	for _, pair := range content {
+		mediaTypes = append(mediaTypes, pair.Name)
+	}
+	preferred := preferredMediaType(mediaTypes)
+	bodyTypeName := name + "RequestBody"
+	var preferredSchema *openapiv3.SchemaOrReference
+	var preferredBody *RequestBody
+	for _, pair := range content {
+		if pair.Name == preferred {
+			preferredSchema = pair.Value.GetSchema()
+			preferredBody = b.buildRequestBody(bodyTypeName, pair.Name, pair.Value)
+		}
+	}
+	for _, pair := range content {
+		r := preferredBody
+		if pair.Name != preferred {
+			if pair.Value.GetSchema() != nil && pair.Value.GetSchema().Equal(preferredSchema) {
+				r = &RequestBody{Kind: preferredBody.Kind, Type: preferredBody.Type, Format: preferredBody.Format}
+				r.MediaType = pair.Name
+				r.Encodings = b.buildEncodings(nestedTypeName(bodyTypeName, pair.Name), pair.Value.GetEncoding())
+			} else {
+				r = b.buildRequestBody(nestedTypeName(bodyTypeName, pair.Name), pair.Name, pair.Value)
+			}
+		}
+		r.Required = requestBody.Required
+		m.addRequestBody(r)
+	}
+	if preferredBody != nil {
+		var f Field
+		f.Name = t.bodyFieldName(preferredBody.Type, preferredSchema.GetReference() != nil)
+		f.Position = Position_BODY
+		f.Kind, f.Type, f.Format = preferredBody.Kind, preferredBody.Type, preferredBody.Format
+		f.MediaType = preferred
+		f.setConstraints(b.constraintsForSchema(preferredSchema.GetSchema()))
+		if requestBody.Required {
+			f.setRequired()
+		}
+		f.Serialize = true
+		t.addField(&f)
+	}
+	return nil
+}
+
+// buildRequestBody builds a description of a request body in one media type.
+// Bodies without schemas are text if they have text media types and binary data otherwise.
+func (b *OpenAPI3Builder) buildRequestBody(name string, mediaType string, value *openapiv3.MediaType) *RequestBody {
+	r := &RequestBody{MediaType: mediaType}
+	if value.GetSchema() != nil {
+		r.Kind, r.Type, r.Format = b.typeForSchemaOrReference(name, value.GetSchema())
+	} else if strings.HasPrefix(mediaType, "text/") {
+		r.Type = "string"
+	} else {
+		r.Type, r.Format = "string", "binary"
+	}
+	r.Encodings = b.buildEncodings(name, value.GetEncoding())
+	return r
+}
+
+// buildEncodings builds descriptions of how the properties of a form or multipart body are encoded.
+func (b *OpenAPI3Builder) buildEncodings(name string, encodings *openapiv3.Encodings) []*Encoding {
+	result := make([]*Encoding, 0)
+	for _, pair := range encodings.GetAdditionalProperties() {
+		result = append(result, &Encoding{
+			Name:        pair.Name,
+			ContentType: pair.Value.ContentType,
+			Headers:     b.buildFieldsFromHeaders(nestedTypeName(name, pair.Name), pair.Value.GetHeaders()),
+			Style:       pair.Value.Style,
+			Explode:     pair.Value.Explode,
+		})
+	}
+	return result
+}
+
 // buildTypeFromResponses builds a service type description from the responses of an API method
 func (b *OpenAPI3Builder) buildTypeFromResponses(
 	m *Method,
 	name string,
 	responses *openapiv3.Responses) (typeName string, err error) {
 	t := &Type{}
-	t.Name = name + "Responses"
+	t.Name = b.names.typeName(name + "Responses")
 	t.Description = t.Name + " holds responses of " + name
 	t.Kind = TypeKind_STRUCT
 	t.Fields = make([]*Field, 0)
 
-	addResponse := func(name string, value *openapiv3.ResponseOrReference) {
-		var f Field
-		f.Name = name
-		f.Serialize = false
+	addResponse := func(code string, value *openapiv3.ResponseOrReference) {
 		response := value.GetResponse()
-		if response != nil && response.GetContent() != nil {
-			for _, pair2 := range response.GetContent().GetAdditionalProperties() {
-				f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(pair2.GetValue().GetSchema())
-				f.Kind = FieldKind_REFERENCE
-				t.addField(&f)
+		if reference := value.GetReference(); reference != nil {
+			var err error
+			response, err = b.resolver.ResolveResponse(reference.XRef)
+			if err != nil {
+				b.reporter.warn([]string{"responses", code}, WarningUnresolvedReference, "unable to resolve response %s of %s: %v", code, name, err)
+				return
 			}
 		}
+		if response == nil {
+			return
+		}
+		headers := b.buildFieldsFromHeaders(nestedTypeName(t.Name, code), response.GetHeaders())
+		content := response.GetContent().GetAdditionalProperties()
+		if len(content) == 0 {
+			m.addResponse(&Response{Code: code, Description: response.Description, Headers: headers})
+			return
+		}
+		mediaTypes := make([]string, 0)
+		for _, pair2 := range content {
+			mediaTypes = append(mediaTypes, pair2.Name)
+		}
+		preferred := preferredMediaType(mediaTypes)
+		for _, pair2 := range content {
+			r := &Response{Code: code, Description: response.Description, MediaType: pair2.Name, Headers: headers}
+			if schema := pair2.GetValue().GetSchema(); schema != nil {
+				// Inline schemas for media types other than the preferred one are named for their media types.
+				bodyTypeName := nestedTypeName(t.Name, code)
+				if pair2.Name != preferred {
+					bodyTypeName = nestedTypeName(bodyTypeName, pair2.Name)
+				}
+				r.Kind, r.Type, r.Format = b.typeForSchemaOrReference(bodyTypeName, schema)
+				if pair2.Name == preferred {
+					var f Field
+					f.Name = code
+					f.Serialize = false
+					f.Type, f.Format = r.Type, r.Format
+					f.Kind = responseFieldKind(r.Kind)
+					t.addField(&f)
+				}
+			}
+			m.addResponse(r)
+		}
 	}
 
 	for _, pair := range responses.ResponseOrReference {
@@ -242,70 +645,176 @@ func (b *OpenAPI3Builder) buildTypeFromResponses(
 	if responses.Default != nil {
 		addResponse("default", responses.Default)
 	}
+	m.markErrorResponses()
 
-	if len(t.Fields) > 0 {
+	// Methods with responses that have headers get a responses type even if they have no bodies,
+	// so that the headers can be read and written with it.
+	if len(t.Fields) > 0 || m.hasResponseHeaders() {
 		b.model.addType(t)
 		return t.Name, err
 	}
 	return "", err
 }
 
-// typeForSchemaOrReference determines the language-specific type of a schema or reference
-func (b *OpenAPI3Builder) typeForSchemaOrReference(value *openapiv3.SchemaOrReference) (kind FieldKind, typeName, format string) {
+// buildFieldsFromHeaders builds field descriptions for the headers of a response.
+func (b *OpenAPI3Builder) buildFieldsFromHeaders(name string, headers *openapiv3.HeadersOrReferences) []*Field {
+	fields := make([]*Field, 0)
+	for _, pair := range headers.GetAdditionalProperties() {
+		header := pair.Value.GetHeader()
+		if reference := pair.Value.GetReference(); reference != nil {
+			var err error
+			header, err = b.resolver.ResolveHeader(reference.XRef)
+			if err != nil {
+				b.reporter.warn(nil, WarningUnresolvedReference, "unable to resolve header %s of %s: %v", pair.Name, name, err)
+				continue
+			}
+		}
+		if header == nil {
+			continue
+		}
+		var f Field
+		f.Name = pair.Name
+		f.Position = Position_HEADER
+		f.Type = "string"
+		if header.Schema != nil {
+			f.Kind, f.Type, f.Format = b.typeForSchemaOrReference(nestedTypeName(name, pair.Name), header.Schema)
+			f.setConstraints(b.constraintsForSchema(header.Schema.GetSchema()))
+		}
+		if header.Required {
+			f.setRequired()
+		}
+		fields = append(fields, &f)
+	}
+	return fields
+}
+
+// typeForSchemaOrReference determines the language-specific type of a schema or reference.
+// Types that are needed to describe inline schemas are added to the model using the specified name.
+func (b *OpenAPI3Builder) typeForSchemaOrReference(name string, value *openapiv3.SchemaOrReference) (kind FieldKind, typeName, format string) {
 	if value.GetSchema() != nil {
-		return b.typeForSchema(value.GetSchema())
+		return b.typeForSchema(name, value.GetSchema())
 	}
 	if value.GetReference() != nil {
 		return FieldKind_SCALAR, typeForRef(value.GetReference().XRef), ""
 	}
-	return FieldKind_SCALAR, "todo", ""
+	return FieldKind_SCALAR, "object", ""
 }
 
 // typeForSchema determines the language-specific type of a schema
-func (b *OpenAPI3Builder) typeForSchema(schema *openapiv3.Schema) (kind FieldKind, typeName, format string) {
-	if schema.Type != "" {
-		format := schema.Format
-		switch schema.Type {
-		case "string":
-			return FieldKind_SCALAR, "string", format
-		case "integer":
-			return FieldKind_SCALAR, "integer", format
-		case "number":
-			return FieldKind_SCALAR, "number", format
-		case "boolean":
-			return FieldKind_SCALAR, "boolean", format
-		case "array":
-			if schema.Items != nil {
-				// we have an array.., but of what?
-				items := schema.Items
-				if items != nil {
-					a := items.GetSchemaOrReference()
-					if a[0].GetReference().GetXRef() != "" {
-						return FieldKind_ARRAY, typeForRef(a[0].GetReference().GetXRef()), format
-					} else if a[0].GetSchema().Type == "string" {
-						return FieldKind_ARRAY, "string", format
-					} else if a[0].GetSchema().Type == "object" {
-						return FieldKind_ARRAY, "object", format
-					}
-				}
-			}
-		case "object":
-			if schema.AdditionalProperties == nil {
-				return FieldKind_MAP, "object", format
-			}
-		default:
-
+func (b *OpenAPI3Builder) typeForSchema(name string, schema *openapiv3.Schema) (kind FieldKind, typeName, format string) {
+	switch schema.Type {
+	case "string", "integer", "number", "boolean":
+		if len(schema.Enum) > 0 {
+			// Scalars with a fixed set of values are described with a new enum type.
+			name = b.names.typeName(name)
+			b.model.addType(enumType(name, schema.Type, enumValuesForSchema(schema)))
+			return FieldKind_SCALAR, name, ""
 		}
+		return FieldKind_SCALAR, schema.Type, schema.Format
+	case "array":
+		typeName, format = b.typeForItems(name+"Item", schema.Items)
+		return FieldKind_ARRAY, typeName, format
 	}
-	if schema.AdditionalProperties != nil {
-		additionalProperties := schema.AdditionalProperties
-		if propertySchema := additionalProperties.GetSchemaOrReference().GetReference(); propertySchema != nil {
-			if ref := propertySchema.XRef; ref != "" {
-				return FieldKind_MAP, "map[string]" + typeForRef(ref), ""
-			}
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		// Inline alternatives are described with a new union type.
+		name = b.names.typeName(name)
+		b.model.addType(b.buildUnionTypeFromSchema(name, schema))
+		return FieldKind_SCALAR, name, ""
+	}
+	if len(schema.AllOf) == 1 && schema.Properties == nil {
+		// A single schema in allOf is commonly used to annotate a reference.
+		return b.typeForSchemaOrReference(name, schema.AllOf[0])
+	}
+	if len(schema.AllOf) > 0 || (schema.Properties != nil && len(schema.Properties.AdditionalProperties) > 0) {
+		// Inline objects are described with a new named type.
+		name = b.names.typeName(name)
+		t, err := b.buildTypeFromSchema(name, schema)
+		if err != nil {
+			b.reporter.warn(nil, WarningOmitted, "unable to build type %s: %v", name, err)
+			return FieldKind_SCALAR, "object", ""
 		}
+		b.model.addType(t)
+		return FieldKind_SCALAR, name, ""
+	}
+	if schema.Type == "object" || schema.AdditionalProperties != nil {
+		typeName, format = b.typeForAdditionalProperties(name+"Value", schema.AdditionalProperties)
+		return FieldKind_MAP, typeName, format
 	}
-	// this function is incomplete... use generic interface{} for now
-	log.Printf("unimplemented: %v", schema)
+	// Schemas that don't constrain their values can hold anything.
 	return FieldKind_SCALAR, "object", ""
 }
+
+// nameForExtensions returns the name that an x-gnostic-name extension specifies, or an empty string if there is none.
+func nameForExtensions(extensions []*openapiv3.NamedAny) string {
+	for _, pair := range extensions {
+		if pair.Name == nameExtension {
+			return enumValue(pair.Value.GetYaml())
+		}
+	}
+	return ""
+}
+
+// constraintsForSchema returns the constraints on the values of a schema, or nil if there is no schema.
+func (b *OpenAPI3Builder) constraintsForSchema(schema *openapiv3.Schema) *Constraints {
+	if schema == nil {
+		return nil
+	}
+	c := b.bounds.constraintsForValue(schema)
+	c.Nullable = schema.Nullable
+	c.ReadOnly = schema.ReadOnly
+	return c
+}
+
+// enumValuesForSchema returns the allowed values of a schema.
+func enumValuesForSchema(schema *openapiv3.Schema) []string {
+	values := make([]string, 0)
+	for _, value := range schema.Enum {
+		values = append(values, enumValue(value.Yaml))
+	}
+	return values
+}
+
+// typeForItems determines the type of the items of an array.
+// If the items are arrays or maps, a named type is added to the model to describe them.
+func (b *OpenAPI3Builder) typeForItems(name string, items *openapiv3.ItemsItem) (typeName, format string) {
+	if items == nil || len(items.SchemaOrReference) == 0 {
+		return "object", ""
+	}
+	return b.typeForElement(name, items.SchemaOrReference[0])
+}
+
+// typeForAdditionalProperties determines the type of the values of a map.
+// If the values are arrays or maps, a named type is added to the model to describe them.
+func (b *OpenAPI3Builder) typeForAdditionalProperties(name string, additionalProperties *openapiv3.AdditionalPropertiesItem) (typeName, format string) {
+	if additionalProperties.GetSchemaOrReference() == nil {
+		return "object", ""
+	}
+	return b.typeForElement(name, additionalProperties.GetSchemaOrReference())
+}
+
+// typeForElement determines the type of an element of an array or map.
+func (b *OpenAPI3Builder) typeForElement(name string, value *openapiv3.SchemaOrReference) (typeName, format string) {
+	kind, typeName, format := b.typeForSchemaOrReference(name, value)
+	switch kind {
+	case FieldKind_ARRAY:
+		name = b.names.typeName(name)
+		b.model.addType(&Type{
+			Name:        name,
+			Kind:        TypeKind_LIST,
+			Description: name + " is a list of " + typeName,
+			ContentType: typeName,
+		})
+		return name, ""
+	case FieldKind_MAP:
+		name = b.names.typeName(name)
+		b.model.addType(&Type{
+			Name:        name,
+			Kind:        TypeKind_OBJECT,
+			Description: name + " is a map of " + typeName,
+			ContentType: typeName,
+		})
+		return name, ""
+	default:
+		return typeName, format
+	}
+}
@@ -0,0 +1,28 @@
+package surface_v1
+
+import (
+	"testing"
+
+	openapiv3 "github.com/googleapis/gnostic/OpenAPIv3"
+	"github.com/googleapis/gnostic/compiler"
+)
+
+func TestOpenAPI3ExternalReferences(t *testing.T) {
+	// the document refers to a file next to it, which isn't in the current directory
+	filename := "testdata/external/openapi.yaml"
+	info, err := compiler.ReadInfoForFile(filename)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	document, err := openapiv3.NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	model, _, err := NewModelFromOpenAPI3WithInfo(document, filename, info)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	pet := typeNamed(t, model, "Pet")
+	fieldNamed(t, pet, "kind")
+	fieldNamed(t, pet, "name")
+}
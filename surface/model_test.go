@@ -0,0 +1,82 @@
+package surface_v1
+
+import (
+	"testing"
+
+	openapiv2 "github.com/googleapis/gnostic/OpenAPIv2"
+	openapiv3 "github.com/googleapis/gnostic/OpenAPIv3"
+	"github.com/googleapis/gnostic/compiler"
+	discovery "github.com/googleapis/gnostic/discovery"
+)
+
+// readInfo parses YAML or JSON text. The text has no filename, so the reader doesn't cache it.
+func readInfo(t *testing.T, text string) interface{} {
+	info, err := compiler.ReadInfoFromBytes("", []byte(text))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return info
+}
+
+// buildOpenAPI2 builds a model and its warnings from the text of an OpenAPI v2 document.
+func buildOpenAPI2(t *testing.T, text string) (*Model, []*Warning) {
+	info := readInfo(t, text)
+	document, err := openapiv2.NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	model, warnings, err := NewModelFromOpenAPI2WithInfo(document, info)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return model, warnings
+}
+
+// buildOpenAPI3 builds a model and its warnings from the text of an OpenAPI v3 document.
+func buildOpenAPI3(t *testing.T, text string) (*Model, []*Warning) {
+	info := readInfo(t, text)
+	document, err := openapiv3.NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	model, warnings, err := NewModelFromOpenAPI3WithInfo(document, "", info)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return model, warnings
+}
+
+// buildDiscovery builds a model and its warnings from the text of a Discovery document.
+func buildDiscovery(t *testing.T, text string) (*Model, []*Warning) {
+	document, err := discovery.NewDocument(readInfo(t, text), compiler.NewContext("$root", nil))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	model, warnings, err := NewModelFromDiscoveryWithWarnings(document)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return model, warnings
+}
+
+// typeNamed returns the type of a model with the specified name, or fails the test if there is none.
+func typeNamed(t *testing.T, model *Model, name string) *Type {
+	for _, typ := range model.Types {
+		if typ.Name == name {
+			return typ
+		}
+	}
+	t.Fatalf("there is no type named %s", name)
+	return nil
+}
+
+// fieldNamed returns the field of a type with the specified name, or fails the test if there is none.
+func fieldNamed(t *testing.T, typ *Type, name string) *Field {
+	for _, field := range typ.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	t.Fatalf("type %s has no field named %s", typ.Name, name)
+	return nil
+}
@@ -0,0 +1,270 @@
+package surface_v1
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestIdentifier(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		expected string
+	}{
+		{name: "ListPets", expected: "ListPets"},
+		{name: "pets list", expected: "PetsList"},
+		{name: "/pets-list", expected: "PetsList"},
+		{name: "GET_pets-list", expected: "GET_petsList"},
+		{name: "pets.list", expected: "PetsList"},
+		{name: "2fa", expected: "_2fa"},
+		{name: "", expected: "_"},
+	} {
+		if name := identifier(test.name); name != test.expected {
+			t.Errorf("identifier(%q) is %q, expected %q", test.name, name, test.expected)
+		}
+	}
+}
+
+func TestTypeIdentifier(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		expected string
+	}{
+		{name: "Pet", expected: "Pet"},
+		{name: "pet", expected: "Pet"},
+		{name: "pet-owner", expected: "PetOwner"},
+		{name: "io.k8s.api.Pod", expected: "Pod"},
+		{name: "models/Pet", expected: "Pet"},
+		{name: "version.", expected: "Version"},
+	} {
+		if name := typeIdentifier(test.name); name != test.expected {
+			t.Errorf("typeIdentifier(%q) is %q, expected %q", test.name, name, test.expected)
+		}
+	}
+}
+
+// methodNames returns the names of the methods of a model in sorted order.
+func methodNames(model *Model) []string {
+	names := make([]string, 0)
+	for _, m := range model.Methods {
+		names = append(names, m.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// typeNames returns the names of the types of a model in sorted order.
+func typeNames(model *Model) []string {
+	names := make([]string, 0)
+	for _, t := range model.Types {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// collisions returns the key paths of the name collisions of a set of warnings.
+func collisions(warnings []*Warning) []string {
+	paths := make([]string, 0)
+	for _, w := range warnings {
+		if w.Code == WarningNameCollision {
+			paths = append(paths, strings.Join(w.Keys, "."))
+		}
+	}
+	return paths
+}
+
+func TestOpenAPI3Names(t *testing.T) {
+	for _, test := range []struct {
+		description string
+		paths       string
+		schemas     string
+		methods     []string
+		types       []string
+		collisions  []string
+	}{
+		{
+			description: "operation ids are reduced to identifiers",
+			paths: `
+  /pets:
+    get:
+      operationId: pets list
+      responses:
+        "204":
+          description: no pets`,
+			methods:    []string{"PetsList"},
+			types:      []string{},
+			collisions: []string{},
+		},
+		{
+			description: "names of operations without ids are reduced to identifiers",
+			paths: `
+  /pets-list:
+    get:
+      responses:
+        "204":
+          description: no pets`,
+			methods:    []string{"GET_petsList"},
+			types:      []string{},
+			collisions: []string{},
+		},
+		{
+			description: "methods with names that reduce to the same identifier collide",
+			paths: `
+  /pets:
+    get:
+      operationId: pets list
+      responses:
+        "204":
+          description: no pets
+  /pets-list:
+    get:
+      operationId: pets-list
+      responses:
+        "204":
+          description: no pets`,
+			methods:    []string{"PetsList", "PetsList2"},
+			types:      []string{},
+			collisions: []string{"paths./pets-list.get"},
+		},
+		{
+			description: "schemas with names that reduce to the same identifier collide",
+			schemas: `
+    a.Pet:
+      type: object
+      properties:
+        owner:
+          $ref: '#/components/schemas/b.Pet'
+    b.Pet:
+      type: object
+      properties:
+        name:
+          type: string`,
+			types:      []string{"Pet", "Pet2"},
+			collisions: []string{"components.schemas.b.Pet"},
+		},
+		{
+			description: "types that are named by the builder don't take the names of schemas",
+			paths: `
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: all pets
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/ListPetsResponses'`,
+			schemas: `
+    ListPetsResponses:
+      type: object
+      properties:
+        count:
+          type: integer`,
+			methods:    []string{"ListPets"},
+			types:      []string{"ListPetsResponses", "ListPetsResponses2"},
+			collisions: []string{"paths./pets.get"},
+		},
+		{
+			description: "x-gnostic-name renames methods and types",
+			paths: `
+  /pets:
+    get:
+      operationId: listPets
+      x-gnostic-name: fetch pets
+      responses:
+        "204":
+          description: no pets`,
+			schemas: `
+    Pet:
+      type: object
+      x-gnostic-name: Animal
+      properties:
+        name:
+          type: string`,
+			methods:    []string{"FetchPets"},
+			types:      []string{"Animal"},
+			collisions: []string{},
+		},
+		{
+			description: "names that are specified with x-gnostic-name can collide",
+			schemas: `
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+    Dog:
+      type: object
+      x-gnostic-name: Pet
+      properties:
+        name:
+          type: string`,
+			types:      []string{"Pet", "Pet2"},
+			collisions: []string{"components.schemas.Dog.x-gnostic-name"},
+		},
+	} {
+		text := "openapi: 3.0.0\ninfo:\n  title: Names\n  version: 1.0.0\npaths:"
+		if test.paths != "" {
+			text += test.paths
+		} else {
+			text += " {}"
+		}
+		if test.schemas != "" {
+			text += "\ncomponents:\n  schemas:" + test.schemas
+		}
+		text += "\n"
+		model, warnings := buildOpenAPI3(t, text)
+		if test.methods == nil {
+			test.methods = []string{}
+		}
+		if names := methodNames(model); !equalStrings(names, test.methods) {
+			t.Errorf("%s: methods are %v, expected %v", test.description, names, test.methods)
+		}
+		if names := typeNames(model); !equalStrings(names, test.types) {
+			t.Errorf("%s: types are %v, expected %v", test.description, names, test.types)
+		}
+		if paths := collisions(warnings); !equalStrings(paths, test.collisions) {
+			t.Errorf("%s: collisions are at %v, expected %v", test.description, paths, test.collisions)
+		}
+	}
+}
+
+func TestRenamedReferences(t *testing.T) {
+	model, _ := buildOpenAPI3(t, `
+openapi: 3.0.0
+info:
+  title: Names
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    io.k8s.Pod:
+      type: object
+      properties:
+        owner:
+          $ref: '#/components/schemas/pet-owner'
+    pet-owner:
+      type: object
+      x-gnostic-name: Owner
+      properties:
+        name:
+          type: string
+`)
+	if field := fieldNamed(t, typeNamed(t, model, "Pod"), "owner"); field.Type != "Owner" {
+		t.Errorf("owner has type %s, expected Owner", field.Type)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
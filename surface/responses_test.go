@@ -0,0 +1,135 @@
+package surface_v1
+
+import (
+	"testing"
+)
+
+// responseKindTest is a field of a responses type and the kind and type that are expected for it.
+type responseKindTest struct {
+	field    string
+	kind     FieldKind
+	typeName string
+}
+
+func testResponseKinds(t *testing.T, typ *Type, tests []responseKindTest) {
+	for _, test := range tests {
+		f := fieldNamed(t, typ, test.field)
+		if f.Kind != test.kind || f.Type != test.typeName {
+			t.Errorf("response %s has kind %v and type %s, expected %v and %s",
+				test.field, f.Kind, f.Type, test.kind, test.typeName)
+		}
+	}
+}
+
+func TestOpenAPI3ResponseKinds(t *testing.T) {
+	model, _ := buildOpenAPI3(t, `
+openapi: 3.0.0
+info:
+  title: Responses
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: all pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+        "400":
+          description: the problems with the request
+          content:
+            application/json:
+              schema:
+                type: object
+                additionalProperties:
+                  type: string
+        default:
+          description: an error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    post:
+      operationId: createPet
+      responses:
+        "201":
+          description: the pet was created
+          headers:
+            Location:
+              schema:
+                type: string
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`)
+	testResponseKinds(t, typeNamed(t, model, "ListPetsResponses"), []responseKindTest{
+		{field: "200", kind: FieldKind_ARRAY, typeName: "Pet"},
+		{field: "400", kind: FieldKind_MAP, typeName: "string"},
+		{field: "default", kind: FieldKind_REFERENCE, typeName: "Pet"},
+	})
+	// methods with responses that only have headers also have responses types.
+	if typ := typeNamed(t, model, "CreatePetResponses"); len(typ.Fields) != 0 {
+		t.Errorf("CreatePetResponses has %d fields, expected none", len(typ.Fields))
+	}
+}
+
+func TestOpenAPI2ResponseKinds(t *testing.T) {
+	model, _ := buildOpenAPI2(t, `
+swagger: "2.0"
+info:
+  title: Responses
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: all pets
+          schema:
+            type: array
+            items:
+              $ref: '#/definitions/Pet'
+        "400":
+          description: the problems with the request
+          schema:
+            type: object
+            additionalProperties:
+              type: string
+        default:
+          description: an error
+          schema:
+            $ref: '#/definitions/Pet'
+    post:
+      operationId: createPet
+      responses:
+        "201":
+          description: the pet was created
+          headers:
+            Location:
+              type: string
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+`)
+	testResponseKinds(t, typeNamed(t, model, "ListPetsResponses"), []responseKindTest{
+		{field: "200", kind: FieldKind_ARRAY, typeName: "Pet"},
+		{field: "400", kind: FieldKind_MAP, typeName: "string"},
+		{field: "default", kind: FieldKind_REFERENCE, typeName: "Pet"},
+	})
+	if typ := typeNamed(t, model, "CreatePetResponses"); len(typ.Fields) != 0 {
+		t.Errorf("CreatePetResponses has %d fields, expected none", len(typ.Fields))
+	}
+}
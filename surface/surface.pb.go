@@ -10,8 +10,22 @@ It is generated from these files:
 
 It has these top-level messages:
 	Field
+	Constraints
 	Type
+	Variant
 	Method
+	ExternalDocs
+	Extension
+	RequestBody
+	Encoding
+	Response
+	SecurityScheme
+	OAuthFlow
+	SecurityRequirement
+	RequiredScheme
+	Server
+	ServerVariable
+	MediaUpload
 	Model
 */
 package surface_v1
@@ -63,15 +77,24 @@ type TypeKind int32
 const (
 	TypeKind_STRUCT TypeKind = 0
 	TypeKind_OBJECT TypeKind = 1
+	TypeKind_LIST   TypeKind = 2
+	TypeKind_ENUM   TypeKind = 3
+	TypeKind_UNION  TypeKind = 4
 )
 
 var TypeKind_name = map[int32]string{
 	0: "STRUCT",
 	1: "OBJECT",
+	2: "LIST",
+	3: "ENUM",
+	4: "UNION",
 }
 var TypeKind_value = map[string]int32{
 	"STRUCT": 0,
 	"OBJECT": 1,
+	"LIST":   2,
+	"ENUM":   3,
+	"UNION":  4,
 }
 
 func (x TypeKind) String() string {
@@ -87,6 +110,7 @@ const (
 	Position_FORMDATA Position = 2
 	Position_QUERY    Position = 3
 	Position_PATH     Position = 4
+	Position_COOKIE   Position = 5
 )
 
 var Position_name = map[int32]string{
@@ -95,6 +119,7 @@ var Position_name = map[int32]string{
 	2: "FORMDATA",
 	3: "QUERY",
 	4: "PATH",
+	5: "COOKIE",
 }
 var Position_value = map[string]int32{
 	"BODY":     0,
@@ -102,6 +127,7 @@ var Position_value = map[string]int32{
 	"FORMDATA": 2,
 	"QUERY":    3,
 	"PATH":     4,
+	"COOKIE":   5,
 }
 
 func (x Position) String() string {
@@ -109,18 +135,49 @@ func (x Position) String() string {
 }
 func (Position) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
+type SecuritySchemeType int32
+
+const (
+	SecuritySchemeType_API_KEY         SecuritySchemeType = 0
+	SecuritySchemeType_HTTP            SecuritySchemeType = 1
+	SecuritySchemeType_OAUTH2          SecuritySchemeType = 2
+	SecuritySchemeType_OPEN_ID_CONNECT SecuritySchemeType = 3
+)
+
+var SecuritySchemeType_name = map[int32]string{
+	0: "API_KEY",
+	1: "HTTP",
+	2: "OAUTH2",
+	3: "OPEN_ID_CONNECT",
+}
+var SecuritySchemeType_value = map[string]int32{
+	"API_KEY":         0,
+	"HTTP":            1,
+	"OAUTH2":          2,
+	"OPEN_ID_CONNECT": 3,
+}
+
+func (x SecuritySchemeType) String() string {
+	return proto.EnumName(SecuritySchemeType_name, int32(x))
+}
+func (SecuritySchemeType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
 // Field is a field in a definition and can be associated with
 // a position in a request structure.
 type Field struct {
-	Name          string    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Type          string    `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
-	Kind          FieldKind `protobuf:"varint,3,opt,name=kind,enum=surface.v1.FieldKind" json:"kind,omitempty"`
-	Format        string    `protobuf:"bytes,4,opt,name=format" json:"format,omitempty"`
-	Position      Position  `protobuf:"varint,5,opt,name=position,enum=surface.v1.Position" json:"position,omitempty"`
-	NativeType    string    `protobuf:"bytes,6,opt,name=nativeType" json:"nativeType,omitempty"`
-	FieldName     string    `protobuf:"bytes,7,opt,name=fieldName" json:"fieldName,omitempty"`
-	ParameterName string    `protobuf:"bytes,8,opt,name=parameterName" json:"parameterName,omitempty"`
-	Serialize     bool      `protobuf:"varint,9,opt,name=serialize" json:"serialize,omitempty"`
+	Name          string       `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type          string       `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Kind          FieldKind    `protobuf:"varint,3,opt,name=kind,enum=surface.v1.FieldKind" json:"kind,omitempty"`
+	Format        string       `protobuf:"bytes,4,opt,name=format" json:"format,omitempty"`
+	Position      Position     `protobuf:"varint,5,opt,name=position,enum=surface.v1.Position" json:"position,omitempty"`
+	NativeType    string       `protobuf:"bytes,6,opt,name=nativeType" json:"nativeType,omitempty"`
+	FieldName     string       `protobuf:"bytes,7,opt,name=fieldName" json:"fieldName,omitempty"`
+	ParameterName string       `protobuf:"bytes,8,opt,name=parameterName" json:"parameterName,omitempty"`
+	Serialize     bool         `protobuf:"varint,9,opt,name=serialize" json:"serialize,omitempty"`
+	Constraints   *Constraints `protobuf:"bytes,10,opt,name=constraints" json:"constraints,omitempty"`
+	MediaType     string       `protobuf:"bytes,11,opt,name=mediaType" json:"mediaType,omitempty"`
+	Style         string       `protobuf:"bytes,12,opt,name=style" json:"style,omitempty"`
+	Explode       bool         `protobuf:"varint,13,opt,name=explode" json:"explode,omitempty"`
 }
 
 func (m *Field) Reset()                    { *m = Field{} }
@@ -191,21 +248,190 @@ func (m *Field) GetSerialize() bool {
 	return false
 }
 
+func (m *Field) GetConstraints() *Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+func (m *Field) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+func (m *Field) GetStyle() string {
+	if m != nil {
+		return m.Style
+	}
+	return ""
+}
+
+func (m *Field) GetExplode() bool {
+	if m != nil {
+		return m.Explode
+	}
+	return false
+}
+
+// Constraints restrict the values of a field.
+// Numeric constraints are zero if they are not specified.
+type Constraints struct {
+	Required         bool    `protobuf:"varint,1,opt,name=required" json:"required,omitempty"`
+	Nullable         bool    `protobuf:"varint,2,opt,name=nullable" json:"nullable,omitempty"`
+	MinLength        int64   `protobuf:"varint,3,opt,name=minLength" json:"minLength,omitempty"`
+	MaxLength        int64   `protobuf:"varint,4,opt,name=maxLength" json:"maxLength,omitempty"`
+	Pattern          string  `protobuf:"bytes,5,opt,name=pattern" json:"pattern,omitempty"`
+	Minimum          float64 `protobuf:"fixed64,6,opt,name=minimum" json:"minimum,omitempty"`
+	ExclusiveMinimum bool    `protobuf:"varint,7,opt,name=exclusiveMinimum" json:"exclusiveMinimum,omitempty"`
+	HasMinimum       bool    `protobuf:"varint,14,opt,name=hasMinimum" json:"hasMinimum,omitempty"`
+	Maximum          float64 `protobuf:"fixed64,8,opt,name=maximum" json:"maximum,omitempty"`
+	ExclusiveMaximum bool    `protobuf:"varint,9,opt,name=exclusiveMaximum" json:"exclusiveMaximum,omitempty"`
+	HasMaximum       bool    `protobuf:"varint,15,opt,name=hasMaximum" json:"hasMaximum,omitempty"`
+	MultipleOf       float64 `protobuf:"fixed64,10,opt,name=multipleOf" json:"multipleOf,omitempty"`
+	MinItems         int64   `protobuf:"varint,11,opt,name=minItems" json:"minItems,omitempty"`
+	MaxItems         int64   `protobuf:"varint,12,opt,name=maxItems" json:"maxItems,omitempty"`
+	UniqueItems      bool    `protobuf:"varint,13,opt,name=uniqueItems" json:"uniqueItems,omitempty"`
+	ReadOnly         bool    `protobuf:"varint,16,opt,name=readOnly" json:"readOnly,omitempty"`
+}
+
+func (m *Constraints) Reset()                    { *m = Constraints{} }
+func (m *Constraints) String() string            { return proto.CompactTextString(m) }
+func (*Constraints) ProtoMessage()               {}
+func (*Constraints) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Constraints) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *Constraints) GetNullable() bool {
+	if m != nil {
+		return m.Nullable
+	}
+	return false
+}
+
+func (m *Constraints) GetMinLength() int64 {
+	if m != nil {
+		return m.MinLength
+	}
+	return 0
+}
+
+func (m *Constraints) GetMaxLength() int64 {
+	if m != nil {
+		return m.MaxLength
+	}
+	return 0
+}
+
+func (m *Constraints) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *Constraints) GetMinimum() float64 {
+	if m != nil {
+		return m.Minimum
+	}
+	return 0
+}
+
+func (m *Constraints) GetExclusiveMinimum() bool {
+	if m != nil {
+		return m.ExclusiveMinimum
+	}
+	return false
+}
+
+func (m *Constraints) GetHasMinimum() bool {
+	if m != nil {
+		return m.HasMinimum
+	}
+	return false
+}
+
+func (m *Constraints) GetMaximum() float64 {
+	if m != nil {
+		return m.Maximum
+	}
+	return 0
+}
+
+func (m *Constraints) GetExclusiveMaximum() bool {
+	if m != nil {
+		return m.ExclusiveMaximum
+	}
+	return false
+}
+
+func (m *Constraints) GetHasMaximum() bool {
+	if m != nil {
+		return m.HasMaximum
+	}
+	return false
+}
+
+func (m *Constraints) GetMultipleOf() float64 {
+	if m != nil {
+		return m.MultipleOf
+	}
+	return 0
+}
+
+func (m *Constraints) GetMinItems() int64 {
+	if m != nil {
+		return m.MinItems
+	}
+	return 0
+}
+
+func (m *Constraints) GetMaxItems() int64 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *Constraints) GetUniqueItems() bool {
+	if m != nil {
+		return m.UniqueItems
+	}
+	return false
+}
+
+func (m *Constraints) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
 // Type typically corresponds to a definition, parameter, or response
 // in an API and is represented by a type in generated code.
 type Type struct {
-	Name        string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Kind        TypeKind `protobuf:"varint,2,opt,name=kind,enum=surface.v1.TypeKind" json:"kind,omitempty"`
-	Description string   `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
-	ContentType string   `protobuf:"bytes,4,opt,name=contentType" json:"contentType,omitempty"`
-	Fields      []*Field `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
-	TypeName    string   `protobuf:"bytes,6,opt,name=typeName" json:"typeName,omitempty"`
+	Name          string     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Kind          TypeKind   `protobuf:"varint,2,opt,name=kind,enum=surface.v1.TypeKind" json:"kind,omitempty"`
+	Description   string     `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	ContentType   string     `protobuf:"bytes,4,opt,name=contentType" json:"contentType,omitempty"`
+	Fields        []*Field   `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
+	EnumValues    []string   `protobuf:"bytes,7,rep,name=enumValues" json:"enumValues,omitempty"`
+	Variants      []*Variant `protobuf:"bytes,8,rep,name=variants" json:"variants,omitempty"`
+	Discriminator string     `protobuf:"bytes,9,opt,name=discriminator" json:"discriminator,omitempty"`
+	TypeName      string     `protobuf:"bytes,6,opt,name=typeName" json:"typeName,omitempty"`
 }
 
 func (m *Type) Reset()                    { *m = Type{} }
 func (m *Type) String() string            { return proto.CompactTextString(m) }
 func (*Type) ProtoMessage()               {}
-func (*Type) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Type) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *Type) GetName() string {
 	if m != nil {
@@ -242,6 +468,27 @@ func (m *Type) GetFields() []*Field {
 	return nil
 }
 
+func (m *Type) GetEnumValues() []string {
+	if m != nil {
+		return m.EnumValues
+	}
+	return nil
+}
+
+func (m *Type) GetVariants() []*Variant {
+	if m != nil {
+		return m.Variants
+	}
+	return nil
+}
+
+func (m *Type) GetDiscriminator() string {
+	if m != nil {
+		return m.Discriminator
+	}
+	return ""
+}
+
 func (m *Type) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
@@ -249,24 +496,58 @@ func (m *Type) GetTypeName() string {
 	return ""
 }
 
+// Variant is one of the types that a union can have.
+type Variant struct {
+	Type                string   `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	DiscriminatorValues []string `protobuf:"bytes,2,rep,name=discriminatorValues" json:"discriminatorValues,omitempty"`
+}
+
+func (m *Variant) Reset()                    { *m = Variant{} }
+func (m *Variant) String() string            { return proto.CompactTextString(m) }
+func (*Variant) ProtoMessage()               {}
+func (*Variant) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Variant) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Variant) GetDiscriminatorValues() []string {
+	if m != nil {
+		return m.DiscriminatorValues
+	}
+	return nil
+}
+
 // Method is an operation of an API and typically has associated client and server code.
 type Method struct {
-	Operation          string `protobuf:"bytes,1,opt,name=operation" json:"operation,omitempty"`
-	Path               string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
-	Method             string `protobuf:"bytes,3,opt,name=method" json:"method,omitempty"`
-	Description        string `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
-	Name               string `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
-	HandlerName        string `protobuf:"bytes,6,opt,name=handlerName" json:"handlerName,omitempty"`
-	ProcessorName      string `protobuf:"bytes,7,opt,name=processorName" json:"processorName,omitempty"`
-	ClientName         string `protobuf:"bytes,8,opt,name=clientName" json:"clientName,omitempty"`
-	ParametersTypeName string `protobuf:"bytes,9,opt,name=parametersTypeName" json:"parametersTypeName,omitempty"`
-	ResponsesTypeName  string `protobuf:"bytes,10,opt,name=responsesTypeName" json:"responsesTypeName,omitempty"`
+	Operation            string                 `protobuf:"bytes,1,opt,name=operation" json:"operation,omitempty"`
+	Path                 string                 `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method" json:"method,omitempty"`
+	Description          string                 `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Name                 string                 `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	HandlerName          string                 `protobuf:"bytes,6,opt,name=handlerName" json:"handlerName,omitempty"`
+	ProcessorName        string                 `protobuf:"bytes,7,opt,name=processorName" json:"processorName,omitempty"`
+	ClientName           string                 `protobuf:"bytes,8,opt,name=clientName" json:"clientName,omitempty"`
+	ParametersTypeName   string                 `protobuf:"bytes,9,opt,name=parametersTypeName" json:"parametersTypeName,omitempty"`
+	ResponsesTypeName    string                 `protobuf:"bytes,10,opt,name=responsesTypeName" json:"responsesTypeName,omitempty"`
+	MediaUpload          *MediaUpload           `protobuf:"bytes,11,opt,name=mediaUpload" json:"mediaUpload,omitempty"`
+	Responses            []*Response            `protobuf:"bytes,12,rep,name=responses" json:"responses,omitempty"`
+	RequestBodies        []*RequestBody         `protobuf:"bytes,13,rep,name=requestBodies" json:"requestBodies,omitempty"`
+	SecurityRequirements []*SecurityRequirement `protobuf:"bytes,14,rep,name=securityRequirements" json:"securityRequirements,omitempty"`
+	Summary              string                 `protobuf:"bytes,15,opt,name=summary" json:"summary,omitempty"`
+	Tags                 []string               `protobuf:"bytes,16,rep,name=tags" json:"tags,omitempty"`
+	Deprecated           bool                   `protobuf:"varint,17,opt,name=deprecated" json:"deprecated,omitempty"`
+	ExternalDocs         *ExternalDocs          `protobuf:"bytes,18,opt,name=externalDocs" json:"externalDocs,omitempty"`
+	Extensions           []*Extension           `protobuf:"bytes,19,rep,name=extensions" json:"extensions,omitempty"`
 }
 
 func (m *Method) Reset()                    { *m = Method{} }
 func (m *Method) String() string            { return proto.CompactTextString(m) }
 func (*Method) ProtoMessage()               {}
-func (*Method) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*Method) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Method) GetOperation() string {
 	if m != nil {
@@ -338,17 +619,606 @@ func (m *Method) GetResponsesTypeName() string {
 	return ""
 }
 
+func (m *Method) GetMediaUpload() *MediaUpload {
+	if m != nil {
+		return m.MediaUpload
+	}
+	return nil
+}
+
+func (m *Method) GetResponses() []*Response {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *Method) GetRequestBodies() []*RequestBody {
+	if m != nil {
+		return m.RequestBodies
+	}
+	return nil
+}
+
+func (m *Method) GetSecurityRequirements() []*SecurityRequirement {
+	if m != nil {
+		return m.SecurityRequirements
+	}
+	return nil
+}
+
+func (m *Method) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Method) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Method) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *Method) GetExternalDocs() *ExternalDocs {
+	if m != nil {
+		return m.ExternalDocs
+	}
+	return nil
+}
+
+func (m *Method) GetExtensions() []*Extension {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+// ExternalDocs refers to documentation outside of an API description.
+type ExternalDocs struct {
+	Url         string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *ExternalDocs) Reset()                    { *m = ExternalDocs{} }
+func (m *ExternalDocs) String() string            { return proto.CompactTextString(m) }
+func (*ExternalDocs) ProtoMessage()               {}
+func (*ExternalDocs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ExternalDocs) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ExternalDocs) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// Extension is a specification extension ("x-" field) of an API description.
+type Extension struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Yaml string `protobuf:"bytes,2,opt,name=yaml" json:"yaml,omitempty"`
+}
+
+func (m *Extension) Reset()                    { *m = Extension{} }
+func (m *Extension) String() string            { return proto.CompactTextString(m) }
+func (*Extension) ProtoMessage()               {}
+func (*Extension) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Extension) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Extension) GetYaml() string {
+	if m != nil {
+		return m.Yaml
+	}
+	return ""
+}
+
+// RequestBody describes a representation of a request body that a method accepts.
+type RequestBody struct {
+	MediaType string      `protobuf:"bytes,1,opt,name=mediaType" json:"mediaType,omitempty"`
+	Kind      FieldKind   `protobuf:"varint,2,opt,name=kind,enum=surface.v1.FieldKind" json:"kind,omitempty"`
+	Type      string      `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	Format    string      `protobuf:"bytes,4,opt,name=format" json:"format,omitempty"`
+	Required  bool        `protobuf:"varint,5,opt,name=required" json:"required,omitempty"`
+	Encodings []*Encoding `protobuf:"bytes,6,rep,name=encodings" json:"encodings,omitempty"`
+}
+
+func (m *RequestBody) Reset()                    { *m = RequestBody{} }
+func (m *RequestBody) String() string            { return proto.CompactTextString(m) }
+func (*RequestBody) ProtoMessage()               {}
+func (*RequestBody) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *RequestBody) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+func (m *RequestBody) GetKind() FieldKind {
+	if m != nil {
+		return m.Kind
+	}
+	return FieldKind_SCALAR
+}
+
+func (m *RequestBody) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RequestBody) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *RequestBody) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *RequestBody) GetEncodings() []*Encoding {
+	if m != nil {
+		return m.Encodings
+	}
+	return nil
+}
+
+// Encoding describes how a property of a form or multipart body is encoded.
+type Encoding struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	ContentType string   `protobuf:"bytes,2,opt,name=contentType" json:"contentType,omitempty"`
+	Headers     []*Field `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty"`
+	Style       string   `protobuf:"bytes,4,opt,name=style" json:"style,omitempty"`
+	Explode     bool     `protobuf:"varint,5,opt,name=explode" json:"explode,omitempty"`
+}
+
+func (m *Encoding) Reset()                    { *m = Encoding{} }
+func (m *Encoding) String() string            { return proto.CompactTextString(m) }
+func (*Encoding) ProtoMessage()               {}
+func (*Encoding) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *Encoding) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Encoding) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Encoding) GetHeaders() []*Field {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Encoding) GetStyle() string {
+	if m != nil {
+		return m.Style
+	}
+	return ""
+}
+
+func (m *Encoding) GetExplode() bool {
+	if m != nil {
+		return m.Explode
+	}
+	return false
+}
+
+// Response is a response that a method can return.
+type Response struct {
+	Code        string    `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	Description string    `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	MediaType   string    `protobuf:"bytes,3,opt,name=mediaType" json:"mediaType,omitempty"`
+	Kind        FieldKind `protobuf:"varint,4,opt,name=kind,enum=surface.v1.FieldKind" json:"kind,omitempty"`
+	Type        string    `protobuf:"bytes,5,opt,name=type" json:"type,omitempty"`
+	Format      string    `protobuf:"bytes,6,opt,name=format" json:"format,omitempty"`
+	Headers     []*Field  `protobuf:"bytes,7,rep,name=headers" json:"headers,omitempty"`
+	IsError     bool      `protobuf:"varint,8,opt,name=isError" json:"isError,omitempty"`
+}
+
+func (m *Response) Reset()                    { *m = Response{} }
+func (m *Response) String() string            { return proto.CompactTextString(m) }
+func (*Response) ProtoMessage()               {}
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Response) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Response) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Response) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+func (m *Response) GetKind() FieldKind {
+	if m != nil {
+		return m.Kind
+	}
+	return FieldKind_SCALAR
+}
+
+func (m *Response) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Response) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Response) GetHeaders() []*Field {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Response) GetIsError() bool {
+	if m != nil {
+		return m.IsError
+	}
+	return false
+}
+
+// SecurityScheme describes a way that clients of an API can be authenticated.
+type SecurityScheme struct {
+	Name             string             `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type             SecuritySchemeType `protobuf:"varint,2,opt,name=type,enum=surface.v1.SecuritySchemeType" json:"type,omitempty"`
+	Description      string             `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	ParameterName    string             `protobuf:"bytes,4,opt,name=parameterName" json:"parameterName,omitempty"`
+	Position         Position           `protobuf:"varint,5,opt,name=position,enum=surface.v1.Position" json:"position,omitempty"`
+	Scheme           string             `protobuf:"bytes,6,opt,name=scheme" json:"scheme,omitempty"`
+	BearerFormat     string             `protobuf:"bytes,7,opt,name=bearerFormat" json:"bearerFormat,omitempty"`
+	Flows            []*OAuthFlow       `protobuf:"bytes,8,rep,name=flows" json:"flows,omitempty"`
+	OpenIdConnectUrl string             `protobuf:"bytes,9,opt,name=openIdConnectUrl" json:"openIdConnectUrl,omitempty"`
+}
+
+func (m *SecurityScheme) Reset()                    { *m = SecurityScheme{} }
+func (m *SecurityScheme) String() string            { return proto.CompactTextString(m) }
+func (*SecurityScheme) ProtoMessage()               {}
+func (*SecurityScheme) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *SecurityScheme) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SecurityScheme) GetType() SecuritySchemeType {
+	if m != nil {
+		return m.Type
+	}
+	return SecuritySchemeType_API_KEY
+}
+
+func (m *SecurityScheme) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SecurityScheme) GetParameterName() string {
+	if m != nil {
+		return m.ParameterName
+	}
+	return ""
+}
+
+func (m *SecurityScheme) GetPosition() Position {
+	if m != nil {
+		return m.Position
+	}
+	return Position_BODY
+}
+
+func (m *SecurityScheme) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *SecurityScheme) GetBearerFormat() string {
+	if m != nil {
+		return m.BearerFormat
+	}
+	return ""
+}
+
+func (m *SecurityScheme) GetFlows() []*OAuthFlow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+func (m *SecurityScheme) GetOpenIdConnectUrl() string {
+	if m != nil {
+		return m.OpenIdConnectUrl
+	}
+	return ""
+}
+
+// OAuthFlow describes a way of getting an OAuth2 token.
+type OAuthFlow struct {
+	Type             string   `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	AuthorizationUrl string   `protobuf:"bytes,2,opt,name=authorizationUrl" json:"authorizationUrl,omitempty"`
+	TokenUrl         string   `protobuf:"bytes,3,opt,name=tokenUrl" json:"tokenUrl,omitempty"`
+	RefreshUrl       string   `protobuf:"bytes,4,opt,name=refreshUrl" json:"refreshUrl,omitempty"`
+	Scopes           []string `protobuf:"bytes,5,rep,name=scopes" json:"scopes,omitempty"`
+}
+
+func (m *OAuthFlow) Reset()                    { *m = OAuthFlow{} }
+func (m *OAuthFlow) String() string            { return proto.CompactTextString(m) }
+func (*OAuthFlow) ProtoMessage()               {}
+func (*OAuthFlow) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *OAuthFlow) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OAuthFlow) GetAuthorizationUrl() string {
+	if m != nil {
+		return m.AuthorizationUrl
+	}
+	return ""
+}
+
+func (m *OAuthFlow) GetTokenUrl() string {
+	if m != nil {
+		return m.TokenUrl
+	}
+	return ""
+}
+
+func (m *OAuthFlow) GetRefreshUrl() string {
+	if m != nil {
+		return m.RefreshUrl
+	}
+	return ""
+}
+
+func (m *OAuthFlow) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+// SecurityRequirement is a set of credentials that must all be sent with a request.
+type SecurityRequirement struct {
+	Schemes []*RequiredScheme `protobuf:"bytes,1,rep,name=schemes" json:"schemes,omitempty"`
+}
+
+func (m *SecurityRequirement) Reset()                    { *m = SecurityRequirement{} }
+func (m *SecurityRequirement) String() string            { return proto.CompactTextString(m) }
+func (*SecurityRequirement) ProtoMessage()               {}
+func (*SecurityRequirement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *SecurityRequirement) GetSchemes() []*RequiredScheme {
+	if m != nil {
+		return m.Schemes
+	}
+	return nil
+}
+
+// RequiredScheme names a security scheme and the scopes that a request needs with it.
+type RequiredScheme struct {
+	Name   string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes" json:"scopes,omitempty"`
+}
+
+func (m *RequiredScheme) Reset()                    { *m = RequiredScheme{} }
+func (m *RequiredScheme) String() string            { return proto.CompactTextString(m) }
+func (*RequiredScheme) ProtoMessage()               {}
+func (*RequiredScheme) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *RequiredScheme) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RequiredScheme) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+// Server is a location where an API is served.
+type Server struct {
+	Url         string            `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Description string            `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Variables   []*ServerVariable `protobuf:"bytes,3,rep,name=variables" json:"variables,omitempty"`
+}
+
+func (m *Server) Reset()                    { *m = Server{} }
+func (m *Server) String() string            { return proto.CompactTextString(m) }
+func (*Server) ProtoMessage()               {}
+func (*Server) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *Server) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Server) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Server) GetVariables() []*ServerVariable {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+// ServerVariable is a variable that can be substituted in the URL of a server.
+type ServerVariable struct {
+	Name         string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	DefaultValue string   `protobuf:"bytes,2,opt,name=defaultValue" json:"defaultValue,omitempty"`
+	EnumValues   []string `protobuf:"bytes,3,rep,name=enumValues" json:"enumValues,omitempty"`
+	Description  string   `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *ServerVariable) Reset()                    { *m = ServerVariable{} }
+func (m *ServerVariable) String() string            { return proto.CompactTextString(m) }
+func (*ServerVariable) ProtoMessage()               {}
+func (*ServerVariable) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ServerVariable) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServerVariable) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
+func (m *ServerVariable) GetEnumValues() []string {
+	if m != nil {
+		return m.EnumValues
+	}
+	return nil
+}
+
+func (m *ServerVariable) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// MediaUpload describes how media is uploaded to a method.
+type MediaUpload struct {
+	Accept        []string `protobuf:"bytes,1,rep,name=accept" json:"accept,omitempty"`
+	MaxSize       string   `protobuf:"bytes,2,opt,name=maxSize" json:"maxSize,omitempty"`
+	SimplePath    string   `protobuf:"bytes,3,opt,name=simplePath" json:"simplePath,omitempty"`
+	ResumablePath string   `protobuf:"bytes,4,opt,name=resumablePath" json:"resumablePath,omitempty"`
+	Multipart     bool     `protobuf:"varint,5,opt,name=multipart" json:"multipart,omitempty"`
+}
+
+func (m *MediaUpload) Reset()                    { *m = MediaUpload{} }
+func (m *MediaUpload) String() string            { return proto.CompactTextString(m) }
+func (*MediaUpload) ProtoMessage()               {}
+func (*MediaUpload) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *MediaUpload) GetAccept() []string {
+	if m != nil {
+		return m.Accept
+	}
+	return nil
+}
+
+func (m *MediaUpload) GetMaxSize() string {
+	if m != nil {
+		return m.MaxSize
+	}
+	return ""
+}
+
+func (m *MediaUpload) GetSimplePath() string {
+	if m != nil {
+		return m.SimplePath
+	}
+	return ""
+}
+
+func (m *MediaUpload) GetResumablePath() string {
+	if m != nil {
+		return m.ResumablePath
+	}
+	return ""
+}
+
+func (m *MediaUpload) GetMultipart() bool {
+	if m != nil {
+		return m.Multipart
+	}
+	return false
+}
+
 // Model represents an API for code generation.
 type Model struct {
-	Name    string    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Types   []*Type   `protobuf:"bytes,2,rep,name=types" json:"types,omitempty"`
-	Methods []*Method `protobuf:"bytes,3,rep,name=methods" json:"methods,omitempty"`
+	Name            string            `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Types           []*Type           `protobuf:"bytes,2,rep,name=types" json:"types,omitempty"`
+	Methods         []*Method         `protobuf:"bytes,3,rep,name=methods" json:"methods,omitempty"`
+	SecuritySchemes []*SecurityScheme `protobuf:"bytes,4,rep,name=securitySchemes" json:"securitySchemes,omitempty"`
+	Servers         []*Server         `protobuf:"bytes,5,rep,name=servers" json:"servers,omitempty"`
 }
 
 func (m *Model) Reset()                    { *m = Model{} }
 func (m *Model) String() string            { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()               {}
-func (*Model) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Model) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *Model) GetName() string {
 	if m != nil {
@@ -371,54 +1241,154 @@ func (m *Model) GetMethods() []*Method {
 	return nil
 }
 
+func (m *Model) GetSecuritySchemes() []*SecurityScheme {
+	if m != nil {
+		return m.SecuritySchemes
+	}
+	return nil
+}
+
+func (m *Model) GetServers() []*Server {
+	if m != nil {
+		return m.Servers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Field)(nil), "surface.v1.Field")
+	proto.RegisterType((*Constraints)(nil), "surface.v1.Constraints")
 	proto.RegisterType((*Type)(nil), "surface.v1.Type")
+	proto.RegisterType((*Variant)(nil), "surface.v1.Variant")
 	proto.RegisterType((*Method)(nil), "surface.v1.Method")
+	proto.RegisterType((*ExternalDocs)(nil), "surface.v1.ExternalDocs")
+	proto.RegisterType((*Extension)(nil), "surface.v1.Extension")
+	proto.RegisterType((*RequestBody)(nil), "surface.v1.RequestBody")
+	proto.RegisterType((*Encoding)(nil), "surface.v1.Encoding")
+	proto.RegisterType((*Response)(nil), "surface.v1.Response")
+	proto.RegisterType((*SecurityScheme)(nil), "surface.v1.SecurityScheme")
+	proto.RegisterType((*OAuthFlow)(nil), "surface.v1.OAuthFlow")
+	proto.RegisterType((*SecurityRequirement)(nil), "surface.v1.SecurityRequirement")
+	proto.RegisterType((*RequiredScheme)(nil), "surface.v1.RequiredScheme")
+	proto.RegisterType((*Server)(nil), "surface.v1.Server")
+	proto.RegisterType((*ServerVariable)(nil), "surface.v1.ServerVariable")
+	proto.RegisterType((*MediaUpload)(nil), "surface.v1.MediaUpload")
 	proto.RegisterType((*Model)(nil), "surface.v1.Model")
 	proto.RegisterEnum("surface.v1.FieldKind", FieldKind_name, FieldKind_value)
 	proto.RegisterEnum("surface.v1.TypeKind", TypeKind_name, TypeKind_value)
 	proto.RegisterEnum("surface.v1.Position", Position_name, Position_value)
+	proto.RegisterEnum("surface.v1.SecuritySchemeType", SecuritySchemeType_name, SecuritySchemeType_value)
 }
 
 func init() { proto.RegisterFile("surface.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x5d, 0xfe, 0x36, 0xb9, 0xfd, 0xf5, 0xa7, 0xcc, 0x02, 0x14, 0x21, 0x84, 0xa2, 0x0a, 0xa1,
-	0xae, 0x9a, 0x2a, 0x18, 0x6f, 0xbc, 0x65, 0x6d, 0xaa, 0x09, 0xe8, 0x5a, 0x4c, 0xf6, 0xd0, 0xc7,
-	0xd0, 0xb8, 0x6a, 0x44, 0x1b, 0x87, 0x38, 0x4c, 0x82, 0x0f, 0xc4, 0xc7, 0x81, 0xaf, 0x84, 0x7c,
-	0x93, 0xb4, 0xde, 0xda, 0x37, 0xfb, 0xdc, 0xe3, 0x6b, 0x9f, 0x73, 0x6e, 0x02, 0x3d, 0xf1, 0xa3,
-	0x5c, 0x27, 0x2b, 0x36, 0x2a, 0x4a, 0x5e, 0x71, 0x02, 0xed, 0xf6, 0xfe, 0x6d, 0xff, 0xb7, 0x0e,
-	0xd6, 0x34, 0x63, 0xdb, 0x94, 0x10, 0x30, 0xf3, 0x64, 0xc7, 0x7c, 0x2d, 0xd0, 0x06, 0x2e, 0xc5,
-	0xb5, 0xc4, 0xaa, 0x9f, 0x05, 0xf3, 0xf5, 0x1a, 0x93, 0x6b, 0x72, 0x01, 0xe6, 0xb7, 0x2c, 0x4f,
-	0x7d, 0x23, 0xd0, 0x06, 0xff, 0x5f, 0x3d, 0x1d, 0x1d, 0x9a, 0x8d, 0xb0, 0xd1, 0xc7, 0x2c, 0x4f,
-	0x29, 0x52, 0xc8, 0x33, 0xb0, 0xd7, 0xbc, 0xdc, 0x25, 0x95, 0x6f, 0x62, 0x83, 0x66, 0x47, 0xde,
-	0x80, 0x53, 0x70, 0x91, 0x55, 0x19, 0xcf, 0x7d, 0x0b, 0xdb, 0x3c, 0x51, 0xdb, 0x2c, 0x9a, 0x1a,
-	0xdd, 0xb3, 0xc8, 0x4b, 0x80, 0x3c, 0xa9, 0xb2, 0x7b, 0x16, 0xcb, 0xe7, 0xd8, 0xd8, 0x4d, 0x41,
-	0xc8, 0x0b, 0x70, 0xd7, 0xf2, 0xf2, 0x5b, 0xa9, 0xa0, 0x83, 0xe5, 0x03, 0x40, 0x5e, 0x41, 0xaf,
-	0x48, 0xca, 0x64, 0xc7, 0x2a, 0x56, 0x22, 0xc3, 0x41, 0xc6, 0x43, 0x50, 0xf6, 0x10, 0xac, 0xcc,
-	0x92, 0x6d, 0xf6, 0x8b, 0xf9, 0x6e, 0xa0, 0x0d, 0x1c, 0x7a, 0x00, 0xfa, 0x7f, 0x35, 0x30, 0xf1,
-	0xaa, 0x53, 0x3e, 0x0d, 0x1a, 0x4f, 0xf4, 0x63, 0x31, 0xf2, 0x8c, 0x62, 0x49, 0x00, 0xdd, 0x94,
-	0x89, 0x55, 0x99, 0x15, 0xa8, 0xde, 0xc0, 0x26, 0x2a, 0x24, 0x19, 0x2b, 0x9e, 0x57, 0x2c, 0xaf,
-	0x50, 0x6b, 0xed, 0x9c, 0x0a, 0x91, 0x0b, 0xb0, 0x51, 0x9b, 0xf0, 0xad, 0xc0, 0x18, 0x74, 0xaf,
-	0xce, 0x8f, 0x32, 0xa0, 0x0d, 0x81, 0x3c, 0x07, 0x47, 0x86, 0x86, 0xa2, 0x6b, 0xd7, 0xf6, 0xfb,
-	0xfe, 0x1f, 0x1d, 0xec, 0x19, 0xab, 0x36, 0x3c, 0x95, 0xd2, 0x79, 0xc1, 0xca, 0x04, 0xdf, 0x54,
-	0x0b, 0x3b, 0x00, 0x52, 0x71, 0x91, 0x54, 0x9b, 0x76, 0x0a, 0xe4, 0x5a, 0x46, 0xbb, 0xc3, 0xb3,
-	0x8d, 0x84, 0x66, 0xf7, 0x58, 0x9f, 0x79, 0xac, 0xaf, 0xf5, 0xcf, 0x52, 0xfc, 0x0b, 0xa0, 0xbb,
-	0x49, 0xf2, 0x74, 0xdb, 0xc4, 0x53, 0xbf, 0x54, 0x85, 0x30, 0xc2, 0x92, 0xaf, 0x98, 0x10, 0xbc,
-	0x54, 0x42, 0x7e, 0x08, 0xca, 0x31, 0x59, 0x6d, 0x33, 0x96, 0x57, 0x4a, 0xca, 0x0a, 0x42, 0x46,
-	0x40, 0xf6, 0x99, 0x8b, 0xb8, 0x35, 0xc6, 0x45, 0xde, 0x89, 0x0a, 0xb9, 0x84, 0xf3, 0x92, 0x89,
-	0x82, 0xe7, 0x82, 0x1d, 0xe8, 0x80, 0xf4, 0xe3, 0x42, 0xff, 0x3b, 0x58, 0x33, 0x9e, 0xb2, 0xed,
-	0xc9, 0x11, 0x79, 0x0d, 0x96, 0x74, 0x5e, 0xf8, 0x3a, 0x66, 0xe6, 0x3d, 0x9e, 0x11, 0x5a, 0x97,
-	0xc9, 0x25, 0x74, 0x6a, 0x2b, 0x85, 0x6f, 0x20, 0x93, 0xa8, 0xcc, 0x3a, 0x2f, 0xda, 0x52, 0x86,
-	0xef, 0xc1, 0xdd, 0x7f, 0x74, 0x04, 0xc0, 0xfe, 0x32, 0x0e, 0x3f, 0x85, 0xd4, 0x3b, 0x23, 0x1d,
-	0x30, 0x66, 0xe1, 0xc2, 0xd3, 0x88, 0x0b, 0x56, 0x48, 0x69, 0xb8, 0xf4, 0x74, 0xd2, 0x03, 0x97,
-	0x46, 0xd3, 0x88, 0x46, 0xb7, 0xe3, 0xc8, 0x33, 0x86, 0x7d, 0x70, 0xda, 0xe1, 0xc4, 0xa3, 0x31,
-	0xbd, 0x1b, 0xc7, 0xde, 0x99, 0x5c, 0xcf, 0xaf, 0x3f, 0x44, 0xe3, 0xd8, 0xd3, 0x86, 0x63, 0x70,
-	0xda, 0xaf, 0x91, 0x38, 0x60, 0x5e, 0xcf, 0x27, 0xcb, 0x9a, 0x71, 0x13, 0x85, 0x93, 0x88, 0x7a,
-	0x1a, 0xf9, 0x0f, 0x9c, 0xe9, 0x9c, 0xce, 0x26, 0x61, 0x1c, 0x7a, 0xba, 0xbc, 0xed, 0xf3, 0x5d,
-	0x44, 0x97, 0x9e, 0x21, 0xe9, 0x8b, 0x30, 0xbe, 0xf1, 0xcc, 0xaf, 0x36, 0xfe, 0x76, 0xde, 0xfd,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x15, 0x52, 0x6a, 0x89, 0x87, 0x04, 0x00, 0x00,
+	// 1709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xef, 0x6e, 0xe4, 0x48,
+	0x11, 0x3f, 0x8f, 0xe7, 0x8f, 0x5d, 0x93, 0x64, 0xbd, 0x9d, 0xbd, 0xc3, 0x5a, 0xa1, 0x63, 0x64,
+	0x21, 0x94, 0xcd, 0x9d, 0x96, 0x63, 0x0e, 0x24, 0x40, 0xc7, 0x87, 0xd9, 0x64, 0xa2, 0x0d, 0xbb,
+	0xc9, 0x84, 0xce, 0x64, 0xa5, 0xfd, 0xb4, 0xea, 0xd8, 0x9d, 0x1d, 0xeb, 0xfc, 0xef, 0xba, 0xed,
+	0x5c, 0xb2, 0x8f, 0xc0, 0x67, 0xbe, 0x22, 0x3e, 0xf0, 0x00, 0xbc, 0x05, 0x0f, 0xc0, 0x3b, 0x20,
+	0x5e, 0x02, 0x24, 0xd4, 0xdd, 0xee, 0x71, 0x7b, 0x3c, 0x61, 0x81, 0x6f, 0x5d, 0x55, 0xbf, 0xae,
+	0xae, 0xa9, 0xea, 0xfa, 0x75, 0x79, 0x60, 0x97, 0x57, 0xec, 0x86, 0x84, 0xf4, 0x79, 0xc1, 0xf2,
+	0x32, 0x47, 0xa0, 0xc5, 0xdb, 0x9f, 0x05, 0x7f, 0xb1, 0x61, 0x70, 0x12, 0xd3, 0x24, 0x42, 0x08,
+	0xfa, 0x19, 0x49, 0xa9, 0x6f, 0x4d, 0xac, 0x03, 0x17, 0xcb, 0xb5, 0xd0, 0x95, 0xf7, 0x05, 0xf5,
+	0x7b, 0x4a, 0x27, 0xd6, 0xe8, 0x19, 0xf4, 0xbf, 0x8d, 0xb3, 0xc8, 0xb7, 0x27, 0xd6, 0xc1, 0xde,
+	0xf4, 0xd3, 0xe7, 0x8d, 0xb3, 0xe7, 0xd2, 0xd1, 0xab, 0x38, 0x8b, 0xb0, 0x84, 0xa0, 0xcf, 0x60,
+	0x78, 0x93, 0xb3, 0x94, 0x94, 0x7e, 0x5f, 0x3a, 0xa8, 0x25, 0xf4, 0x15, 0x38, 0x45, 0xce, 0xe3,
+	0x32, 0xce, 0x33, 0x7f, 0x20, 0xdd, 0x3c, 0x31, 0xdd, 0x5c, 0xd4, 0x36, 0xbc, 0x46, 0xa1, 0xcf,
+	0x01, 0x32, 0x52, 0xc6, 0xb7, 0x74, 0x29, 0xc2, 0x19, 0x4a, 0x6f, 0x86, 0x06, 0xfd, 0x10, 0xdc,
+	0x1b, 0x71, 0xf8, 0xb9, 0xf8, 0x05, 0x23, 0x69, 0x6e, 0x14, 0xe8, 0xc7, 0xb0, 0x5b, 0x10, 0x46,
+	0x52, 0x5a, 0x52, 0x26, 0x11, 0x8e, 0x44, 0xb4, 0x95, 0xc2, 0x07, 0xa7, 0x2c, 0x26, 0x49, 0xfc,
+	0x81, 0xfa, 0xee, 0xc4, 0x3a, 0x70, 0x70, 0xa3, 0x40, 0xbf, 0x82, 0x71, 0x98, 0x67, 0xbc, 0x64,
+	0x24, 0xce, 0x4a, 0xee, 0xc3, 0xc4, 0x3a, 0x18, 0x4f, 0x7f, 0x60, 0x86, 0x7d, 0xd4, 0x98, 0xb1,
+	0x89, 0x15, 0x8e, 0x53, 0x1a, 0xc5, 0x44, 0xc6, 0x3e, 0x56, 0xc1, 0xad, 0x15, 0xe8, 0x09, 0x0c,
+	0x78, 0x79, 0x9f, 0x50, 0x7f, 0x47, 0x5a, 0x94, 0x80, 0x7c, 0x18, 0xd1, 0xbb, 0x22, 0xc9, 0x23,
+	0xea, 0xef, 0xca, 0x50, 0xb4, 0x18, 0xfc, 0xcb, 0x86, 0xb1, 0x71, 0x14, 0x7a, 0x0a, 0x0e, 0xa3,
+	0xdf, 0x55, 0x31, 0xa3, 0x91, 0xac, 0x9d, 0x83, 0xd7, 0xb2, 0xb0, 0x65, 0x55, 0x92, 0x90, 0xeb,
+	0x44, 0xd5, 0xd0, 0xc1, 0x6b, 0x59, 0x46, 0x15, 0x67, 0xaf, 0x69, 0xf6, 0xbe, 0x5c, 0xc9, 0x62,
+	0xda, 0xb8, 0x51, 0x48, 0x2b, 0xb9, 0xab, 0xad, 0xfd, 0xda, 0xaa, 0x15, 0x22, 0xba, 0x82, 0x94,
+	0x25, 0x65, 0xaa, 0x7e, 0x2e, 0xd6, 0xa2, 0xb0, 0xa4, 0x71, 0x16, 0xa7, 0x55, 0x2a, 0xab, 0x64,
+	0x61, 0x2d, 0xa2, 0x43, 0xf0, 0xe8, 0x5d, 0x98, 0x54, 0x3c, 0xbe, 0xa5, 0x67, 0x35, 0x64, 0x24,
+	0x63, 0xea, 0xe8, 0x45, 0xb9, 0x57, 0x84, 0x6b, 0xd4, 0x9e, 0x44, 0x19, 0x1a, 0x79, 0x0a, 0xb9,
+	0x93, 0x46, 0xa7, 0x3e, 0x85, 0xdc, 0x75, 0x4f, 0xa9, 0x21, 0xee, 0xe6, 0x29, 0xe4, 0xce, 0x3c,
+	0xa5, 0x46, 0x3d, 0x6a, 0x4e, 0x69, 0xec, 0x69, 0x95, 0x94, 0x71, 0x91, 0xd0, 0xc5, 0x8d, 0xac,
+	0xb8, 0x85, 0x0d, 0x8d, 0xc8, 0x6e, 0x1a, 0x67, 0xa7, 0x25, 0x4d, 0xb9, 0x2c, 0xab, 0x8d, 0xd7,
+	0xb2, 0xb4, 0x91, 0x3b, 0x65, 0xdb, 0xa9, 0x6d, 0xb5, 0x8c, 0x26, 0x30, 0xae, 0xb2, 0xf8, 0xbb,
+	0x8a, 0x2a, 0xb3, 0xaa, 0xaf, 0xa9, 0x52, 0x35, 0x25, 0xd1, 0x22, 0x4b, 0xee, 0x7d, 0x4f, 0xd7,
+	0x54, 0xc9, 0xc1, 0x5f, 0x7b, 0xd0, 0x97, 0x17, 0x67, 0x5b, 0xc3, 0x1e, 0xd4, 0xcd, 0xd9, 0xeb,
+	0x76, 0x95, 0xd8, 0x63, 0xf4, 0xe6, 0x04, 0xc6, 0x11, 0xe5, 0x21, 0x8b, 0x0b, 0xd9, 0x86, 0xb6,
+	0x74, 0x62, 0xaa, 0x04, 0x22, 0xcc, 0xb3, 0x92, 0x66, 0xa5, 0xbc, 0xb8, 0xaa, 0x85, 0x4d, 0x15,
+	0x7a, 0x06, 0x43, 0xd9, 0x64, 0xdc, 0x1f, 0x4c, 0xec, 0x83, 0xf1, 0xf4, 0x71, 0x87, 0x0c, 0x70,
+	0x0d, 0x10, 0xb9, 0xa4, 0x59, 0x95, 0xbe, 0x21, 0x49, 0x45, 0xb9, 0x3f, 0x9a, 0xd8, 0xa2, 0x81,
+	0x1b, 0x0d, 0xfa, 0x29, 0x38, 0xb7, 0x84, 0xc5, 0x44, 0xf4, 0x96, 0x23, 0x9d, 0xed, 0x9b, 0xce,
+	0xde, 0x28, 0x1b, 0x5e, 0x83, 0x44, 0x4f, 0x47, 0xb1, 0x08, 0x36, 0x8d, 0x33, 0x52, 0xe6, 0x4c,
+	0x56, 0xd9, 0xc5, 0x6d, 0xa5, 0x48, 0xa4, 0x20, 0x2d, 0xd9, 0xf4, 0x8a, 0x35, 0xd6, 0x72, 0xb0,
+	0x80, 0x51, 0xed, 0x76, 0xcd, 0x73, 0x96, 0xc1, 0x73, 0x5f, 0xc1, 0x7e, 0xcb, 0x57, 0x1d, 0x7a,
+	0x4f, 0x86, 0xbe, 0xcd, 0x14, 0xfc, 0x61, 0x08, 0xc3, 0x33, 0x5a, 0xae, 0xf2, 0x48, 0xb4, 0x4f,
+	0x5e, 0x50, 0x46, 0x64, 0x6e, 0x95, 0xd7, 0x46, 0x21, 0x8e, 0x2b, 0x48, 0xb9, 0xd2, 0xb4, 0x2a,
+	0xd6, 0x82, 0x2b, 0x53, 0xb9, 0xb7, 0x2e, 0x45, 0x2d, 0x6d, 0xd6, 0xa9, 0xdf, 0xad, 0x93, 0xbe,
+	0x07, 0x03, 0xe3, 0x1e, 0x4c, 0x60, 0xbc, 0x22, 0x59, 0x94, 0x50, 0x66, 0xfc, 0x74, 0x53, 0x25,
+	0x39, 0x91, 0xe5, 0x21, 0xe5, 0x3c, 0x67, 0x06, 0x6b, 0xb6, 0x95, 0xa2, 0x6c, 0x61, 0x12, 0xd3,
+	0xac, 0x34, 0x68, 0xd3, 0xd0, 0xa0, 0xe7, 0x80, 0xd6, 0x24, 0xca, 0x97, 0x3a, 0xd3, 0xaa, 0x14,
+	0x5b, 0x2c, 0xe8, 0x4b, 0x78, 0xcc, 0x28, 0x2f, 0xf2, 0x8c, 0xd3, 0x06, 0x0e, 0x12, 0xde, 0x35,
+	0x08, 0xce, 0x95, 0x3c, 0x79, 0x55, 0x24, 0x39, 0x89, 0xfc, 0x71, 0x97, 0x73, 0xcf, 0x1a, 0x33,
+	0x36, 0xb1, 0x68, 0x0a, 0xee, 0xda, 0x9f, 0xbf, 0x23, 0x2f, 0x54, 0xab, 0x1b, 0x70, 0x6d, 0xc4,
+	0x0d, 0x0c, 0xfd, 0x06, 0x76, 0x05, 0x73, 0x52, 0x5e, 0xbe, 0xc8, 0xa3, 0x98, 0x8a, 0xce, 0xb4,
+	0x37, 0x0f, 0xc4, 0x6b, 0xc0, 0x3d, 0x6e, 0xa3, 0xd1, 0x25, 0x3c, 0xe1, 0x34, 0xac, 0x58, 0x5c,
+	0xde, 0x63, 0x45, 0xc0, 0x29, 0x15, 0xd7, 0x79, 0x4f, 0x7a, 0xf9, 0x91, 0xe9, 0xe5, 0xb2, 0x8b,
+	0xc3, 0x5b, 0x37, 0x0b, 0xa6, 0xe3, 0x55, 0x9a, 0x12, 0x76, 0x2f, 0x09, 0xca, 0xc5, 0x5a, 0x94,
+	0x77, 0x96, 0xbc, 0xe7, 0xbe, 0x27, 0x2f, 0xa4, 0x5c, 0x8b, 0x72, 0x45, 0xb4, 0x60, 0x34, 0x24,
+	0x25, 0x8d, 0xfc, 0xc7, 0x8a, 0xd1, 0x1a, 0x0d, 0xfa, 0x06, 0x76, 0xe8, 0x9d, 0xe0, 0x69, 0x92,
+	0x1c, 0xe7, 0x21, 0xf7, 0x91, 0xcc, 0xa8, 0x6f, 0x86, 0x36, 0x37, 0xec, 0xb8, 0x85, 0x46, 0xbf,
+	0x00, 0x10, 0x72, 0xc6, 0xe3, 0x3c, 0xe3, 0xfe, 0xbe, 0xfc, 0x59, 0x9f, 0x6e, 0xee, 0x95, 0x56,
+	0x6c, 0x00, 0x83, 0x17, 0xb0, 0x63, 0x3a, 0x45, 0x1e, 0xd8, 0x15, 0x4b, 0xea, 0xae, 0x10, 0xcb,
+	0xcd, 0x3b, 0xde, 0xeb, 0xdc, 0xf1, 0xe0, 0x6b, 0x70, 0xd7, 0xce, 0x1f, 0x9a, 0x54, 0xee, 0x49,
+	0x9a, 0xe8, 0x96, 0x12, 0xeb, 0xe0, 0x6f, 0x16, 0x8c, 0x8d, 0x7a, 0xb5, 0xdf, 0x61, 0x6b, 0xf3,
+	0x1d, 0x7e, 0xd6, 0xa2, 0xce, 0xff, 0x38, 0xd7, 0x68, 0xba, 0xb0, 0x0d, 0xba, 0x78, 0x68, 0xd6,
+	0x31, 0x9f, 0xe7, 0xc1, 0xc6, 0xf3, 0x3c, 0x05, 0x97, 0x66, 0x61, 0x1e, 0xc5, 0xd9, 0x7b, 0xee,
+	0x0f, 0xbb, 0x97, 0x74, 0x5e, 0x1b, 0x71, 0x03, 0x0b, 0xfe, 0x68, 0x81, 0xa3, 0xf5, 0x5b, 0x33,
+	0xb1, 0x41, 0xdb, 0xbd, 0x2e, 0x6d, 0x7f, 0x01, 0xa3, 0x15, 0x25, 0x11, 0x65, 0xdc, 0xb7, 0x1f,
+	0xe2, 0x6d, 0x8d, 0x68, 0xc6, 0x93, 0xfe, 0x03, 0xe3, 0xc9, 0xa0, 0x3d, 0x9e, 0xfc, 0xd3, 0x02,
+	0x47, 0x37, 0x97, 0x88, 0x2f, 0xcc, 0xa3, 0x75, 0x7c, 0x62, 0xfd, 0xf1, 0x62, 0xb7, 0xeb, 0x64,
+	0x3f, 0x54, 0xa7, 0xfe, 0x7f, 0x5f, 0xa7, 0xc1, 0xd6, 0x3a, 0x0d, 0x5b, 0x75, 0x32, 0x92, 0x32,
+	0xfa, 0x68, 0x52, 0x7c, 0x18, 0xc5, 0x7c, 0xce, 0x58, 0xce, 0x24, 0x27, 0x3a, 0x58, 0x8b, 0xc1,
+	0x3f, 0x7a, 0xb0, 0xa7, 0xbb, 0xfb, 0x32, 0x5c, 0xd1, 0x74, 0xfb, 0x3b, 0x3d, 0x35, 0x06, 0xeb,
+	0xbd, 0xe9, 0xe7, 0xdb, 0xb8, 0x41, 0xed, 0x16, 0x3f, 0xb9, 0x8e, 0xfc, 0xe3, 0x2f, 0x76, 0x67,
+	0xce, 0xed, 0x6f, 0x9b, 0x73, 0xff, 0xf7, 0xe9, 0xfb, 0x33, 0x18, 0x72, 0x19, 0x8d, 0xce, 0x99,
+	0x92, 0x50, 0x00, 0x3b, 0xd7, 0x94, 0x30, 0xca, 0x4e, 0x54, 0x46, 0xd5, 0x13, 0xd2, 0xd2, 0xa1,
+	0x2f, 0x60, 0x70, 0x93, 0xe4, 0xdf, 0xeb, 0x57, 0xbd, 0x55, 0xaf, 0xc5, 0xac, 0x2a, 0x57, 0x27,
+	0x49, 0xfe, 0x3d, 0x56, 0x18, 0x31, 0xbd, 0xe5, 0x05, 0xcd, 0x4e, 0xa3, 0xa3, 0x3c, 0xcb, 0x68,
+	0x58, 0x5e, 0xb1, 0xa4, 0x7e, 0x4c, 0x3a, 0xfa, 0xe0, 0x4f, 0x16, 0xb8, 0x6b, 0x07, 0x5b, 0x5f,
+	0xf0, 0x43, 0xf0, 0x48, 0x55, 0xae, 0x72, 0x16, 0x7f, 0x90, 0xef, 0xae, 0xf0, 0xa6, 0xae, 0x5b,
+	0x47, 0x2f, 0x07, 0x85, 0xfc, 0x5b, 0x2a, 0x31, 0x76, 0x3d, 0x28, 0xd4, 0xb2, 0x60, 0x55, 0x46,
+	0x6f, 0x18, 0xe5, 0x2b, 0x61, 0x55, 0x39, 0x35, 0x34, 0x2a, 0x3d, 0x79, 0x41, 0xd5, 0x18, 0xe4,
+	0xe2, 0x5a, 0x0a, 0x5e, 0xc1, 0xfe, 0x16, 0xa2, 0x47, 0x3f, 0x87, 0x91, 0xca, 0x1f, 0xf7, 0x2d,
+	0x99, 0x93, 0xa7, 0x9b, 0x0f, 0x8c, 0x20, 0x07, 0x55, 0x7e, 0xac, 0xa1, 0xc1, 0x37, 0xb0, 0xd7,
+	0x36, 0x6d, 0xbd, 0x57, 0x4d, 0x28, 0xbd, 0x56, 0x28, 0xb7, 0x30, 0xbc, 0xa4, 0xec, 0x96, 0xb2,
+	0xff, 0x87, 0x7d, 0xd1, 0x2f, 0xc1, 0x95, 0x73, 0xd7, 0x75, 0x42, 0x35, 0x65, 0x3c, 0x6d, 0x5f,
+	0x59, 0xe1, 0xfa, 0x4d, 0x0d, 0xc1, 0x0d, 0x38, 0xf8, 0xbd, 0x05, 0x7b, 0x6d, 0xeb, 0xd6, 0xb0,
+	0x03, 0xd8, 0x89, 0xe8, 0x0d, 0xa9, 0x92, 0x52, 0x8e, 0x52, 0x75, 0x0c, 0x2d, 0xdd, 0xc6, 0x04,
+	0x69, 0x77, 0x26, 0xc8, 0x8f, 0x0e, 0x4a, 0xc1, 0x9f, 0x2d, 0x18, 0x1b, 0x03, 0x83, 0x48, 0x16,
+	0x09, 0x43, 0x5a, 0x94, 0xb2, 0x0e, 0x2e, 0xae, 0xa5, 0xfa, 0xeb, 0xe2, 0x32, 0xfe, 0xa0, 0x03,
+	0xd1, 0xa2, 0x88, 0x81, 0xc7, 0x69, 0x91, 0xd0, 0x0b, 0x52, 0x7f, 0x34, 0xb9, 0xd8, 0xd0, 0x88,
+	0x06, 0x64, 0x94, 0x57, 0x29, 0xb9, 0x56, 0x0a, 0xdd, 0x80, 0x2d, 0xa5, 0xe4, 0x37, 0xf9, 0x15,
+	0x41, 0x58, 0x59, 0xd3, 0x67, 0xa3, 0x08, 0xfe, 0x6e, 0xc1, 0xe0, 0x2c, 0x8f, 0x68, 0xb2, 0x35,
+	0x53, 0x3f, 0x81, 0x41, 0x79, 0xaf, 0xeb, 0x3b, 0x9e, 0x7a, 0x9b, 0x13, 0x3e, 0x56, 0x66, 0xf4,
+	0x25, 0x8c, 0xd4, 0x00, 0xa9, 0x0b, 0x86, 0xda, 0x63, 0x93, 0x30, 0x61, 0x0d, 0x41, 0xc7, 0xf0,
+	0x88, 0xb7, 0x68, 0x87, 0xfb, 0xfd, 0x6d, 0x65, 0x36, 0x21, 0x78, 0x73, 0x8b, 0x38, 0x93, 0xcb,
+	0x5a, 0xeb, 0xef, 0x01, 0xd4, 0xbd, 0x24, 0x58, 0x43, 0x0e, 0x7f, 0x0d, 0xee, 0x9a, 0xaf, 0x11,
+	0xc0, 0xf0, 0xf2, 0x68, 0xf6, 0x7a, 0x86, 0xbd, 0x4f, 0xd0, 0x08, 0xec, 0xb3, 0xd9, 0x85, 0x67,
+	0x21, 0x17, 0x06, 0x33, 0x8c, 0x67, 0x6f, 0xbd, 0x1e, 0xda, 0x05, 0x17, 0xcf, 0x4f, 0xe6, 0x78,
+	0x7e, 0x7e, 0x34, 0xf7, 0xec, 0xc3, 0x19, 0x38, 0xfa, 0x73, 0x46, 0x6e, 0x5d, 0xe2, 0xab, 0xa3,
+	0xa5, 0xf7, 0x89, 0x58, 0x2f, 0x5e, 0xfc, 0x76, 0x7e, 0xb4, 0xf4, 0x2c, 0xe4, 0x40, 0xff, 0xf5,
+	0xe9, 0xe5, 0xd2, 0xeb, 0x89, 0xd5, 0xfc, 0xfc, 0xea, 0xcc, 0xb3, 0x85, 0xc7, 0xab, 0xf3, 0xd3,
+	0xc5, 0xb9, 0xd7, 0x3f, 0x5c, 0x80, 0xa3, 0x99, 0x4e, 0x00, 0x5e, 0x2c, 0x8e, 0xdf, 0x2a, 0x07,
+	0x2f, 0xe7, 0xb3, 0xe3, 0x39, 0xf6, 0x2c, 0xb4, 0x03, 0xce, 0xc9, 0x02, 0x9f, 0x1d, 0xcf, 0x96,
+	0x33, 0xaf, 0x27, 0xb6, 0xfe, 0xee, 0x6a, 0x8e, 0xdf, 0x7a, 0xb6, 0x80, 0x5f, 0xcc, 0x96, 0x2f,
+	0xbd, 0xbe, 0x80, 0x1f, 0x2d, 0x16, 0xaf, 0x4e, 0xe7, 0xde, 0xe0, 0xf0, 0x35, 0xa0, 0x2e, 0x75,
+	0xa3, 0x31, 0x8c, 0x66, 0x17, 0xa7, 0xef, 0x5e, 0xcd, 0x85, 0x77, 0x07, 0xfa, 0x2f, 0x97, 0x4b,
+	0xf1, 0xd3, 0x44, 0xa0, 0xb3, 0xab, 0xe5, 0xcb, 0xa9, 0xd7, 0x43, 0xfb, 0xf0, 0x68, 0x71, 0x31,
+	0x3f, 0x7f, 0x77, 0x7a, 0xfc, 0xee, 0x68, 0x71, 0x7e, 0x2e, 0xa2, 0xb7, 0xaf, 0x87, 0xf2, 0xaf,
+	0x9a, 0xaf, 0xff, 0x3d, 0x00, 0x18, 0x4b, 0x93, 0xb6, 0xbb, 0x11, 0x00, 0x00,
 }
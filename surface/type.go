@@ -14,10 +14,84 @@
 
 package surface_v1
 
+import (
+	"strconv"
+	"unicode"
+)
+
 func (t *Type) addField(f *Field) {
 	t.Fields = append(t.Fields, f)
 }
 
+// setField replaces the field with the same name as f, or adds f if there is none.
+// A replaced field that was required remains required.
+func (t *Type) setField(f *Field) {
+	for i, existing := range t.Fields {
+		if existing.Name == f.Name {
+			if existing.GetConstraints().GetRequired() {
+				f.setRequired()
+			}
+			t.Fields[i] = f
+			return
+		}
+	}
+	t.addField(f)
+}
+
+// setRequiredFields marks the fields with the specified names as ones that must have values.
+func (t *Type) setRequiredFields(names []string) {
+	for _, f := range t.Fields {
+		for _, name := range names {
+			if f.Name == name {
+				f.setRequired()
+			}
+		}
+	}
+}
+
+// hasFieldNamed returns true if a type has a field with the specified name.
+func (t *Type) hasFieldNamed(name string) bool {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// bodyFieldName returns a name for the field of a parameters type that holds a request body.
+// Bodies of named types are named for their types and other bodies are named "body",
+// but names that are used by other parameters are avoided.
+func (t *Type) bodyFieldName(typeName string, named bool) string {
+	names := []string{"body", "requestBody"}
+	if named && typeName != "" {
+		a := []rune(typeName)
+		a[0] = unicode.ToLower(a[0])
+		names = append([]string{string(a)}, names...)
+	}
+	for _, name := range names {
+		if !t.hasFieldNamed(name) {
+			return name
+		}
+	}
+	for i := 2; ; i++ {
+		name := "requestBody" + strconv.Itoa(i)
+		if !t.hasFieldNamed(name) {
+			return name
+		}
+	}
+}
+
+// variantWithType returns the variant of a union that has the specified type, or nil if there is none.
+func (t *Type) variantWithType(typeName string) *Variant {
+	for _, v := range t.Variants {
+		if v.Type == typeName {
+			return v
+		}
+	}
+	return nil
+}
+
 func (s *Type) HasFieldWithName(name string) bool {
 	return s.FieldWithName(name) != nil
 }
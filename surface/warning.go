@@ -0,0 +1,61 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surface_v1
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Codes of the warnings that are reported while building models.
+const (
+	WarningNameCollision       = "NAMECOLLISION"       // a name was changed because it was already used
+	WarningUnresolvedReference = "UNRESOLVEDREFERENCE" // a reference couldn't be resolved
+	WarningUnsupported         = "UNSUPPORTED"         // a feature isn't supported by the model
+	WarningOmitted             = "OMITTED"             // a part of the description was left out of the model
+)
+
+// Warning describes a problem in an API description that was found while building a model.
+// Parts of the description that have problems are left out of the model or are changed,
+// and code that is generated from the model may not support them.
+type Warning struct {
+	Code string   // identifies the kind of problem
+	Text string   // describes the problem
+	Keys []string // the key path of the problem in the API description
+}
+
+func (w *Warning) String() string {
+	return fmt.Sprintf("%s: %s (%s)", w.Code, w.Text, strings.Join(w.Keys, "."))
+}
+
+// reporter collects the warnings of a builder. Warnings are reported with key paths
+// that are relative to the location of the part of the API description being built.
+type reporter struct {
+	location []string
+	warnings []*Warning
+}
+
+// at sets the location of the part of the API description that is being built.
+func (r *reporter) at(keys ...string) {
+	r.location = keys
+}
+
+// warn adds a warning with a key path that is relative to the current location.
+func (r *reporter) warn(keys []string, code string, format string, args ...interface{}) {
+	w := &Warning{Code: code, Text: fmt.Sprintf(format, args...)}
+	w.Keys = append(w.Keys, r.location...)
+	w.Keys = append(w.Keys, keys...)
+	r.warnings = append(r.warnings, w)
+}
@@ -0,0 +1,172 @@
+package surface_v1
+
+import (
+	"strings"
+	"testing"
+)
+
+// warningTest is a part of an API description and the warning that is expected for it.
+type warningTest struct {
+	description string
+	text        string
+	code        string
+	keys        string // the keys of the path of the warning, separated by spaces
+}
+
+func testWarnings(t *testing.T, build func(*testing.T, string) (*Model, []*Warning), header string, tests []warningTest) {
+	for _, test := range tests {
+		_, warnings := build(t, header+test.text)
+		if len(warnings) != 1 {
+			t.Errorf("%s: got %d warnings, expected 1: %v", test.description, len(warnings), warnings)
+			continue
+		}
+		expected := (&Warning{Code: test.code, Text: warnings[0].Text, Keys: strings.Fields(test.keys)}).String()
+		if warning := warnings[0].String(); warning != expected {
+			t.Errorf("%s: got warning %s, expected %s", test.description, warning, expected)
+		}
+	}
+}
+
+func TestOpenAPI3Warnings(t *testing.T) {
+	testWarnings(t, buildOpenAPI3, "openapi: 3.0.0\ninfo:\n  title: Warnings\n  version: 1.0.0\n", []warningTest{
+		{
+			description: "methods with the same names",
+			text: `
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "204":
+          description: no pets
+  /animals:
+    get:
+      operationId: listPets
+      responses:
+        "204":
+          description: no animals
+`,
+			code: WarningNameCollision,
+			keys: "paths /animals get",
+		},
+		{
+			description: "responses that can't be resolved",
+			text: `
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          $ref: '#/components/responses/Missing'
+`,
+			code: WarningUnresolvedReference,
+			keys: "paths /pets get responses 200",
+		},
+		{
+			description: "security schemes that can't be resolved",
+			text: `
+paths: {}
+components:
+  securitySchemes:
+    key:
+      $ref: '#/components/securitySchemes/missing'
+`,
+			code: WarningUnresolvedReference,
+			keys: "components securitySchemes key $ref",
+		},
+		{
+			description: "security schemes of unsupported types",
+			text: `
+paths: {}
+components:
+  securitySchemes:
+    certificate:
+      type: mutualTLS
+`,
+			code: WarningUnsupported,
+			keys: "components securitySchemes certificate type",
+		},
+		{
+			description: "schemas that are references",
+			text: `
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+    Animal:
+      $ref: '#/components/schemas/Pet'
+`,
+			code: WarningOmitted,
+			keys: "components schemas Animal",
+		},
+		{
+			description: "methods with parameters that can't be resolved",
+			text: `
+paths:
+  /pets:
+    get:
+      parameters:
+      - $ref: '#/components/parameters/missing'
+      responses:
+        "204":
+          description: no pets
+`,
+			code: WarningOmitted,
+			keys: "paths /pets get",
+		},
+	})
+}
+
+func TestOpenAPI2Warnings(t *testing.T) {
+	testWarnings(t, buildOpenAPI2, "swagger: \"2.0\"\ninfo:\n  title: Warnings\n  version: 1.0.0\n", []warningTest{
+		{
+			description: "methods with the same names",
+			text: `
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "204":
+          description: no pets
+  /animals:
+    get:
+      operationId: listPets
+      responses:
+        "204":
+          description: no animals
+`,
+			code: WarningNameCollision,
+			keys: "paths /animals get",
+		},
+		{
+			description: "responses that can't be resolved",
+			text: `
+paths:
+  /pets:
+    get:
+      responses:
+        "200":
+          $ref: '#/responses/Missing'
+`,
+			code: WarningUnresolvedReference,
+			keys: "paths /pets get responses 200",
+		},
+		{
+			description: "methods with parameters that can't be resolved",
+			text: `
+paths:
+  /pets:
+    get:
+      parameters:
+      - $ref: '#/parameters/missing'
+      responses:
+        "204":
+          description: no pets
+`,
+			code: WarningOmitted,
+			keys: "paths /pets get",
+		},
+	})
+}